@@ -0,0 +1,242 @@
+// Package assert compares factory-built fixtures against expected values while
+// ignoring generated fields such as IDs and timestamps.
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+// TestingT is the subset of testing.TB used by the assertion helpers.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// Equal reports a field-path diff through t when expected and actual differ outside the ignored paths.
+// Paths use dot notation for struct fields and brackets for indexes and map keys
+// (e.g. "Owner.ID", "Items[0].CreatedAt"); "[*]" matches any index or key.
+func Equal(t TestingT, expected, actual any, ignore ...string) bool {
+	t.Helper()
+
+	diffs := Diff(expected, actual, ignore...)
+	if len(diffs) == 0 {
+		return true
+	}
+
+	t.Errorf("fixture mismatch:\n  %s", strings.Join(diffs, "\n  "))
+	return false
+}
+
+// Diff returns one line per differing field path between expected and actual.
+func Diff(expected, actual any, ignore ...string) []string {
+	comparer := &comparer{ignore: ignore}
+	comparer.compare("", addressable(reflect.ValueOf(expected)), addressable(reflect.ValueOf(actual)))
+	return comparer.diffs
+}
+
+var indexPattern = regexp.MustCompile(`\[[^\]]*\]`)
+
+var timeType = reflect.TypeFor[time.Time]()
+
+type comparer struct {
+	ignore  []string
+	diffs   []string
+	visited map[visit]struct{}
+}
+
+// visit records a pair of pointers already being compared, as reflect.DeepEqual does, so cyclic
+// values terminate.
+type visit struct {
+	expected uintptr
+	actual   uintptr
+	typ      reflect.Type
+}
+
+func (c *comparer) compare(path string, expected, actual reflect.Value) {
+	if c.ignored(path) {
+		return
+	}
+
+	if !expected.IsValid() || !actual.IsValid() {
+		if expected.IsValid() != actual.IsValid() {
+			c.report(path, "expected %s, got %s", describe(expected), describe(actual))
+		}
+		return
+	}
+
+	if expected.Type() != actual.Type() {
+		c.report(path, "expected type %s, got %s", expected.Type(), actual.Type())
+		return
+	}
+
+	expected, actual = expose(expected), expose(actual)
+	if c.seen(expected, actual) {
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				c.report(path, "expected %s, got %s", describe(expected), describe(actual))
+			}
+			return
+		}
+		c.compare(path, expected.Elem(), actual.Elem())
+	case reflect.Struct:
+		c.compareStruct(path, expected, actual)
+	case reflect.Slice, reflect.Array:
+		c.compareSequence(path, expected, actual)
+	case reflect.Map:
+		c.compareMap(path, expected, actual)
+	default:
+		if !reflect.DeepEqual(expected.Interface(), actual.Interface()) {
+			c.report(path, "expected %s, got %s", describe(expected), describe(actual))
+		}
+	}
+}
+
+// seen reports whether the pointer, map or slice pair was already compared, recording it otherwise.
+func (c *comparer) seen(expected, actual reflect.Value) bool {
+	switch expected.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+	default:
+		return false
+	}
+	if expected.IsNil() || actual.IsNil() {
+		return false
+	}
+
+	key := visit{expected: expected.Pointer(), actual: actual.Pointer(), typ: expected.Type()}
+	if _, ok := c.visited[key]; ok {
+		return true
+	}
+	if c.visited == nil {
+		c.visited = make(map[visit]struct{})
+	}
+	c.visited[key] = struct{}{}
+
+	return false
+}
+
+func (c *comparer) compareStruct(path string, expected, actual reflect.Value) {
+	if expected.Type() == timeType {
+		expectedTime, _ := expected.Interface().(time.Time)
+		actualTime, _ := actual.Interface().(time.Time)
+		if !expectedTime.Equal(actualTime) {
+			c.report(path, "expected %s, got %s", expectedTime, actualTime)
+		}
+		return
+	}
+
+	for i := 0; i < expected.NumField(); i++ {
+		c.compare(joinField(path, expected.Type().Field(i).Name), expected.Field(i), actual.Field(i))
+	}
+}
+
+func (c *comparer) compareSequence(path string, expected, actual reflect.Value) {
+	if expected.Len() != actual.Len() {
+		c.report(path, "expected length %d, got %d", expected.Len(), actual.Len())
+	}
+
+	for i := 0; i < min(expected.Len(), actual.Len()); i++ {
+		c.compare(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), actual.Index(i))
+	}
+}
+
+func (c *comparer) compareMap(path string, expected, actual reflect.Value) {
+	for _, key := range expected.MapKeys() {
+		keyPath := fmt.Sprintf("%s[%v]", path, key.Interface())
+		actualValue := actual.MapIndex(key)
+		if !actualValue.IsValid() {
+			if !c.ignored(keyPath) {
+				c.report(keyPath, "missing key")
+			}
+			continue
+		}
+		c.compare(keyPath, addressable(expected.MapIndex(key)), addressable(actualValue))
+	}
+
+	for _, key := range actual.MapKeys() {
+		keyPath := fmt.Sprintf("%s[%v]", path, key.Interface())
+		if !expected.MapIndex(key).IsValid() && !c.ignored(keyPath) {
+			c.report(keyPath, "unexpected key")
+		}
+	}
+}
+
+func (c *comparer) ignored(path string) bool {
+	if path == "" {
+		return false
+	}
+
+	wildcard := indexPattern.ReplaceAllString(path, "[*]")
+	for _, pattern := range c.ignore {
+		if matchesPath(path, pattern) || matchesPath(wildcard, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *comparer) report(path, format string, args ...any) {
+	if path == "" {
+		path = "(root)"
+	}
+	c.diffs = append(c.diffs, path+": "+fmt.Sprintf(format, args...))
+}
+
+func matchesPath(path, pattern string) bool {
+	if path == pattern {
+		return true
+	}
+	return strings.HasPrefix(path, pattern+".") || strings.HasPrefix(path, pattern+"[")
+}
+
+func joinField(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func describe(value reflect.Value) string {
+	if !value.IsValid() {
+		return "<nil>"
+	}
+	if (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && value.IsNil() {
+		return "<nil>"
+	}
+	value = expose(value)
+	if !value.CanInterface() {
+		return value.String()
+	}
+	return fmt.Sprintf("%#v", value.Interface())
+}
+
+// addressable copies value into addressable storage so unexported fields can be exposed.
+func addressable(value reflect.Value) reflect.Value {
+	if !value.IsValid() || value.CanAddr() || !value.CanInterface() {
+		return value
+	}
+
+	copied := reflect.New(value.Type()).Elem()
+	copied.Set(value)
+	return copied
+}
+
+func expose(value reflect.Value) reflect.Value {
+	if value.CanInterface() {
+		return value
+	}
+	if value.CanAddr() {
+		return reflect.NewAt(value.Type(), unsafe.Pointer(value.UnsafeAddr())).Elem()
+	}
+	return value
+}
@@ -0,0 +1,158 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type item struct {
+	ID    int
+	Name  string
+	price int
+}
+
+type order struct {
+	ID        int
+	Owner     *owner
+	Items     []item
+	Tags      map[string]string
+	CreatedAt time.Time
+	note      string
+}
+
+type owner struct {
+	ID   int
+	Name string
+}
+
+type recordingT struct {
+	messages []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+func sampleOrder() order {
+	return order{
+		ID:        1,
+		Owner:     &owner{ID: 10, Name: "alice"},
+		Items:     []item{{ID: 100, Name: "pen", price: 3}, {ID: 101, Name: "ink", price: 5}},
+		Tags:      map[string]string{"channel": "web"},
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		note:      "gift",
+	}
+}
+
+func TestDiffReturnsNothingForEqualValues(t *testing.T) {
+	if diffs := Diff(sampleOrder(), sampleOrder()); len(diffs) != 0 {
+		t.Fatalf("expected no diffs, got %v", diffs)
+	}
+}
+
+func TestDiffReportsFieldPaths(t *testing.T) {
+	expected := sampleOrder()
+	actual := sampleOrder()
+	actual.Owner = &owner{ID: 10, Name: "bob"}
+	actual.Items[1].price = 7
+	actual.Tags = map[string]string{"channel": "app", "extra": "x"}
+	actual.note = "none"
+
+	diffs := Diff(expected, actual)
+	joined := strings.Join(diffs, "\n")
+
+	for _, want := range []string{
+		`Owner.Name: expected "alice", got "bob"`,
+		"Items[1].price: expected 5, got 7",
+		`Tags[channel]: expected "web", got "app"`,
+		"Tags[extra]: unexpected key",
+		`note: expected "gift", got "none"`,
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected diff %q in:\n%s", want, joined)
+		}
+	}
+}
+
+func TestDiffIgnoresListedPaths(t *testing.T) {
+	expected := sampleOrder()
+	actual := sampleOrder()
+	actual.ID = 2
+	actual.Owner.ID = 20
+	actual.Items[0].ID = 200
+	actual.Items[1].ID = 201
+	actual.CreatedAt = time.Now()
+
+	diffs := Diff(expected, actual, "ID", "Owner.ID", "Items[*].ID", "CreatedAt")
+	if len(diffs) != 0 {
+		t.Fatalf("expected ignored fields to be skipped, got %v", diffs)
+	}
+}
+
+func TestDiffReportsLengthAndNilMismatch(t *testing.T) {
+	expected := sampleOrder()
+	actual := sampleOrder()
+	actual.Owner = nil
+	actual.Items = actual.Items[:1]
+
+	diffs := Diff(expected, actual)
+	joined := strings.Join(diffs, "\n")
+
+	if !strings.Contains(joined, "Owner: expected") || !strings.Contains(joined, "got <nil>") {
+		t.Errorf("expected nil mismatch, got:\n%s", joined)
+	}
+	if !strings.Contains(joined, "Items: expected length 2, got 1") {
+		t.Errorf("expected length mismatch, got:\n%s", joined)
+	}
+}
+
+func TestDiffComparesTimesByInstant(t *testing.T) {
+	instant := time.Date(2025, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	if diffs := Diff(instant.UTC(), instant); len(diffs) != 0 {
+		t.Fatalf("expected equal instants, got %v", diffs)
+	}
+}
+
+func TestEqualReportsThroughTestingT(t *testing.T) {
+	recorder := &recordingT{}
+	expected := sampleOrder()
+	actual := sampleOrder()
+	actual.Owner.Name = "carol"
+
+	if Equal(recorder, expected, actual) {
+		t.Fatal("expected Equal to report mismatch")
+	}
+	if len(recorder.messages) != 1 || !strings.Contains(recorder.messages[0], "Owner.Name") {
+		t.Fatalf("unexpected messages %v", recorder.messages)
+	}
+
+	if !Equal(recorder, expected, actual, "Owner.Name") {
+		t.Fatal("expected Equal to pass when field ignored")
+	}
+}
+
+type node struct {
+	Name string
+	Next *node
+}
+
+func TestDiffTerminatesOnCycles(t *testing.T) {
+	expected := &node{Name: "a"}
+	expected.Next = expected
+	actual := &node{Name: "a"}
+	actual.Next = actual
+
+	if diffs := Diff(expected, actual); len(diffs) != 0 {
+		t.Fatalf("expected equal cyclic values, got %v", diffs)
+	}
+
+	actual.Name = "b"
+	if diffs := Diff(expected, actual); len(diffs) != 1 || !strings.Contains(diffs[0], "Name") {
+		t.Fatalf("expected one Name diff, got %v", diffs)
+	}
+}
@@ -0,0 +1,82 @@
+// Package benchdata builds size-parameterized datasets from forge builders for benchmarks.
+package benchdata
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// Standard dataset sizes.
+const (
+	Small  = 10
+	Medium = 1_000
+	Large  = 100_000
+)
+
+// Sizes lists the standard dataset sizes in ascending order.
+var Sizes = []int{Small, Medium, Large}
+
+type listBuilder[T any] interface {
+	BuildListWith(size int, seed int64, overrides any) []T
+}
+
+// Dataset lazily builds and caches one deterministic corpus per size.
+type Dataset[T any] struct {
+	builder   listBuilder[T]
+	seed      int64
+	overrides any
+	mu        sync.Mutex
+	cache     map[int][]T
+}
+
+// New creates a Dataset backed by builder. Every corpus starts from seed so runs are reproducible.
+func New[T any](builder listBuilder[T], seed int64, overrides any) *Dataset[T] {
+	return &Dataset[T]{
+		builder:   builder,
+		seed:      seed,
+		overrides: overrides,
+		cache:     make(map[int][]T),
+	}
+}
+
+// Get returns the corpus for size, building it on first use only.
+func (d *Dataset[T]) Get(size int) []T {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if data, ok := d.cache[size]; ok {
+		return data
+	}
+
+	data := d.builder.BuildListWith(size, d.seed, d.overrides)
+	d.cache[size] = data
+
+	return data
+}
+
+// Run executes fn as a sub-benchmark per size (Sizes when none are given),
+// excluding dataset generation from the measured time.
+func (d *Dataset[T]) Run(b *testing.B, fn func(b *testing.B, data []T), sizes ...int) {
+	b.Helper()
+
+	if len(sizes) == 0 {
+		sizes = Sizes
+	}
+
+	for _, size := range sizes {
+		b.Run("n="+strconv.Itoa(size), func(b *testing.B) {
+			data := d.Get(size)
+			b.ResetTimer()
+			fn(b, data)
+		})
+	}
+}
+
+// Reset drops every cached corpus.
+func (d *Dataset[T]) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.cache = make(map[int][]T)
+}
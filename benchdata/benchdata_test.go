@@ -0,0 +1,73 @@
+package benchdata
+
+import (
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+type countingBuilder struct {
+	calls int
+}
+
+func (c *countingBuilder) BuildListWith(size int, seed int64, _ any) []int64 {
+	c.calls++
+	result := make([]int64, size)
+	for i := range result {
+		result[i] = seed + int64(i)
+	}
+	return result
+}
+
+func TestDatasetCachesPerSize(t *testing.T) {
+	builder := &countingBuilder{}
+	dataset := New[int64](builder, 100, nil)
+
+	first := dataset.Get(Small)
+	second := dataset.Get(Small)
+
+	if len(first) != Small || &first[0] != &second[0] {
+		t.Fatal("expected cached corpus to be reused")
+	}
+	if builder.calls != 1 {
+		t.Fatalf("expected one build, got %d", builder.calls)
+	}
+
+	dataset.Get(Medium)
+	if builder.calls != 2 {
+		t.Fatalf("expected a build per size, got %d", builder.calls)
+	}
+
+	dataset.Reset()
+	dataset.Get(Small)
+	if builder.calls != 3 {
+		t.Fatalf("expected rebuild after reset, got %d", builder.calls)
+	}
+}
+
+func TestDatasetIsDeterministic(t *testing.T) {
+	builder := factory.Builder(&factory.StringFactory{})
+
+	first := New(builder, 42, nil).Get(Small)
+	second := New(builder, 42, nil).Get(Small)
+
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("expected identical corpora at %d: %q vs %q", i, first[i], second[i])
+		}
+	}
+}
+
+var corpus = New(factory.Builder(&factory.StringFactory{}), 1, nil)
+
+func BenchmarkDatasetRun(b *testing.B) {
+	corpus.Run(b, func(b *testing.B, data []string) {
+		for range b.N {
+			total := 0
+			for _, value := range data {
+				total += len(value)
+			}
+			_ = total
+		}
+	}, Small, Medium)
+}
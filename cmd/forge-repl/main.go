@@ -0,0 +1,45 @@
+// Command forge-repl is an interactive playground for the built-in factories. Run it, then type
+// "list" to see the providers, "use forge.email" to pick one and "build 3" to print instances.
+package main
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+
+	"github.com/lihs-ie/forge/factory"
+	"github.com/lihs-ie/forge/faker"
+	"github.com/lihs-ie/forge/repl"
+)
+
+func init() {
+	factory.RegisterProvider("forge.email", func() factory.Factory[string, factory.EmailProperties] {
+		return &factory.EmailFactory{}
+	})
+	factory.RegisterProvider("forge.ip", func() factory.Factory[netip.Addr, factory.IPProperties] {
+		return &factory.IPFactory{}
+	})
+	factory.RegisterProvider("forge.phone", func() factory.Factory[string, factory.PhoneProperties] {
+		return &factory.PhoneFactory{Countries: []string{"US", "GB", "DE", "FR", "JP"}}
+	})
+	factory.RegisterProvider("forge.url", func() factory.Factory[string, factory.URLProperties] {
+		return &factory.URLFactory{}
+	})
+	factory.RegisterProvider("forge.uuid", func() factory.Factory[string, factory.UUIDProperties] {
+		return &factory.UUIDFactory{}
+	})
+	factory.RegisterProvider("faker.address", func() factory.Factory[faker.Address, faker.AddressProperties] {
+		return &faker.AddressFactory{}
+	})
+	factory.RegisterProvider("faker.name", func() factory.Factory[string, faker.NameProperties] {
+		return &faker.NameFactory{}
+	})
+}
+
+func main() {
+	fmt.Println("forge playground, type help for commands")
+	if err := repl.Run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
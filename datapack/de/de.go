@@ -0,0 +1,33 @@
+// Package de registers German datasets for factory.DatasetFactory. Import it for its side
+// effects:
+//
+//	import _ "github.com/lihs-ie/forge/datapack/de"
+package de
+
+import "github.com/lihs-ie/forge/factory"
+
+func init() {
+	factory.RegisterDataset(factory.LocaleGerman, factory.DatasetFirstNames, []string{
+		"Lukas", "Anna", "Leon", "Marie", "Finn", "Sophie", "Jonas", "Emma", "Paul", "Hannah",
+		"Felix", "Lena", "Maximilian", "Mia", "Elias", "Lea", "Noah", "Johanna", "Ben", "Clara",
+	})
+	factory.RegisterDataset(factory.LocaleGerman, factory.DatasetLastNames, []string{
+		"Müller", "Schmidt", "Schneider", "Fischer", "Weber", "Meyer", "Wagner", "Becker",
+		"Schulz", "Hoffmann", "Schäfer", "Koch", "Bauer", "Richter", "Klein", "Wolf",
+		"Schröder", "Neumann", "Schwarz", "Zimmermann",
+	})
+	factory.RegisterDataset(factory.LocaleGerman, factory.DatasetCities, []string{
+		"Berlin", "Hamburg", "München", "Köln", "Frankfurt am Main", "Stuttgart", "Düsseldorf",
+		"Leipzig", "Dortmund", "Essen", "Bremen", "Dresden", "Hannover", "Nürnberg",
+	})
+	factory.RegisterDataset(factory.LocaleGerman, factory.DatasetStreets, []string{
+		"Hauptstraße", "Schulstraße", "Gartenstraße", "Bahnhofstraße", "Dorfstraße",
+		"Bergstraße", "Birkenweg", "Lindenstraße", "Kirchstraße", "Waldstraße", "Ringstraße",
+		"Schillerstraße", "Goethestraße",
+	})
+	factory.RegisterDataset(factory.LocaleGerman, factory.DatasetWords, []string{
+		"Abend", "Baum", "Brücke", "Dach", "Feld", "Garten", "Himmel", "Insel", "Kraft",
+		"Licht", "Meer", "Nacht", "Quelle", "Regen", "Sonne", "Stadt", "Tisch", "Wald",
+		"Wasser", "Zeit",
+	})
+}
@@ -0,0 +1,5 @@
+module github.com/lihs-ie/forge/datapack/de
+
+go 1.25.3
+
+require github.com/lihs-ie/forge v0.0.0-20261016022501-2ee3d3e472a1
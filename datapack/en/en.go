@@ -0,0 +1,38 @@
+// Package en registers English datasets for factory.DatasetFactory. Import it for its side
+// effects:
+//
+//	import _ "github.com/lihs-ie/forge/datapack/en"
+package en
+
+import "github.com/lihs-ie/forge/factory"
+
+func init() {
+	factory.RegisterDataset(factory.LocaleEnglish, factory.DatasetFirstNames, []string{
+		"James", "Mary", "John", "Patricia", "Robert", "Jennifer", "Michael", "Linda",
+		"William", "Elizabeth", "David", "Barbara", "Richard", "Susan", "Joseph", "Jessica",
+		"Thomas", "Sarah", "Charles", "Karen", "Daniel", "Nancy", "Matthew", "Lisa",
+		"Anthony", "Betty", "Mark", "Margaret", "Steven", "Emily", "Andrew", "Olivia",
+	})
+	factory.RegisterDataset(factory.LocaleEnglish, factory.DatasetLastNames, []string{
+		"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis",
+		"Rodriguez", "Martinez", "Hernandez", "Lopez", "Wilson", "Anderson", "Thomas", "Taylor",
+		"Moore", "Jackson", "Martin", "Lee", "Thompson", "White", "Harris", "Clark",
+		"Lewis", "Robinson", "Walker", "Young", "Allen", "King", "Wright", "Scott",
+	})
+	factory.RegisterDataset(factory.LocaleEnglish, factory.DatasetCities, []string{
+		"New York", "Los Angeles", "Chicago", "Houston", "Phoenix", "Philadelphia",
+		"San Antonio", "San Diego", "Dallas", "Austin", "Seattle", "Denver", "Boston",
+		"Portland", "Atlanta", "Miami", "London", "Manchester", "Toronto", "Sydney",
+	})
+	factory.RegisterDataset(factory.LocaleEnglish, factory.DatasetStreets, []string{
+		"Main Street", "Oak Avenue", "Maple Drive", "Cedar Lane", "Pine Street", "Elm Street",
+		"Washington Avenue", "Lake View Road", "Hillcrest Drive", "Park Place", "Sunset Boulevard",
+		"Church Street", "Mill Road", "River Road", "Highland Avenue", "Spring Street",
+	})
+	factory.RegisterDataset(factory.LocaleEnglish, factory.DatasetWords, []string{
+		"account", "anchor", "balance", "bridge", "canvas", "circle", "delta", "engine",
+		"field", "garden", "harbor", "island", "journey", "kernel", "ladder", "meadow",
+		"number", "orbit", "pattern", "quartz", "river", "signal", "timber", "update",
+		"valley", "window", "yellow", "zephyr",
+	})
+}
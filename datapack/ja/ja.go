@@ -0,0 +1,30 @@
+// Package ja registers Japanese datasets for factory.DatasetFactory. Import it for its side
+// effects:
+//
+//	import _ "github.com/lihs-ie/forge/datapack/ja"
+package ja
+
+import "github.com/lihs-ie/forge/factory"
+
+func init() {
+	factory.RegisterDataset(factory.LocaleJapanese, factory.DatasetFirstNames, []string{
+		"太郎", "花子", "翔太", "陽菜", "蓮", "結衣", "大翔", "美咲", "悠真", "さくら",
+		"湊", "葵", "陽翔", "凛", "樹", "莉子", "颯太", "美羽", "健太", "七海",
+	})
+	factory.RegisterDataset(factory.LocaleJapanese, factory.DatasetLastNames, []string{
+		"佐藤", "鈴木", "高橋", "田中", "伊藤", "渡辺", "山本", "中村", "小林", "加藤",
+		"吉田", "山田", "佐々木", "山口", "松本", "井上", "木村", "林", "斎藤", "清水",
+	})
+	factory.RegisterDataset(factory.LocaleJapanese, factory.DatasetCities, []string{
+		"東京都", "横浜市", "大阪市", "名古屋市", "札幌市", "福岡市", "神戸市", "川崎市",
+		"京都市", "さいたま市", "広島市", "仙台市", "千葉市", "北九州市", "堺市", "新潟市",
+	})
+	factory.RegisterDataset(factory.LocaleJapanese, factory.DatasetStreets, []string{
+		"中央通り", "本町", "駅前通り", "桜通り", "緑町", "栄町", "旭町", "昭和通り",
+		"港町", "新町", "寿町", "元町",
+	})
+	factory.RegisterDataset(factory.LocaleJapanese, factory.DatasetWords, []string{
+		"空", "海", "山", "川", "森", "花", "風", "雨", "雪", "光", "星", "月",
+		"道", "街", "橋", "窓", "本", "声", "夢", "時",
+	})
+}
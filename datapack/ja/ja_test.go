@@ -0,0 +1,21 @@
+package ja
+
+import (
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+func TestDatasetsAreRegistered(t *testing.T) {
+	for _, name := range []string{
+		factory.DatasetFirstNames,
+		factory.DatasetLastNames,
+		factory.DatasetCities,
+		factory.DatasetStreets,
+		factory.DatasetWords,
+	} {
+		if values, ok := factory.LookupDataset(factory.LocaleJapanese, name); !ok || len(values) == 0 {
+			t.Fatalf("expected dataset %q to be registered", name)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+// Package export writes generated fixtures as JSON, CSV or SQL files, optionally stamped with
+// provenance metadata so an exported dataset can be traced back to the seed and factories that
+// produced it and regenerated later.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+const modulePath = "github.com/lihs-ie/forge"
+
+// Provenance describes how an exported dataset was generated.
+type Provenance struct {
+	Library          string   `json:"library"`
+	ModuleVersion    string   `json:"moduleVersion"`
+	GeneratorVersion string   `json:"generatorVersion"`
+	Seed             int64    `json:"seed"`
+	Factories        []string `json:"factories"`
+}
+
+// NewProvenance records seed, the names of the factory types and the library versions. Pass the
+// seed given to BuildListWith so the dataset can be rebuilt.
+func NewProvenance(seed int64, factories ...any) Provenance {
+	names := make([]string, len(factories))
+	for index, value := range factories {
+		names[index] = reflect.TypeOf(value).String()
+	}
+
+	return Provenance{
+		Library:          modulePath,
+		ModuleVersion:    moduleVersion(),
+		GeneratorVersion: factory.GeneratorVersion,
+		Seed:             seed,
+		Factories:        names,
+	}
+}
+
+// lines renders the provenance as "key: value" lines for comment headers.
+func (p Provenance) lines() []string {
+	return []string{
+		"generated by " + p.Library + " " + p.ModuleVersion,
+		"generator version: " + p.GeneratorVersion,
+		"seed: " + strconv.FormatInt(p.Seed, 10),
+		"factories: " + strings.Join(p.Factories, ", "),
+	}
+}
+
+// moduleVersion returns the version of this module in the running binary, or "(devel)".
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, dependency := range info.Deps {
+		if dependency.Path == modulePath {
+			return dependency.Version
+		}
+	}
+	return "(devel)"
+}
+
+// Option configures an export.
+type Option func(*options)
+
+type options struct {
+	provenance *Provenance
+}
+
+// WithProvenance stamps the export with provenance: a manifest object in JSON and a comment
+// header in CSV and SQL.
+func WithProvenance(provenance Provenance) Option {
+	return func(opts *options) {
+		opts.provenance = &provenance
+	}
+}
+
+func collectOptions(opts []Option) options {
+	config := options{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// document is the JSON layout used when provenance is present.
+type document[T any] struct {
+	Provenance Provenance `json:"provenance"`
+	Records    []T        `json:"records"`
+}
+
+// JSON writes records as an indented JSON array, or as {"provenance": ..., "records": [...]}
+// when stamped with WithProvenance.
+func JSON[T any](w io.Writer, records []T, opts ...Option) error {
+	config := collectOptions(opts)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	var err error
+	if config.provenance != nil {
+		err = encoder.Encode(document[T]{Provenance: *config.provenance, Records: records})
+	} else {
+		err = encoder.Encode(records)
+	}
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	return nil
+}
+
+// CSV writes records, which must be structs or pointers to structs, with a header row of their
+// exported field names (or json tag names). Provenance is written first as "# " comment lines.
+func CSV[T any](w io.Writer, records []T, opts ...Option) error {
+	config := collectOptions(opts)
+
+	columns, err := columnsOf[T]()
+	if err != nil {
+		return err
+	}
+
+	if config.provenance != nil {
+		for _, line := range config.provenance.lines() {
+			if _, err := fmt.Fprintf(w, "# %s\n", line); err != nil {
+				return fmt.Errorf("export: %w", err)
+			}
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	header := make([]string, len(columns))
+	for index, column := range columns {
+		header[index] = column.name
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+
+	for _, record := range records {
+		value := reflect.Indirect(reflect.ValueOf(record))
+		row := make([]string, len(columns))
+		for index, column := range columns {
+			row[index] = formatText(fieldOf(value, column))
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("export: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	return nil
+}
+
+// SQL writes one INSERT statement into table per record, with the columns used by CSV.
+// Provenance is written first as "-- " comment lines.
+func SQL[T any](w io.Writer, table string, records []T, opts ...Option) error {
+	config := collectOptions(opts)
+
+	columns, err := columnsOf[T]()
+	if err != nil {
+		return err
+	}
+
+	var builder strings.Builder
+	if config.provenance != nil {
+		for _, line := range config.provenance.lines() {
+			builder.WriteString("-- " + line + "\n")
+		}
+	}
+
+	names := make([]string, len(columns))
+	for index, column := range columns {
+		names[index] = column.name
+	}
+	prefix := "INSERT INTO " + table + " (" + strings.Join(names, ", ") + ") VALUES ("
+
+	for _, record := range records {
+		value := reflect.Indirect(reflect.ValueOf(record))
+		literals := make([]string, len(columns))
+		for index, column := range columns {
+			literals[index] = formatSQL(fieldOf(value, column))
+		}
+		builder.WriteString(prefix + strings.Join(literals, ", ") + ");\n")
+	}
+
+	if _, err := io.WriteString(w, builder.String()); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	return nil
+}
+
+type column struct {
+	name  string
+	index int
+}
+
+func columnsOf[T any]() ([]column, error) {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("export: %s is not a struct", typ)
+	}
+
+	var columns []column
+	for index := range typ.NumField() {
+		field := typ.Field(index)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		columns = append(columns, column{name: name, index: index})
+	}
+
+	return columns, nil
+}
+
+// fieldOf returns the column of record, or an invalid value for nil records.
+func fieldOf(record reflect.Value, column column) reflect.Value {
+	if !record.IsValid() {
+		return reflect.Value{}
+	}
+	return reflect.Indirect(record.Field(column.index))
+}
+
+func formatText(value reflect.Value) string {
+	if !value.IsValid() {
+		return ""
+	}
+	if stamp, ok := value.Interface().(time.Time); ok {
+		return stamp.Format(time.RFC3339Nano)
+	}
+	if stringer, ok := value.Interface().(fmt.Stringer); ok {
+		return stringer.String()
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Struct, reflect.Array:
+		encoded, err := json.Marshal(value.Interface())
+		if err != nil {
+			return fmt.Sprint(value.Interface())
+		}
+		return string(encoded)
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
+
+func formatSQL(value reflect.Value) string {
+	if !value.IsValid() {
+		return "NULL"
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return strings.ToUpper(strconv.FormatBool(value.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if _, ok := value.Interface().(fmt.Stringer); !ok {
+			return fmt.Sprint(value.Interface())
+		}
+	}
+
+	return "'" + strings.ReplaceAll(formatText(value), "'", "''") + "'"
+}
@@ -0,0 +1,121 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+type user struct {
+	ID      int       `json:"id"`
+	Name    string    `json:"name"`
+	Active  bool      `json:"active"`
+	Joined  time.Time `json:"joined"`
+	Nick    *string   `json:"nick"`
+	Secret  string    `json:"-"`
+	private string
+}
+
+func sampleUsers() []user {
+	nick := "o'neil"
+	return []user{
+		{ID: 1, Name: "alice", Active: true, Joined: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Nick: &nick},
+		{ID: 2, Name: "bob", Secret: "hidden", private: "hidden"},
+	}
+}
+
+func TestJSONWithProvenance(t *testing.T) {
+	provenance := NewProvenance(42, &factory.IntFactory{})
+
+	var buffer bytes.Buffer
+	if err := JSON(&buffer, sampleUsers(), WithProvenance(provenance)); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded struct {
+		Provenance Provenance `json:"provenance"`
+		Records    []user     `json:"records"`
+	}
+	if err := json.Unmarshal(buffer.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Provenance.Seed != 42 || decoded.Provenance.GeneratorVersion != factory.GeneratorVersion {
+		t.Fatalf("unexpected provenance %+v", decoded.Provenance)
+	}
+	if len(decoded.Provenance.Factories) != 1 || decoded.Provenance.Factories[0] != "*factory.IntFactory" {
+		t.Fatalf("unexpected factory names %v", decoded.Provenance.Factories)
+	}
+	if len(decoded.Records) != 2 || decoded.Records[0].Name != "alice" {
+		t.Fatalf("unexpected records %+v", decoded.Records)
+	}
+}
+
+func TestJSONWithoutProvenanceIsAnArray(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := JSON(&buffer, sampleUsers()); err != nil {
+		t.Fatal(err)
+	}
+
+	var records []user
+	if err := json.Unmarshal(buffer.Bytes(), &records); err != nil || len(records) != 2 {
+		t.Fatalf("expected a plain array, got %s: %v", buffer.String(), err)
+	}
+}
+
+func TestCSVWithProvenance(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := CSV(&buffer, sampleUsers(), WithProvenance(NewProvenance(7))); err != nil {
+		t.Fatal(err)
+	}
+
+	output := buffer.String()
+	if !strings.HasPrefix(output, "# generated by github.com/lihs-ie/forge") || !strings.Contains(output, "# seed: 7\n") {
+		t.Fatalf("expected a provenance header, got:\n%s", output)
+	}
+
+	reader := csv.NewReader(strings.NewReader(output))
+	reader.Comment = '#'
+	rows, err := reader.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(rows[0], ",") != "id,name,active,joined,nick" {
+		t.Fatalf("unexpected header %v", rows[0])
+	}
+	if strings.Join(rows[1], ",") != "1,alice,true,2024-01-02T03:04:05Z,o'neil" || rows[2][4] != "" {
+		t.Fatalf("unexpected rows %v", rows[1:])
+	}
+}
+
+func TestSQLWithProvenance(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := SQL(&buffer, "users", sampleUsers(), WithProvenance(NewProvenance(7))); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	if !strings.HasPrefix(lines[0], "-- generated by") {
+		t.Fatalf("expected a provenance comment, got %q", lines[0])
+	}
+
+	expected := "INSERT INTO users (id, name, active, joined, nick) VALUES (1, 'alice', TRUE, '2024-01-02T03:04:05Z', 'o''neil');"
+	if lines[len(lines)-2] != expected {
+		t.Fatalf("unexpected statement %q", lines[len(lines)-2])
+	}
+	if !strings.HasSuffix(lines[len(lines)-1], "FALSE, '0001-01-01T00:00:00Z', NULL);") {
+		t.Fatalf("unexpected statement %q", lines[len(lines)-1])
+	}
+}
+
+func TestCSVRejectsNonStructs(t *testing.T) {
+	if err := CSV(&bytes.Buffer{}, []int{1}); err == nil {
+		t.Fatal("expected an error for non-struct records")
+	}
+}
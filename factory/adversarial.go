@@ -0,0 +1,31 @@
+package factory
+
+// adversarialSalt decorrelates the edge-case decision from values factories derive from the same seed.
+const adversarialSalt = 0x0AD5_E75A
+
+// adversarialRate is the fraction of adversarial builds that produce an edge case.
+const adversarialRate = 0.5
+
+// AdversarialCapable is implemented by factories that can bias generation toward edge cases.
+// Adversarial must return a copy of the factory in adversarial mode, leaving the receiver untouched.
+type AdversarialCapable[T any, P any] interface {
+	Adversarial() Factory[T, P]
+}
+
+// AdversarialMode makes the builder use the factory's adversarial variant when it implements
+// AdversarialCapable: about half of the generated values become edge cases such as empty or
+// maximum-length strings, control characters, zero or negative amounts and epoch timestamps.
+// Values set through overrides are never replaced.
+func AdversarialMode() BuilderOption {
+	return func(opts *builderOptions) {
+		opts.adversarial = true
+	}
+}
+
+// adversarialCase reports whether seed should produce an edge case and, if so, which of count cases.
+func adversarialCase(seed int64, count int) (int, bool) {
+	if !BoolAt(seed^adversarialSalt, adversarialRate) {
+		return 0, false
+	}
+	return int(IntAt(seed, 0, int64(count-1))), true
+}
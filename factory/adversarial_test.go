@@ -0,0 +1,92 @@
+package factory
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdversarialModeProducesStringEdgeCases(t *testing.T) {
+	builder := Builder(&StringFactory{Min: 3, Max: 8}, AdversarialMode())
+
+	edges := map[string]bool{}
+	for _, value := range builder.BuildListWith(500, 1, nil) {
+		switch value {
+		case "", "aaa", "aaaaaaaa", " \t\n", "\x00\x1b[31m\u202e\u200b", "é漢字😀", "' OR '1'='1":
+			edges[value] = true
+		}
+	}
+
+	if len(edges) != stringEdgeCases {
+		t.Fatalf("expected all %d edge cases, got %d", stringEdgeCases, len(edges))
+	}
+}
+
+func TestAdversarialModeKeepsOverrides(t *testing.T) {
+	builder := Builder(&StringFactory{}, AdversarialMode())
+	override := Override[StringProperties](map[string]any{"value": "kept"})
+
+	for _, value := range builder.BuildList(50, override) {
+		if value != "kept" {
+			t.Fatalf("expected override to win, got %q", value)
+		}
+	}
+}
+
+func TestAdversarialModeLeavesFactoryUntouched(t *testing.T) {
+	factory := &StringFactory{}
+	Builder(factory, AdversarialMode())
+
+	if factory.adversarial {
+		t.Fatal("expected the original factory to stay in normal mode")
+	}
+	for seed := range int64(200) {
+		if value := Builder(factory).BuildWith(seed, nil); value == "" {
+			t.Fatal("expected no edge cases without AdversarialMode")
+		}
+	}
+}
+
+func TestAdversarialStringGenerateMatchesBuild(t *testing.T) {
+	factory := &StringFactory{}
+	adversarial, _ := factory.Adversarial().(*StringFactory)
+	builder := Builder(factory, AdversarialMode())
+
+	for seed := range int64(100) {
+		if adversarial.Generate(seed) != builder.BuildWith(seed, nil) {
+			t.Fatalf("expected Generate to match Build for seed %d", seed)
+		}
+		if string(adversarial.AppendTo(nil, seed)) != adversarial.Generate(seed) {
+			t.Fatalf("expected AppendTo to match Generate for seed %d", seed)
+		}
+	}
+}
+
+func TestAdversarialModeProducesMoneyEdgeCases(t *testing.T) {
+	builder := Builder(&MoneyFactory{Min: 100, Max: 500}, AdversarialMode())
+
+	seen := map[int64]bool{}
+	for _, money := range builder.BuildListWith(500, 1, nil) {
+		seen[money.Amount] = true
+	}
+
+	for _, edge := range []int64{0, 1, 100, 500, -500} {
+		if !seen[edge] {
+			t.Fatalf("expected edge amount %d to be generated", edge)
+		}
+	}
+}
+
+func TestAdversarialModeProducesEpochTimeSeries(t *testing.T) {
+	builder := Builder(&TimeSeriesFactory{Count: 2}, AdversarialMode())
+
+	found := false
+	for _, points := range builder.BuildListWith(100, 1, nil) {
+		if points[0].Timestamp.Equal(time.Unix(0, 0)) {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Fatal("expected an epoch-based series")
+	}
+}
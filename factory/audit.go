@@ -0,0 +1,159 @@
+package factory
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// OverrideAudit counts how overrides fare inside a factory's Prepare. A field counts as applied
+// when an override changed it, and as lost when Prepare later replaced the overridden value, the
+// silent-override-loss bug that occurs when defaults are assigned after overrides. Report, when
+// set, is called for every loss; pass t.Errorf to fail the test or log.Printf to only warn.
+// It is safe for concurrent use.
+type OverrideAudit struct {
+	Report func(format string, args ...any)
+
+	mu      sync.Mutex
+	applied map[string]int
+	lost    map[string]int
+}
+
+// AuditOverrides records override application for every build of the builder in audit. Fields
+// equal to the factory default are not detected, as overriding them changes nothing.
+func AuditOverrides(audit *OverrideAudit) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.audit = audit
+	}
+}
+
+// Applied returns how many builds had field changed by an override.
+func (a *OverrideAudit) Applied(field string) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.applied[field]
+}
+
+// Lost returns how many builds had an overridden field replaced by Prepare.
+func (a *OverrideAudit) Lost(field string) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.lost[field]
+}
+
+// LostFields returns every field that lost an override at least once, in sorted order.
+func (a *OverrideAudit) LostFields() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	fields := make([]string, 0, len(a.lost))
+	for field := range a.lost {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+func (a *OverrideAudit) record(typ reflect.Type, applied, lost []string) {
+	a.mu.Lock()
+	if a.applied == nil {
+		a.applied = make(map[string]int)
+		a.lost = make(map[string]int)
+	}
+	for _, field := range applied {
+		a.applied[field]++
+	}
+	for _, field := range lost {
+		a.lost[field]++
+	}
+	a.mu.Unlock()
+
+	if a.Report != nil {
+		for _, field := range lost {
+			a.Report("override: %s.%s was overridden but replaced by Prepare", typ, field)
+		}
+	}
+}
+
+// auditedFactory compares properties right after overrides run with the result of Prepare.
+type auditedFactory[T any, P any] struct {
+	inner Factory[T, P]
+	audit *OverrideAudit
+}
+
+func (f *auditedFactory[T, P]) Instantiate(properties P) T {
+	return f.inner.Instantiate(properties)
+}
+
+func (f *auditedFactory[T, P]) Prepare(overrides Partial[P], seed int64) P {
+	if overrides == nil || reflect.TypeFor[P]().Kind() != reflect.Struct {
+		return f.inner.Prepare(overrides, seed)
+	}
+
+	var before, after P
+	var applied []int
+	audited := func(properties *P) {
+		before = *properties
+		overrides(properties)
+		after = *properties
+		applied = changedFields(&before, &after)
+	}
+
+	prepared := f.inner.Prepare(audited, seed)
+
+	appliedNames := make([]string, 0, len(applied))
+	lostNames := make([]string, 0)
+	typ := reflect.TypeFor[P]()
+	for _, index := range applied {
+		name := typ.Field(index).Name
+		appliedNames = append(appliedNames, name)
+		if !fieldsEqual(&after, &prepared, index) {
+			lostNames = append(lostNames, name)
+		}
+	}
+
+	if len(appliedNames) > 0 {
+		f.audit.record(typ, appliedNames, lostNames)
+	}
+
+	return prepared
+}
+
+func (f *auditedFactory[T, P]) Retrieve(instance T) P {
+	return f.inner.Retrieve(instance)
+}
+
+func (f *auditedFactory[T, P]) memoized(seed int64, build func() T) T {
+	if memoizer, ok := f.inner.(seedMemoizer[T]); ok {
+		return memoizer.memoized(seed, build)
+	}
+	return build()
+}
+
+// changedFields returns the indexes of the comparable fields that differ between before and after.
+func changedFields[P any](before, after *P) []int {
+	var changed []int
+	for index := range reflect.TypeFor[P]().NumField() {
+		if !fieldsEqual(before, after, index) {
+			changed = append(changed, index)
+		}
+	}
+	return changed
+}
+
+// fieldsEqual reports whether field index holds equal values in a and b. Func and channel fields
+// cannot be compared meaningfully and always count as equal.
+func fieldsEqual[P any](a, b *P, index int) bool {
+	left := exposeField(reflect.ValueOf(a).Elem().Field(index))
+	right := exposeField(reflect.ValueOf(b).Elem().Field(index))
+
+	switch left.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	default:
+		return reflect.DeepEqual(left.Interface(), right.Interface())
+	}
+}
@@ -0,0 +1,90 @@
+package factory
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type auditTestProperties struct {
+	name    string
+	role    string
+	handler func()
+}
+
+// auditTestFactory assigns role after overrides run, losing any overridden role.
+type auditTestFactory struct{}
+
+func (f *auditTestFactory) Instantiate(properties auditTestProperties) auditTestProperties {
+	return properties
+}
+
+func (f *auditTestFactory) Prepare(overrides Partial[auditTestProperties], seed int64) auditTestProperties {
+	properties := auditTestProperties{}
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.name == "" {
+		properties.name = fmt.Sprintf("user-%d", seed)
+	}
+	properties.role = "member"
+
+	return properties
+}
+
+func (f *auditTestFactory) Retrieve(instance auditTestProperties) auditTestProperties {
+	return instance
+}
+
+func TestAuditOverridesReportsLostOverrides(t *testing.T) {
+	var mu sync.Mutex
+	var reports []string
+	audit := &OverrideAudit{Report: func(format string, args ...any) {
+		mu.Lock()
+		defer mu.Unlock()
+		reports = append(reports, fmt.Sprintf(format, args...))
+	}}
+
+	builder := Builder(&auditTestFactory{}, AuditOverrides(audit))
+	builder.BuildList(3, Override[auditTestProperties](map[string]any{"name": "alice", "role": "admin"}))
+
+	if audit.Applied("name") != 3 || audit.Applied("role") != 3 {
+		t.Fatalf("expected both fields applied three times, got %d and %d", audit.Applied("name"), audit.Applied("role"))
+	}
+	if audit.Lost("name") != 0 || audit.Lost("role") != 3 {
+		t.Fatalf("expected only role lost, got %d and %d", audit.Lost("name"), audit.Lost("role"))
+	}
+	if !slices.Equal(audit.LostFields(), []string{"role"}) {
+		t.Fatalf("unexpected lost fields %v", audit.LostFields())
+	}
+	if len(reports) != 3 || !strings.Contains(reports[0], "auditTestProperties.role") {
+		t.Fatalf("expected one report per lost override, got %v", reports)
+	}
+}
+
+func TestAuditOverridesIgnoresBuildsWithoutOverrides(t *testing.T) {
+	audit := &OverrideAudit{Report: func(format string, args ...any) {
+		t.Errorf(format, args...)
+	}}
+
+	builder := Builder(&auditTestFactory{}, AuditOverrides(audit))
+	builder.BuildList(5, nil)
+	builder.Build(Override[auditTestProperties](map[string]any{"handler": func() {}}))
+
+	if len(audit.LostFields()) != 0 || audit.Applied("name") != 0 {
+		t.Fatalf("expected nothing recorded, got %v", audit.LostFields())
+	}
+}
+
+func TestAuditOverridesKeepsResults(t *testing.T) {
+	audited := Builder(&auditTestFactory{}, AuditOverrides(&OverrideAudit{}))
+	plain := Builder(&auditTestFactory{})
+
+	overrides := Override[auditTestProperties](map[string]any{"name": "bob"})
+	if got, want := audited.BuildWith(4, overrides), plain.BuildWith(4, overrides); got.name != want.name || got.role != want.role {
+		t.Fatalf("expected auditing not to change the result, got %+v and %+v", got, want)
+	}
+}
@@ -0,0 +1,42 @@
+package factory
+
+import "fmt"
+
+// BoundaryCapable is implemented by factories whose constraints have meaningful boundaries.
+type BoundaryCapable[T any] interface {
+	Boundaries() []T
+}
+
+// Boundary returns the boundary values of factory's constraints (minimum, minimum+1, maximum-1 and
+// maximum) as a deterministic list, for table-driven boundary tests:
+//
+//	for _, name := range factory.Boundary(&factory.StringFactory{Min: 1, Max: 50}) { ... }
+//
+// It panics when factory does not implement BoundaryCapable.
+func Boundary[T any, P any](factory Factory[T, P]) []T {
+	capable, ok := factory.(BoundaryCapable[T])
+	if !ok {
+		panic(fmt.Sprintf("boundary: %T does not implement BoundaryCapable", factory))
+	}
+	return capable.Boundaries()
+}
+
+// boundaryPoints returns minimum, minimum+1, maximum-1 and maximum in order, without duplicates.
+func boundaryPoints(minimum, maximum int64) []int64 {
+	if maximum < minimum {
+		maximum = minimum
+	}
+
+	points := make([]int64, 0, 4)
+	for _, point := range []int64{minimum, minimum + 1, maximum - 1, maximum} {
+		if point < minimum || point > maximum {
+			continue
+		}
+		if len(points) > 0 && points[len(points)-1] >= point {
+			continue
+		}
+		points = append(points, point)
+	}
+
+	return points
+}
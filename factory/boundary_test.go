@@ -0,0 +1,59 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBoundaryPoints(t *testing.T) {
+	cases := []struct {
+		minimum, maximum int64
+		expected         string
+	}{
+		{1, 10, "[1 2 9 10]"},
+		{1, 3, "[1 2 3]"},
+		{1, 2, "[1 2]"},
+		{5, 5, "[5]"},
+		{5, 1, "[5]"},
+	}
+
+	for _, test := range cases {
+		if got := fmt.Sprint(boundaryPoints(test.minimum, test.maximum)); got != test.expected {
+			t.Errorf("boundaryPoints(%d, %d) = %s, expected %s", test.minimum, test.maximum, got, test.expected)
+		}
+	}
+}
+
+func TestBoundaryStringLengths(t *testing.T) {
+	values := Boundary(&StringFactory{Min: 2, Max: 6})
+
+	lengths := make([]int, len(values))
+	for index, value := range values {
+		lengths[index] = len(value)
+	}
+	if fmt.Sprint(lengths) != "[2 3 5 6]" {
+		t.Fatalf("expected lengths [2 3 5 6], got %v", lengths)
+	}
+
+	if fmt.Sprint(values) != fmt.Sprint(Boundary(&StringFactory{Min: 2, Max: 6})) {
+		t.Fatal("expected boundaries to be deterministic")
+	}
+}
+
+func TestBoundaryMoneyAmounts(t *testing.T) {
+	values := Boundary(&MoneyFactory{Min: 100, Max: 500, Currencies: []Currency{Currencies.JPY}, Sign: SignNegative})
+
+	if fmt.Sprint(values) != "[-100 JPY -101 JPY -499 JPY -500 JPY]" {
+		t.Fatalf("unexpected boundaries %v", values)
+	}
+}
+
+func TestBoundaryPanicsForUnsupportedFactory(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for factory without boundaries")
+		}
+	}()
+
+	Boundary(&BoolFactory{})
+}
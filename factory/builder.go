@@ -1,14 +1,17 @@
 package factory
 
 import (
+	"fmt"
 	"math/rand"
-
-	"github.com/lihs-ie/forge/internal/collections"
+	"sync"
+	"time"
 )
 
 const maxSafeInteger = 1<<53 - 1
 
-// BuilderHandle exposes the supported build operations for a factory.
+// BuilderHandle exposes the supported build operations for a factory. Further operations, such as
+// BuildE, Shared or Anonymize, are package-level functions over a handle created by Builder, so
+// the interface keeps to its core methods.
 type BuilderHandle[T any, P any] interface {
 	Build(overrides any) T
 	BuildList(size int, overrides any) []T
@@ -17,55 +20,227 @@ type BuilderHandle[T any, P any] interface {
 	Duplicate(instance T, overrides any) T
 }
 
+// BuilderOf is satisfied by every BuilderHandle building T, whatever its properties type. The
+// package-level builder functions accept it, so their type arguments are inferred from the handle.
+type BuilderOf[T any] interface {
+	Build(overrides any) T
+}
+
+// builderExtensions backs the package-level functions over a BuilderHandle.
+type builderExtensions[T any] interface {
+	buildE(overrides any) (T, error)
+	buildListE(size int, overrides any) ([]T, error)
+	buildListChunked(total, chunkSize int, overrides any, fn func([]T) error) error
+	buildStratified(strata ...Stratum) []T
+	buildCombinations(variations ...Variation) []T
+	duplicateAsNew(instance T, overrides any) T
+	anonymize(instance T, fields ...string) T
+	buildShared(name string, overrides any) T
+	resetShared()
+}
+
+// extensionsOf returns the extended operations of builder, panicking for handles not created
+// through Builder.
+func extensionsOf[T any](builder BuilderOf[T], operation string) builderExtensions[T] {
+	extensions, ok := builder.(builderExtensions[T])
+	if !ok {
+		panic(operation + ": builder must be created via Builder()")
+	}
+	return extensions
+}
+
+// Stratum describes one class of instances for BuildStratified.
+type Stratum struct {
+	Count     int
+	Overrides any
+}
+
+// CleanupRegistrar is satisfied by *testing.T, *testing.B and testing.TB.
+type CleanupRegistrar interface {
+	Cleanup(func())
+}
+
+// ResetSharedOnCleanup discards shared instances of the given builders when the test finishes.
+// Builders of other types need a call of their own.
+func ResetSharedOnCleanup[T any](registrar CleanupRegistrar, builders ...BuilderOf[T]) {
+	for _, builder := range builders {
+		extensionsOf(builder, "reset shared")
+	}
+
+	registrar.Cleanup(func() {
+		for _, builder := range builders {
+			ResetShared(builder)
+		}
+	})
+}
+
 type builderInstance[T any, P any] struct {
 	factory         Factory[T, P]
 	nextSeed        func() int64
 	nextSeeds       func(size int) []int64
-	convertOverride func(any) Partial[P]
+	appendSeeds     func(next []int64, size int) []int64
+	convertOverride func(any) Overrider[P]
+	identity        identityConfig
+	distinct        bool
+	bulk            bool
+	seedBuffers     sync.Pool
+	sharedMu        sync.Mutex
+	shared          map[string]T
 }
 
 var _ BuilderHandle[any, any] = (*builderInstance[any, any])(nil)
 
+type builderOptions struct {
+	locale        Locale
+	stableVersion string
+	source        rand.Source
+	identity      identityConfig
+	adversarial   bool
+	namespace     string
+	distinct      bool
+	anchor        time.Time
+	audit         *OverrideAudit
+	required      []string
+	bulk          bool
+}
+
+// BuilderOption configures a BuilderHandle created by Builder.
+type BuilderOption func(*builderOptions)
+
+// WithLocale binds the builder to locale when the factory implements Localizable,
+// taking precedence over the global locale.
+func WithLocale(locale Locale) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.locale = locale
+	}
+}
+
+// WithSeedSource gives the builder its own random stream for Build and BuildList seeds instead of
+// the shared global one. Concurrent builds on the resulting builder are safe, but which goroutine
+// receives which seed depends on scheduling; give each goroutine its own builder through
+// WithSeedStream for reproducible output.
+func WithSeedSource(source rand.Source) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.source = source
+	}
+}
+
 // Builder creates a BuilderHandle for the provided Factory.
-func Builder[T any, P any](factory Factory[T, P]) BuilderHandle[T, P] {
-	seeds := collections.NewSet[int64](nil)
+func Builder[T any, P any](factory Factory[T, P], opts ...BuilderOption) BuilderHandle[T, P] {
+	config := builderOptions{}
+	for _, opt := range opts {
+		opt(&config)
+	}
 
-	nextSeeds := func(size int) []int64 {
-		next := make([]int64, 0, size)
-
-		for len(next) < size {
-			//nolint:gosec // G404: math/rand is acceptable for deterministic test data generation
-			seed := rand.Int63n(maxSafeInteger)
-			if !seeds.Has(seed) {
-				next = append(next, seed)
-				seeds.Set(seed)
+	if config.locale != "" {
+		if localizable, ok := factory.(Localizable[T, P]); ok {
+			factory = localizable.Localized(config.locale)
+		}
+	}
+
+	if !config.anchor.IsZero() {
+		if anchorable, ok := factory.(Anchorable[T, P]); ok {
+			factory = anchorable.Anchored(config.anchor)
+		}
+	}
+
+	if config.adversarial {
+		if capable, ok := factory.(AdversarialCapable[T, P]); ok {
+			factory = capable.Adversarial()
+		}
+	}
+
+	if config.identity.fields == nil {
+		if identifiable, ok := factory.(Identifiable); ok {
+			config.identity.fields = identifiable.IdentityFields()
+		}
+	}
+
+	if config.required == nil {
+		if requiring, ok := factory.(Requiring); ok {
+			config.required = requiring.RequiredFields()
+		}
+	}
+
+	if config.stableVersion != "" {
+		requireGeneratorVersion(config.stableVersion)
+		if config.source == nil {
+			config.source = rand.NewSource(stableSeedSource)
+		}
+	}
+
+	//nolint:gosec // G404: math/rand is acceptable for deterministic test data generation
+	randomSeed := rand.Int63n
+	if config.source != nil {
+		//nolint:gosec // G404: math/rand is acceptable for deterministic test data generation
+		randomSeed = rand.New(config.source).Int63n
+	}
+
+	var seedsMu sync.Mutex
+	//nolint:gosec // G115: randomSeed returns non-negative values
+	seeds := newSeedTracker(uint64(randomSeed(maxSafeInteger)))
+
+	appendSeeds := func(next []int64, size int) []int64 {
+		seedsMu.Lock()
+		defer seedsMu.Unlock()
+
+		if !config.bulk || size == 1 {
+			for range size {
+				next = append(next, seeds.next())
 			}
+			return next
+		}
+
+		for target := len(next) + size; len(next) < target; {
+			remaining := target - len(next)
+			next = seeds.appendRun(next, singleSeedSpan+randomSeed(maxSafeInteger-singleSeedSpan-int64(remaining)), remaining)
 		}
 
 		return next
 	}
 
+	nextSeeds := func(size int) []int64 {
+		return appendSeeds(make([]int64, 0, size), size)
+	}
+
 	nextSeed := func() int64 {
 		return nextSeeds(1)[0]
 	}
 
-	convertOverride := func(override any) Partial[P] {
+	convertOverride := func(override any) Overrider[P] {
 		if override == nil {
-			return nil
+			return Overrider[P]{}
 		}
 
 		overrider, ok := override.(Overrider[P])
 		if !ok {
 			panic("builder: overrides must be generated via Override()")
 		}
-		return overrider.Func()
+		return overrider
+	}
+
+	if config.audit != nil {
+		factory = &auditedFactory[T, P]{inner: factory, audit: config.audit}
+	}
+
+	if len(config.required) > 0 {
+		factory = newRequiredFactory(factory, config.required)
+	}
+
+	if config.namespace != "" {
+		factory = &namespacedFactory[T, P]{inner: factory, mask: namespaceMask(config.namespace)}
 	}
 
 	return &builderInstance[T, P]{
 		factory:         factory,
 		nextSeed:        nextSeed,
 		nextSeeds:       nextSeeds,
+		appendSeeds:     appendSeeds,
 		convertOverride: convertOverride,
+		identity:        config.identity,
+		distinct:        config.distinct,
+		bulk:            config.bulk,
+		shared:          make(map[string]T),
 	}
 }
 
@@ -74,13 +249,41 @@ func (b *builderInstance[T, P]) Build(overrides any) T {
 	return create(b.factory, b.convertOverride(overrides), seed)
 }
 
+// BuildE is Build returning a *RequiredFieldsError instead of panicking when required fields stay
+// zero.
+func BuildE[T any](builder BuilderOf[T], overrides any) (T, error) {
+	return extensionsOf(builder, "build").buildE(overrides)
+}
+
+func (b *builderInstance[T, P]) buildE(overrides any) (instance T, err error) {
+	defer recoverRequired(&err)
+
+	return b.Build(overrides), nil
+}
+
+// BuildListE is BuildList returning a *RequiredFieldsError instead of panicking when required
+// fields stay zero.
+func BuildListE[T any](builder BuilderOf[T], size int, overrides any) ([]T, error) {
+	return extensionsOf(builder, "build").buildListE(size, overrides)
+}
+
+func (b *builderInstance[T, P]) buildListE(size int, overrides any) (instances []T, err error) {
+	defer recoverRequired(&err)
+
+	return b.BuildList(size, overrides), nil
+}
+
 func (b *builderInstance[T, P]) BuildList(size int, overrides any) []T {
-	seedList := b.nextSeeds(size)
+	seedList := b.borrowSeeds(size)
+	defer b.returnSeeds(seedList)
+
 	results := make([]T, 0, size)
 	converted := b.convertOverride(overrides)
+	seen := b.newDistinctTracker(size)
+	buffer := b.propertiesBuffer()
 
-	for _, seed := range seedList {
-		results = append(results, create(b.factory, converted, seed))
+	for _, seed := range *seedList {
+		results = append(results, b.createListed(converted, seed, seen, buffer))
 	}
 
 	return results
@@ -93,14 +296,134 @@ func (b *builderInstance[T, P]) BuildWith(seed int64, overrides any) T {
 func (b *builderInstance[T, P]) BuildListWith(size int, seed int64, overrides any) []T {
 	results := make([]T, 0, size)
 	converted := b.convertOverride(overrides)
+	seen := b.newDistinctTracker(size)
+	retry := seed + int64(size) - 1
+	next := func() int64 {
+		retry++
+		return retry
+	}
 
 	for i := range size {
-		results = append(results, create(b.factory, converted, seed+int64(i)))
+		if seen == nil {
+			results = append(results, create(b.factory, converted, seed+int64(i)))
+			continue
+		}
+		results = append(results, b.createDistinct(converted, seed+int64(i), seen, next))
 	}
 
 	return results
 }
 
+// BuildListChunked builds total instances with builder and hands them to fn in chunks of at most
+// chunkSize, so peak memory stays bounded by one chunk. The chunk slice is reused between calls
+// and must not be retained by fn. The first error returned by fn stops generation and is returned.
+// A non-positive total or chunkSize is reported as an error before anything is built.
+func BuildListChunked[T any](builder BuilderOf[T], total, chunkSize int, overrides any, fn func([]T) error) error {
+	return extensionsOf(builder, "build list chunked").buildListChunked(total, chunkSize, overrides, fn)
+}
+
+func (b *builderInstance[T, P]) buildListChunked(total, chunkSize int, overrides any, fn func([]T) error) error {
+	if total <= 0 || chunkSize <= 0 {
+		return fmt.Errorf("builder: chunked list needs a positive total and chunk size, got %d and %d", total, chunkSize)
+	}
+	chunkSize = min(chunkSize, total)
+
+	converted := b.convertOverride(overrides)
+	chunk := make([]T, 0, chunkSize)
+	seeds := make([]int64, 0, chunkSize)
+	seen := b.newDistinctTracker(total)
+	buffer := b.propertiesBuffer()
+
+	for remaining := total; remaining > 0; remaining -= len(chunk) {
+		chunk = chunk[:0]
+		seeds = b.appendSeeds(seeds[:0], min(chunkSize, remaining))
+		for _, seed := range seeds {
+			chunk = append(chunk, b.createListed(converted, seed, seen, buffer))
+		}
+
+		if err := fn(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BuildStratified builds exactly Count instances per stratum and returns them shuffled together.
+// The shuffle draws from the builder's seed stream, so it is reproducible in stable mode.
+func BuildStratified[T any](builder BuilderOf[T], strata ...Stratum) []T {
+	return extensionsOf(builder, "build stratified").buildStratified(strata...)
+}
+
+func (b *builderInstance[T, P]) buildStratified(strata ...Stratum) []T {
+	total := 0
+	for _, stratum := range strata {
+		total += stratum.Count
+	}
+
+	results := make([]T, 0, total)
+	for _, stratum := range strata {
+		results = append(results, b.BuildList(stratum.Count, stratum.Overrides)...)
+	}
+
+	shuffle(results, b.nextSeed())
+
+	return results
+}
+
 func (b *builderInstance[T, P]) Duplicate(instance T, overrides any) T {
-	return duplicate(b.factory, instance, b.convertOverride(overrides))
+	return duplicate(b.factory, instance, b.convertOverride(overrides).Func())
+}
+
+// DuplicateAsNew copies instance as a new record: identity fields are regenerated (or cleared,
+// see ClearIdentityFields) before overrides are applied. It panics when no identity fields are
+// registered through Identifiable or WithIdentityFields.
+func DuplicateAsNew[T any](builder BuilderOf[T], instance T, overrides any) T {
+	return extensionsOf(builder, "duplicate as new").duplicateAsNew(instance, overrides)
+}
+
+func (b *builderInstance[T, P]) duplicateAsNew(instance T, overrides any) T {
+	return duplicateAsNew(b.factory, instance, b.nextSeed(), b.identity, b.convertOverride(overrides).Func())
+}
+
+// Anonymize keeps instance but regenerates the listed properties with the factory defaults.
+// Field names are matched case-insensitively, as with Override.
+func Anonymize[T any](builder BuilderOf[T], instance T, fields ...string) T {
+	return extensionsOf(builder, "anonymize").anonymize(instance, fields...)
+}
+
+func (b *builderInstance[T, P]) anonymize(instance T, fields ...string) T {
+	return anonymize(b.factory, instance, b.nextSeed(), fields)
+}
+
+// Shared builds the named instance once per builder and returns it on every subsequent call until
+// ResetShared. Overrides are only applied on the first call for a given name.
+func Shared[T any](builder BuilderOf[T], name string, overrides any) T {
+	return extensionsOf(builder, "shared").buildShared(name, overrides)
+}
+
+// ResetShared discards the shared instances of builder.
+func ResetShared[T any](builder BuilderOf[T]) {
+	extensionsOf(builder, "reset shared").resetShared()
+}
+
+func (b *builderInstance[T, P]) buildShared(name string, overrides any) T {
+	b.sharedMu.Lock()
+	defer b.sharedMu.Unlock()
+
+	if instance, ok := b.shared[name]; ok {
+		return instance
+	}
+
+	instance := b.Build(overrides)
+	b.shared[name] = instance
+
+	return instance
+}
+
+func (b *builderInstance[T, P]) resetShared() {
+	b.sharedMu.Lock()
+	defer b.sharedMu.Unlock()
+
+	b.shared = make(map[string]T)
 }
@@ -1,10 +1,38 @@
 package factory
 
-func create[T any, P any](factory Factory[T, P], overrides Partial[P], seed int64) T {
-	properties := factory.Prepare(overrides, seed)
+import (
+	"fmt"
+	"reflect"
+)
+
+func create[T any, P any](factory Factory[T, P], overrides Overrider[P], seed int64) T {
+	partial, after := overrides.forSeed(seed), overrides.afterPrepare(seed)
+	if memoizer, ok := factory.(seedMemoizer[T]); ok && partial == nil && after == nil {
+		return memoizer.memoized(seed, func() T {
+			return factory.Instantiate(factory.Prepare(nil, seed))
+		})
+	}
+
+	properties := factory.Prepare(partial, seed)
+	if after != nil {
+		after(&properties)
+	}
 	return factory.Instantiate(properties)
 }
 
+// createInto is create preparing into properties, so list builds can reuse one buffer.
+func createInto[T any, P any](factory Factory[T, P], overrides Overrider[P], seed int64, properties *P) T {
+	if _, ok := factory.(seedMemoizer[T]); ok {
+		return create(factory, overrides, seed)
+	}
+
+	*properties = factory.Prepare(overrides.forSeed(seed), seed)
+	if after := overrides.afterPrepare(seed); after != nil {
+		after(properties)
+	}
+	return factory.Instantiate(*properties)
+}
+
 func duplicate[T any, P any](factory Factory[T, P], instance T, overrides Partial[P]) T {
 	properties := factory.Retrieve(instance)
 	if overrides != nil {
@@ -12,3 +40,64 @@ func duplicate[T any, P any](factory Factory[T, P], instance T, overrides Partia
 	}
 	return factory.Instantiate(properties)
 }
+
+func anonymize[T any, P any](factory Factory[T, P], instance T, seed int64, fields []string) T {
+	properties := factory.Retrieve(instance)
+	generated := factory.Prepare(nil, seed)
+	copyFields(&properties, &generated, fields)
+
+	return factory.Instantiate(properties)
+}
+
+func duplicateAsNew[T any, P any](
+	factory Factory[T, P],
+	instance T,
+	seed int64,
+	identity identityConfig,
+	overrides Partial[P],
+) T {
+	if len(identity.fields) == 0 {
+		panic("builder: no identity fields registered for DuplicateAsNew")
+	}
+
+	properties := factory.Retrieve(instance)
+
+	var source P
+	if !identity.clear {
+		source = factory.Prepare(nil, seed)
+	}
+	copyFields(&properties, &source, identity.fields)
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return factory.Instantiate(properties)
+}
+
+// copyFields overwrites the named fields of target with their values in source. Field names are
+// matched case-insensitively. Values are copied through reflection rather than the override
+// machinery, so setters and override hooks never see these internal copies.
+func copyFields[P any](target *P, source *P, fields []string) {
+	targetValue, sourceValue := reflect.ValueOf(target).Elem(), reflect.ValueOf(source).Elem()
+	if targetValue.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("builder: properties must be a struct, got %s", targetValue.Kind()))
+	}
+
+	for _, field := range fields {
+		from, _, found := lookupField(sourceValue, field, true)
+		to, _, ok := lookupField(targetValue, field, true)
+		if !found || !ok {
+			panic(fmt.Sprintf("builder: unknown field %q on %s", field, targetValue.Type()))
+		}
+		exposeField(to).Set(exposeField(from))
+	}
+}
+
+// shuffle permutes items in place with a Fisher-Yates shuffle driven by seed.
+func shuffle[T any](items []T, seed int64) {
+	for index := len(items) - 1; index > 0; index-- {
+		other := IntAt(seed+int64(index), 0, int64(index))
+		items[index], items[other] = items[other], items[index]
+	}
+}
@@ -0,0 +1,53 @@
+package factory
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestBuilderPoolHandsOutWorkingBuilders(t *testing.T) {
+	pool := NewBuilderPool(&StringFactory{Min: 4, Max: 8})
+
+	builder := pool.Get()
+	value := builder.Build(nil)
+	pool.Put(builder)
+
+	if len(value) < 4 || len(value) > 8 {
+		t.Fatalf("expected configured length, got %q", value)
+	}
+}
+
+func TestBuilderPoolConcurrentUse(t *testing.T) {
+	pool := NewBuilderPool(&StringFactory{})
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				builder := pool.Get()
+				builder.BuildList(10, nil)
+				Shared(builder, "tenant", nil)
+				pool.Put(builder)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWithSeedSourceIsIndependentAndReproducible(t *testing.T) {
+	first := Builder(&stubFactory{}, WithSeedSource(rand.NewSource(7))).BuildList(5, nil)
+	second := Builder(&stubFactory{}, WithSeedSource(rand.NewSource(7))).BuildList(5, nil)
+	other := Builder(&stubFactory{}, WithSeedSource(rand.NewSource(8))).BuildList(5, nil)
+
+	for index := range first {
+		if first[index] != second[index] {
+			t.Fatalf("expected identical seeds for identical sources at %d", index)
+		}
+	}
+	if first[0] == other[0] {
+		t.Fatal("expected different sources to produce different seeds")
+	}
+}
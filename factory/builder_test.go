@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -160,3 +161,268 @@ func TestBuilderDuplicateAppliesOverride(t *testing.T) {
 		t.Fatalf("base should remain unchanged, got %s", base.Value)
 	}
 }
+
+func TestBuilderSharedReturnsSameInstance(t *testing.T) {
+	factory := &stubFactory{}
+	builder := Builder(factory)
+
+	first := Shared(builder, "tenant", Override[stubProps](map[string]any{
+		"Value": "default-tenant",
+	}))
+	second := Shared(builder, "tenant", nil)
+
+	if first != second {
+		t.Fatalf("expected shared instance, got %+v and %+v", first, second)
+	}
+	if second.Value != "default-tenant" {
+		t.Fatalf("expected first overrides to stick, got %s", second.Value)
+	}
+	if len(factory.prepareSeeds) != 1 {
+		t.Fatalf("expected one prepare call, got %d", len(factory.prepareSeeds))
+	}
+
+	other := Shared(builder, "other", nil)
+	if other == first {
+		t.Fatal("expected distinct names to build distinct instances")
+	}
+}
+
+func TestBuilderResetSharedOnCleanup(t *testing.T) {
+	factory := &stubFactory{}
+	builder := Builder(factory)
+
+	var first stubInstance
+	t.Run("scope", func(t *testing.T) {
+		ResetSharedOnCleanup(t, builder)
+		first = Shared(builder, "tenant", nil)
+	})
+
+	second := Shared(builder, "tenant", nil)
+	if first == second {
+		t.Fatal("expected shared instance to be rebuilt after cleanup")
+	}
+}
+
+type anonymizedProps struct {
+	Email string
+	name  string
+	Plan  string
+}
+
+type anonymizedFactory struct{}
+
+func (f *anonymizedFactory) Instantiate(props anonymizedProps) anonymizedProps {
+	return props
+}
+
+func (f *anonymizedFactory) Prepare(overrides Partial[anonymizedProps], seed int64) anonymizedProps {
+	props := anonymizedProps{
+		Email: fmt.Sprintf("user-%d@example.com", seed),
+		name:  fmt.Sprintf("user-%d", seed),
+		Plan:  "free",
+	}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *anonymizedFactory) Retrieve(instance anonymizedProps) anonymizedProps {
+	return instance
+}
+
+func TestBuilderAnonymizeRegeneratesListedFields(t *testing.T) {
+	builder := Builder(&anonymizedFactory{})
+	original := anonymizedProps{Email: "jane@corp.example", name: "Jane Doe", Plan: "enterprise"}
+
+	safe := Anonymize(builder, original, "email", "Name")
+
+	if safe.Email == original.Email || safe.name == original.name {
+		t.Fatalf("expected sensitive fields to be regenerated, got %+v", safe)
+	}
+	if safe.Plan != "enterprise" {
+		t.Fatalf("expected untouched field to be kept, got %s", safe.Plan)
+	}
+	if original.Email != "jane@corp.example" {
+		t.Fatal("expected original instance to remain unchanged")
+	}
+}
+
+func TestBuilderAnonymizePanicsOnUnknownField(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic for unknown field")
+		}
+	}()
+
+	builder := Builder(&anonymizedFactory{})
+	Anonymize(builder, anonymizedProps{}, "phone")
+}
+
+func TestBuilderBuildListChunked(t *testing.T) {
+	factory := &stubFactory{}
+	builder := Builder(factory)
+
+	var sizes []int
+	seen := make(map[int64]struct{})
+	err := BuildListChunked(builder, 10, 4, Override[stubProps](map[string]any{
+		"Value": "chunked",
+	}), func(chunk []stubInstance) error {
+		sizes = append(sizes, len(chunk))
+		for _, instance := range chunk {
+			if instance.Value != "chunked" {
+				t.Fatalf("expected override to apply, got %s", instance.Value)
+			}
+			seen[instance.Seed] = struct{}{}
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(sizes) != "[4 4 2]" {
+		t.Fatalf("expected chunks [4 4 2], got %v", sizes)
+	}
+	if len(seen) != 10 {
+		t.Fatalf("expected 10 unique seeds, got %d", len(seen))
+	}
+}
+
+func TestBuilderBuildListChunkedStopsOnError(t *testing.T) {
+	builder := Builder(&stubFactory{})
+	failure := errors.New("insert failed")
+
+	calls := 0
+	err := BuildListChunked(builder, 100, 10, nil, func([]stubInstance) error {
+		calls++
+		if calls == 2 {
+			return failure
+		}
+		return nil
+	})
+
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected insert failure, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected generation to stop after 2 chunks, got %d", calls)
+	}
+}
+
+func TestBuilderBuildListChunkedCapsChunkSizeAtTotal(t *testing.T) {
+	builder := Builder(&stubFactory{})
+
+	calls := 0
+	_ = BuildListChunked(builder, 5, 10, nil, func(chunk []stubInstance) error {
+		calls++
+		if len(chunk) != 5 {
+			t.Fatalf("expected single chunk of 5, got %d", len(chunk))
+		}
+		return nil
+	})
+
+	if calls != 1 {
+		t.Fatalf("expected one chunk, got %d", calls)
+	}
+}
+
+func TestBuilderBuildListChunkedRejectsNonPositiveSizes(t *testing.T) {
+	builder := Builder(&stubFactory{})
+	fail := func([]stubInstance) error {
+		t.Fatal("expected no chunk to be built")
+		return nil
+	}
+
+	for _, sizes := range [][2]int{{5, 0}, {5, -1}, {0, 5}, {-3, 5}} {
+		if err := BuildListChunked(builder, sizes[0], sizes[1], nil, fail); err == nil {
+			t.Fatalf("expected an error for total %d and chunk size %d", sizes[0], sizes[1])
+		}
+	}
+}
+
+func TestBuilderBuildStratified(t *testing.T) {
+	builder := Builder(&stubFactory{})
+
+	results := BuildStratified(builder,
+		Stratum{Count: 7, Overrides: Override[stubProps](map[string]any{"Value": "paid"})},
+		Stratum{Count: 3, Overrides: Override[stubProps](map[string]any{"Value": "trial"})},
+	)
+
+	counts := make(map[string]int)
+	for _, instance := range results {
+		counts[instance.Value]++
+	}
+	if counts["paid"] != 7 || counts["trial"] != 3 || len(results) != 10 {
+		t.Fatalf("expected 7 paid and 3 trial, got %v", counts)
+	}
+}
+
+func TestShuffleIsDeterministicPermutation(t *testing.T) {
+	first := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	second := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	shuffle(first, 42)
+	shuffle(second, 42)
+
+	if fmt.Sprint(first) != fmt.Sprint(second) {
+		t.Fatalf("expected identical shuffles, got %v and %v", first, second)
+	}
+
+	seen := make(map[int]bool)
+	for _, value := range first {
+		seen[value] = true
+	}
+	if len(seen) != 10 {
+		t.Fatalf("expected a permutation, got %v", first)
+	}
+}
+
+func TestBuilderFunctionsPanicForForeignBuilder(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for a builder not created via Builder")
+		}
+	}()
+
+	Shared[stubInstance](pluckBuilder{}, "tenant", nil)
+}
+
+type hookedProps struct {
+	Email string
+	Plan  string
+}
+
+func (p *hookedProps) SetEmail(string) {
+	panic("setter must not run for internal copies")
+}
+
+func (p *hookedProps) AfterOverride() error {
+	return errors.New("hook must not run for internal copies")
+}
+
+type hookedFactory struct{}
+
+func (f *hookedFactory) Instantiate(props hookedProps) hookedProps {
+	return props
+}
+
+func (f *hookedFactory) Prepare(_ Partial[hookedProps], seed int64) hookedProps {
+	return hookedProps{Email: fmt.Sprintf("user-%d@example.com", seed), Plan: "free"}
+}
+
+func (f *hookedFactory) Retrieve(instance hookedProps) hookedProps {
+	return instance
+}
+
+func TestBuilderAnonymizeBypassesSettersAndHooks(t *testing.T) {
+	builder := Builder(&hookedFactory{}, WithIdentityFields("Email"))
+	original := hookedProps{Email: "jane@corp.example", Plan: "enterprise"}
+
+	if safe := Anonymize(builder, original, "email"); safe.Email == original.Email || safe.Plan != "enterprise" {
+		t.Fatalf("expected only the email to be regenerated, got %+v", safe)
+	}
+	if copied := DuplicateAsNew(builder, original, nil); copied.Email == original.Email {
+		t.Fatalf("expected a new email, got %+v", copied)
+	}
+}
@@ -0,0 +1,49 @@
+package factory
+
+import "slices"
+
+// BulkAllocation tunes the builder for lists of millions of instances, such as load-test
+// datasets. BuildList and BuildListChunked draw each list's seeds as one consecutive run from a
+// random start instead of one permuted seed per instance; the builder remembers each run as a
+// single interval to keep later runs apart. Each list prepares its instances into
+// one reused properties buffer, BuildList takes its seed slice from a pool shared by the
+// builder's calls, and DistinctValues trackers are sized for the whole list up front. Seeds stay
+// unique across calls, but the generated values differ from those of a builder without the
+// option.
+func BulkAllocation() BuilderOption {
+	return func(opts *builderOptions) {
+		opts.bulk = true
+	}
+}
+
+// borrowSeeds returns size fresh seeds. Under BulkAllocation the slice comes from a pool shared by
+// the builder's calls; hand it back with returnSeeds once the list is built.
+func (b *builderInstance[T, P]) borrowSeeds(size int) *[]int64 {
+	if !b.bulk {
+		seeds := b.nextSeeds(size)
+		return &seeds
+	}
+
+	seeds, _ := b.seedBuffers.Get().(*[]int64)
+	if seeds == nil {
+		seeds = new([]int64)
+	}
+	*seeds = b.appendSeeds(slices.Grow((*seeds)[:0], size), size)
+	return seeds
+}
+
+// returnSeeds puts a slice from borrowSeeds back into the pool under BulkAllocation.
+func (b *builderInstance[T, P]) returnSeeds(seeds *[]int64) {
+	if b.bulk {
+		b.seedBuffers.Put(seeds)
+	}
+}
+
+// propertiesBuffer returns the properties buffer reused across one list under BulkAllocation, or
+// nil to prepare every instance into its own value.
+func (b *builderInstance[T, P]) propertiesBuffer() *P {
+	if !b.bulk {
+		return nil
+	}
+	return new(P)
+}
@@ -0,0 +1,103 @@
+package factory
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBulkAllocationSeedsAreUniqueAcrossCalls(t *testing.T) {
+	builder := Builder(&stubFactory{}, BulkAllocation(), WithSeedSource(rand.NewSource(1)))
+
+	seen := make(map[int64]bool)
+	for range 5 {
+		for _, instance := range builder.BuildList(1000, nil) {
+			if seen[instance.Seed] {
+				t.Fatalf("seed %d issued twice", instance.Seed)
+			}
+			seen[instance.Seed] = true
+		}
+	}
+
+	err := BuildListChunked(builder, 2500, 1000, nil, func(chunk []stubInstance) error {
+		for _, instance := range chunk {
+			if seen[instance.Seed] {
+				t.Fatalf("seed %d issued twice", instance.Seed)
+			}
+			seen[instance.Seed] = true
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seen) != 7500 {
+		t.Fatalf("expected 7500 instances, got %d", len(seen))
+	}
+}
+
+func TestBulkAllocationIsReproducible(t *testing.T) {
+	first := Builder(&IntFactory{}, BulkAllocation(), WithSeedSource(rand.NewSource(7))).BuildList(100, nil)
+	second := Builder(&IntFactory{}, BulkAllocation(), WithSeedSource(rand.NewSource(7))).BuildList(100, nil)
+
+	for index := range first {
+		if first[index] != second[index] {
+			t.Fatalf("expected identical lists, differ at %d", index)
+		}
+	}
+}
+
+func TestBulkAllocationKeepsOverridesAndDistinctValues(t *testing.T) {
+	builder := Builder(NewEnumFactory([]string{"a", "b", "c"}), BulkAllocation(), DistinctValues())
+
+	values := builder.BuildList(3, nil)
+	if values[0] == values[1] || values[1] == values[2] || values[0] == values[2] {
+		t.Fatalf("expected distinct values, got %v", values)
+	}
+}
+
+func benchmarkBuildList(b *testing.B, opts ...BuilderOption) {
+	b.ReportAllocs()
+	builder := Builder(&IntFactory{}, opts...)
+
+	for b.Loop() {
+		builder.BuildList(10_000, nil)
+	}
+}
+
+func TestBulkAllocationReducesAllocations(t *testing.T) {
+	plain := Builder(&IntFactory{})
+	bulk := Builder(&IntFactory{}, BulkAllocation())
+
+	plainAllocs := testing.AllocsPerRun(10, func() { plain.BuildList(1000, nil) })
+	bulkAllocs := testing.AllocsPerRun(10, func() { bulk.BuildList(1000, nil) })
+
+	if bulkAllocs > plainAllocs*3/4 {
+		t.Fatalf("expected bulk allocation to save allocations, got %v against %v", bulkAllocs, plainAllocs)
+	}
+}
+
+func BenchmarkBuildList(b *testing.B) {
+	benchmarkBuildList(b)
+}
+
+func BenchmarkBuildListBulk(b *testing.B) {
+	benchmarkBuildList(b, BulkAllocation())
+}
+
+func benchmarkBuildListChunked(b *testing.B, opts ...BuilderOption) {
+	b.ReportAllocs()
+	builder := Builder(&IntFactory{}, opts...)
+
+	for b.Loop() {
+		_ = BuildListChunked(builder, 10_000, 1_000, nil, func([]int) error { return nil })
+	}
+}
+
+func BenchmarkBuildListChunked(b *testing.B) {
+	benchmarkBuildListChunked(b)
+}
+
+func BenchmarkBuildListChunkedBulk(b *testing.B) {
+	benchmarkBuildListChunked(b, BulkAllocation())
+}
@@ -0,0 +1,130 @@
+package factory
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const (
+	defaultBytesLength = 16
+	bytesLengthSalt    = 0x0B17_E5A1
+)
+
+// BytesProperties carries the generated bytes for BytesFactory and its encoded forms.
+type BytesProperties struct {
+	value []byte
+}
+
+// BytesFactory generates random byte slices of Min to Max bytes (16 when both are zero; Max
+// defaults to Min), for keys, nonces and checksum fields. Hex and Base64 return factories that
+// encode the same bytes as text.
+type BytesFactory struct {
+	Min int
+	Max int
+}
+
+// Hex returns a factory producing the bytes as lowercase hexadecimal strings.
+func (f *BytesFactory) Hex() *HexStringFactory {
+	return &HexStringFactory{bytes: f}
+}
+
+// Base64 returns a factory producing the bytes encoded with encoding, or base64.StdEncoding when
+// nil.
+func (f *BytesFactory) Base64(encoding *base64.Encoding) *Base64Factory {
+	if encoding == nil {
+		encoding = base64.StdEncoding
+	}
+	return &Base64Factory{bytes: f, encoding: encoding}
+}
+
+// Instantiate returns the generated bytes.
+func (f *BytesFactory) Instantiate(properties BytesProperties) []byte {
+	return properties.value
+}
+
+// Prepare generates the bytes unless overridden; an empty non-nil slice is kept.
+func (f *BytesFactory) Prepare(overrides Partial[BytesProperties], seed int64) BytesProperties {
+	properties := BytesProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value == nil {
+		properties.value = f.Generate(seed)
+	}
+
+	return properties
+}
+
+// Retrieve wraps existing bytes into BytesProperties.
+func (f *BytesFactory) Retrieve(instance []byte) BytesProperties {
+	return BytesProperties{
+		value: instance,
+	}
+}
+
+// Generate produces the same bytes as building with seed and no overrides.
+func (f *BytesFactory) Generate(seed int64) []byte {
+	minimum, maximum := f.Min, f.Max
+	if minimum <= 0 && maximum <= 0 {
+		minimum = defaultBytesLength
+	}
+	if maximum < minimum {
+		maximum = minimum
+	}
+
+	return seededBytes(seed, int(IntAt(seed^bytesLengthSalt, int64(max(minimum, 0)), int64(maximum))))
+}
+
+// HexStringFactory produces the bytes of a BytesFactory as hexadecimal strings; see
+// BytesFactory.Hex.
+type HexStringFactory struct {
+	bytes *BytesFactory
+}
+
+// Instantiate returns the hex-encoded bytes.
+func (f *HexStringFactory) Instantiate(properties BytesProperties) string {
+	return hex.EncodeToString(properties.value)
+}
+
+// Prepare delegates to the underlying BytesFactory.
+func (f *HexStringFactory) Prepare(overrides Partial[BytesProperties], seed int64) BytesProperties {
+	return f.bytes.Prepare(overrides, seed)
+}
+
+// Retrieve decodes a hex string back into BytesProperties. Undecodable strings yield empty
+// properties.
+func (f *HexStringFactory) Retrieve(instance string) BytesProperties {
+	decoded, err := hex.DecodeString(instance)
+	if err != nil {
+		return BytesProperties{}
+	}
+	return BytesProperties{value: decoded}
+}
+
+// Base64Factory produces the bytes of a BytesFactory as base64 strings; see BytesFactory.Base64.
+type Base64Factory struct {
+	bytes    *BytesFactory
+	encoding *base64.Encoding
+}
+
+// Instantiate returns the base64-encoded bytes.
+func (f *Base64Factory) Instantiate(properties BytesProperties) string {
+	return f.encoding.EncodeToString(properties.value)
+}
+
+// Prepare delegates to the underlying BytesFactory.
+func (f *Base64Factory) Prepare(overrides Partial[BytesProperties], seed int64) BytesProperties {
+	return f.bytes.Prepare(overrides, seed)
+}
+
+// Retrieve decodes a base64 string back into BytesProperties. Undecodable strings yield empty
+// properties.
+func (f *Base64Factory) Retrieve(instance string) BytesProperties {
+	decoded, err := f.encoding.DecodeString(instance)
+	if err != nil {
+		return BytesProperties{}
+	}
+	return BytesProperties{value: decoded}
+}
@@ -0,0 +1,77 @@
+package factory
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+	"testing"
+)
+
+func TestBytesFactoryLengths(t *testing.T) {
+	if got := len(Builder(&BytesFactory{}).Build(nil)); got != defaultBytesLength {
+		t.Fatalf("expected %d bytes by default, got %d", defaultBytesLength, got)
+	}
+
+	seen := make(map[int]bool)
+	for _, value := range Builder(&BytesFactory{Min: 4, Max: 8}).BuildListWith(200, 1, nil) {
+		if len(value) < 4 || len(value) > 8 {
+			t.Fatalf("length %d out of range", len(value))
+		}
+		seen[len(value)] = true
+	}
+	if len(seen) != 5 {
+		t.Fatalf("expected every length in [4, 8], got %v", seen)
+	}
+}
+
+func TestBytesFactoryIsDeterministic(t *testing.T) {
+	factory := &BytesFactory{Min: 8, Max: 32}
+
+	for seed := range int64(20) {
+		if !bytes.Equal(Builder(factory).BuildWith(seed, nil), factory.Generate(seed)) {
+			t.Fatalf("expected Build and Generate to agree for seed %d", seed)
+		}
+	}
+}
+
+func TestBytesFactoryEncodings(t *testing.T) {
+	factory := &BytesFactory{Min: 20, Max: 20}
+	raw := Builder(factory).BuildWith(5, nil)
+
+	encoded := Builder(factory.Hex()).BuildWith(5, nil)
+	if encoded != hex.EncodeToString(raw) || !regexp.MustCompile(`^[0-9a-f]{40}$`).MatchString(encoded) {
+		t.Fatalf("unexpected hex %q", encoded)
+	}
+
+	if got := Builder(factory.Base64(nil)).BuildWith(5, nil); got != base64.StdEncoding.EncodeToString(raw) {
+		t.Fatalf("unexpected base64 %q", got)
+	}
+	if got := Builder(factory.Base64(base64.RawURLEncoding)).BuildWith(5, nil); got != base64.RawURLEncoding.EncodeToString(raw) {
+		t.Fatalf("unexpected raw URL base64 %q", got)
+	}
+}
+
+func TestBytesFactoryRetrieve(t *testing.T) {
+	factory := &BytesFactory{}
+	raw := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	if got := factory.Hex().Retrieve("deadbeef"); !bytes.Equal(got.value, raw) {
+		t.Fatalf("unexpected decoded hex %x", got.value)
+	}
+	if got := factory.Base64(nil).Retrieve("3q2+7w=="); !bytes.Equal(got.value, raw) {
+		t.Fatalf("unexpected decoded base64 %x", got.value)
+	}
+
+	copied := Builder(factory.Hex()).Duplicate("deadbeef", nil)
+	if copied != "deadbeef" {
+		t.Fatalf("expected the duplicate to keep the bytes, got %q", copied)
+	}
+}
+
+func TestBytesFactoryOverride(t *testing.T) {
+	got := Builder((&BytesFactory{}).Hex()).Build(Override[BytesProperties](map[string]any{"value": []byte{1, 2}}))
+	if got != "0102" {
+		t.Fatalf("unexpected hex %q", got)
+	}
+}
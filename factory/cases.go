@@ -0,0 +1,31 @@
+package factory
+
+// Case is a named fixture ready to be ranged over in table-driven subtests.
+type Case[T any] struct {
+	Name string
+	In   T
+}
+
+// Scenario names a set of overrides produced via Override().
+type Scenario struct {
+	Name      string
+	Overrides any
+}
+
+type caseBuilder[T any] interface {
+	Build(overrides any) T
+}
+
+// Cases builds one instance per scenario with builder, preserving the scenario order.
+func Cases[T any](builder caseBuilder[T], scenarios ...Scenario) []Case[T] {
+	cases := make([]Case[T], 0, len(scenarios))
+
+	for _, scenario := range scenarios {
+		cases = append(cases, Case[T]{
+			Name: scenario.Name,
+			In:   builder.Build(scenario.Overrides),
+		})
+	}
+
+	return cases
+}
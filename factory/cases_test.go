@@ -0,0 +1,40 @@
+package factory
+
+import "testing"
+
+func TestCasesBuildsNamedFixtures(t *testing.T) {
+	builder := Builder(&stubFactory{})
+
+	cases := Cases(builder,
+		Scenario{Name: "default"},
+		Scenario{Name: "custom", Overrides: Override[stubProps](map[string]any{
+			"Value": "custom",
+		})},
+	)
+
+	if len(cases) != 2 {
+		t.Fatalf("expected 2 cases, got %d", len(cases))
+	}
+	if cases[0].Name != "default" || cases[1].Name != "custom" {
+		t.Fatalf("expected ordered names, got %s and %s", cases[0].Name, cases[1].Name)
+	}
+	if cases[1].In.Value != "custom" {
+		t.Fatalf("expected overridden value, got %s", cases[1].In.Value)
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if tc.In.Value == "" {
+				t.Fatal("expected built value")
+			}
+		})
+	}
+}
+
+func TestCasesWithoutScenarios(t *testing.T) {
+	builder := Builder(&stubFactory{})
+
+	if cases := Cases(builder); len(cases) != 0 {
+		t.Fatalf("expected no cases, got %d", len(cases))
+	}
+}
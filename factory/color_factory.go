@@ -0,0 +1,141 @@
+package factory
+
+import (
+	"fmt"
+	stdmath "math"
+	"strconv"
+	"strings"
+)
+
+// ColorFormat selects how ColorFactory renders colors.
+type ColorFormat int
+
+// Color formats supported by ColorFactory.
+const (
+	// ColorHex renders colors as "#RRGGBB", e.g. "#1E90FF".
+	ColorHex ColorFormat = iota
+	// ColorRGB renders colors as CSS rgb(), e.g. "rgb(30, 144, 255)".
+	ColorRGB
+	// ColorHSL renders colors as CSS hsl(), e.g. "hsl(210, 100%, 56%)".
+	ColorHSL
+)
+
+const colorPaletteSalt = 0x0C01_0A7E
+
+// Color is a 24-bit sRGB color.
+type Color struct {
+	R uint8
+	G uint8
+	B uint8
+}
+
+// ParseHexColor parses "#RRGGBB" or "#RGB", with or without the '#'. It panics on other input.
+func ParseHexColor(hex string) Color {
+	digits := strings.TrimPrefix(hex, "#")
+	if len(digits) == 3 {
+		digits = string([]byte{digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]})
+	}
+
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || len(digits) != 6 {
+		panic(fmt.Sprintf("color: invalid hex color %q", hex))
+	}
+	//nolint:gosec // G115: Each channel is masked to eight bits
+	return Color{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value)}
+}
+
+// Hex renders the color as "#RRGGBB".
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// RGB renders the color as CSS rgb().
+func (c Color) RGB() string {
+	return fmt.Sprintf("rgb(%d, %d, %d)", c.R, c.G, c.B)
+}
+
+// HSL renders the color as CSS hsl(), rounding hue to degrees and saturation and lightness to
+// percent.
+func (c Color) HSL() string {
+	red, green, blue := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	high, low := max(red, green, blue), min(red, green, blue)
+	lightness := (high + low) / 2
+
+	hue, saturation := 0.0, 0.0
+	if delta := high - low; delta > 0 {
+		saturation = delta / (1 - stdmath.Abs(2*lightness-1))
+		switch high {
+		case red:
+			hue = stdmath.Mod((green-blue)/delta+6, 6)
+		case green:
+			hue = (blue-red)/delta + 2
+		default:
+			hue = (red-green)/delta + 4
+		}
+		hue *= 60
+	}
+
+	return fmt.Sprintf("hsl(%d, %d%%, %d%%)",
+		int(stdmath.Round(hue))%360, int(stdmath.Round(saturation*100)), int(stdmath.Round(lightness*100)))
+}
+
+// ColorProperties carries the color and its rendering for ColorFactory.
+type ColorProperties struct {
+	color Color
+	value string
+}
+
+// ColorFactory generates colors for UI fixtures, rendered in Format. Colors are drawn from
+// Palette, hex colors accepted by ParseHexColor, or uniformly from all 24-bit colors when it is
+// empty. Prepare panics on an invalid palette entry.
+type ColorFactory struct {
+	Format  ColorFormat
+	Palette []string
+}
+
+// Instantiate returns the rendered color.
+func (f *ColorFactory) Instantiate(properties ColorProperties) string {
+	return properties.value
+}
+
+// Prepare picks a color before applying overrides, so overriding with black is honored, and
+// renders it unless the value is overridden.
+func (f *ColorFactory) Prepare(overrides Partial[ColorProperties], seed int64) ColorProperties {
+	properties := ColorProperties{
+		color: f.pick(seed),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value == "" {
+		switch f.Format {
+		case ColorRGB:
+			properties.value = properties.color.RGB()
+		case ColorHSL:
+			properties.value = properties.color.HSL()
+		default:
+			properties.value = properties.color.Hex()
+		}
+	}
+
+	return properties
+}
+
+// Retrieve wraps a rendered color into ColorProperties.
+func (f *ColorFactory) Retrieve(instance string) ColorProperties {
+	return ColorProperties{
+		value: instance,
+	}
+}
+
+func (f *ColorFactory) pick(seed int64) Color {
+	if len(f.Palette) > 0 {
+		return ParseHexColor(pickString(f.Palette, nil, seed^colorPaletteSalt))
+	}
+
+	value := IntAt(seed, 0, 0xFFFFFF)
+	//nolint:gosec // G115: Each channel is masked to eight bits
+	return Color{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value)}
+}
@@ -0,0 +1,92 @@
+package factory
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestColorFactoryFormats(t *testing.T) {
+	formats := map[ColorFormat]*regexp.Regexp{
+		ColorHex: regexp.MustCompile(`^#[0-9A-F]{6}$`),
+		ColorRGB: regexp.MustCompile(`^rgb\(\d{1,3}, \d{1,3}, \d{1,3}\)$`),
+		ColorHSL: regexp.MustCompile(`^hsl\(\d{1,3}, \d{1,3}%, \d{1,3}%\)$`),
+	}
+
+	for format, pattern := range formats {
+		for _, value := range Builder(&ColorFactory{Format: format}).BuildListWith(100, 1, nil) {
+			if !pattern.MatchString(value) {
+				t.Fatalf("format %d: unexpected value %q", format, value)
+			}
+		}
+	}
+}
+
+func TestColorRenderings(t *testing.T) {
+	cases := []struct {
+		color Color
+		hex   string
+		rgb   string
+		hsl   string
+	}{
+		{Color{30, 144, 255}, "#1E90FF", "rgb(30, 144, 255)", "hsl(210, 100%, 56%)"},
+		{Color{0, 0, 0}, "#000000", "rgb(0, 0, 0)", "hsl(0, 0%, 0%)"},
+		{Color{255, 255, 255}, "#FFFFFF", "rgb(255, 255, 255)", "hsl(0, 0%, 100%)"},
+		{Color{255, 0, 128}, "#FF0080", "rgb(255, 0, 128)", "hsl(330, 100%, 50%)"},
+	}
+
+	for _, tc := range cases {
+		if tc.color.Hex() != tc.hex || tc.color.RGB() != tc.rgb || tc.color.HSL() != tc.hsl {
+			t.Fatalf("unexpected renderings %s, %s, %s for %v", tc.color.Hex(), tc.color.RGB(), tc.color.HSL(), tc.color)
+		}
+		if ParseHexColor(tc.hex) != tc.color {
+			t.Fatalf("expected %s to parse back to %v", tc.hex, tc.color)
+		}
+	}
+
+	if ParseHexColor("f80") != (Color{255, 136, 0}) {
+		t.Fatal("expected the short form to expand")
+	}
+}
+
+func TestColorFactoryPalette(t *testing.T) {
+	palette := []string{"#1E90FF", "#FF0080", "#00C853"}
+	factory := &ColorFactory{Palette: palette}
+
+	seen := make(map[string]bool)
+	for _, value := range Builder(factory).BuildListWith(100, 1, nil) {
+		if !slices.Contains(palette, value) {
+			t.Fatalf("expected a palette color, got %q", value)
+		}
+		seen[value] = true
+	}
+	if len(seen) != len(palette) {
+		t.Fatalf("expected every palette color, got %v", seen)
+	}
+
+	rgb := Builder(&ColorFactory{Format: ColorRGB, Palette: []string{"1e90ff"}}).Build(nil)
+	if rgb != "rgb(30, 144, 255)" {
+		t.Fatalf("unexpected rgb %q", rgb)
+	}
+}
+
+func TestColorFactoryOverride(t *testing.T) {
+	value := Builder(&ColorFactory{}).Build(Override[ColorProperties](map[string]any{"color": Color{}}))
+	if value != "#000000" {
+		t.Fatalf("expected the black override to be honored, got %q", value)
+	}
+}
+
+func TestParseHexColorPanicsForInvalidInput(t *testing.T) {
+	for _, hex := range []string{"", "#12345", "#GGGGGG", "#1234567"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected panic for %q", hex)
+				}
+			}()
+
+			ParseHexColor(hex)
+		}()
+	}
+}
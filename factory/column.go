@@ -0,0 +1,134 @@
+package factory
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// ColumnOverride sets fields on every element of a slice property; see Each.
+type ColumnOverride struct {
+	literal any
+}
+
+// Each returns an override value that applies literal (a map or struct, as accepted by Override)
+// to every element of a slice or array of structs, instead of replacing the whole property:
+//
+//	factory.Override[OrderProperties](map[string]any{
+//		"items": factory.Each(map[string]any{"Currency": "JPY"}),
+//	})
+//
+// Column overrides run after Prepare, so they also reach elements the factory generated. Elements
+// are updated on a copy of the slice; pointer elements are cloned and nil ones skipped.
+func Each(literal any) ColumnOverride {
+	return ColumnOverride{literal: literal}
+}
+
+var columnOverrideType = reflect.TypeFor[ColumnOverride]()
+
+// columnEntry is a parsed column override targeting one slice property.
+type columnEntry struct {
+	originalName string
+	key          string
+	entries      []literalEntry
+}
+
+// splitColumnEntries separates column overrides from the entries applied before Prepare.
+func splitColumnEntries(entries []literalEntry, config overrideOptions) ([]literalEntry, []columnEntry, error) {
+	var columns []columnEntry
+
+	direct := entries[:0:0]
+	for _, entry := range entries {
+		value := entry.value
+		for value.IsValid() && value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+
+		if !value.IsValid() || value.Type() != columnOverrideType {
+			direct = append(direct, entry)
+			continue
+		}
+
+		column, _ := value.Interface().(ColumnOverride)
+		nested, err := parseOverrideLiteral(column.literal, config.caseInsensitive)
+		if err != nil {
+			return nil, nil, fmt.Errorf("override: column %q: %w", entry.originalName, err)
+		}
+
+		columns = append(columns, columnEntry{
+			originalName: entry.originalName,
+			key:          entry.key,
+			entries:      nested,
+		})
+	}
+
+	return direct, columns, nil
+}
+
+// applyColumnEntries applies every column override to the struct target points to.
+func applyColumnEntries(target reflect.Value, columns []columnEntry, config overrideOptions) error {
+	for _, column := range columns {
+		field, info, ok := lookupField(target.Elem(), column.key, config.caseInsensitive)
+		if !ok {
+			return fmt.Errorf("override: unknown field %q on %s", column.originalName, target.Elem().Type())
+		}
+		if !isExportedStructField(&info) && !config.allowUnexported {
+			return fmt.Errorf("override: field %q is unexported and DisallowUnexported was provided", info.Name)
+		}
+		if !field.CanSet() {
+			field = reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+		}
+
+		updated, err := applyColumn(field, column, config)
+		if err != nil {
+			return err
+		}
+		field.Set(updated)
+		notifyOverride(target, info.Name)
+	}
+
+	return nil
+}
+
+// applyColumn returns a copy of the slice or array in field with the column applied to each element.
+func applyColumn(field reflect.Value, column columnEntry, config overrideOptions) (reflect.Value, error) {
+	var updated reflect.Value
+	switch field.Kind() {
+	case reflect.Slice:
+		if field.IsNil() {
+			return field, nil
+		}
+		updated = reflect.MakeSlice(field.Type(), field.Len(), field.Len())
+		reflect.Copy(updated, field)
+	case reflect.Array:
+		updated = reflect.New(field.Type()).Elem()
+		updated.Set(field)
+	default:
+		return reflect.Value{}, fmt.Errorf("override: column %q must target a slice or array, got %s", column.originalName, field.Type())
+	}
+
+	for index := range updated.Len() {
+		element := updated.Index(index)
+
+		var target reflect.Value
+		switch {
+		case element.Kind() == reflect.Struct:
+			target = element.Addr()
+		case element.Kind() == reflect.Pointer && element.Type().Elem().Kind() == reflect.Struct:
+			if element.IsNil() {
+				continue
+			}
+			target = reflect.New(element.Type().Elem())
+			target.Elem().Set(element.Elem())
+			element.Set(target)
+		default:
+			return reflect.Value{}, fmt.Errorf("override: column %q elements must be structs, got %s", column.originalName, element.Type())
+		}
+
+		if err := applyOverrideEntriesTo(target, column.entries, nil, config); err != nil {
+			return reflect.Value{}, fmt.Errorf("override: column %q element %d: %w", column.originalName, index, err)
+		}
+	}
+
+	return updated, nil
+}
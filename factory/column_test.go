@@ -0,0 +1,123 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+)
+
+type columnTestItem struct {
+	Name     string
+	Currency string
+}
+
+type columnTestOrderProperties struct {
+	items    []columnTestItem
+	pointers []*columnTestItem
+	fixed    [2]columnTestItem
+	count    int
+}
+
+type columnTestOrderFactory struct{}
+
+func (f *columnTestOrderFactory) Instantiate(properties columnTestOrderProperties) columnTestOrderProperties {
+	return properties
+}
+
+func (f *columnTestOrderFactory) Prepare(overrides Partial[columnTestOrderProperties], seed int64) columnTestOrderProperties {
+	properties := columnTestOrderProperties{}
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.items == nil {
+		properties.items = []columnTestItem{{Name: "a", Currency: "USD"}, {Name: "b", Currency: "EUR"}}
+		properties.pointers = []*columnTestItem{{Name: "c", Currency: "USD"}, nil}
+	}
+
+	return properties
+}
+
+func (f *columnTestOrderFactory) Retrieve(instance columnTestOrderProperties) columnTestOrderProperties {
+	return instance
+}
+
+func TestEachReachesGeneratedElements(t *testing.T) {
+	builder := Builder(&columnTestOrderFactory{})
+
+	order := builder.Build(Override[columnTestOrderProperties](map[string]any{
+		"items":    Each(map[string]any{"currency": "JPY"}),
+		"pointers": Each(map[string]any{"Currency": "JPY"}),
+		"fixed":    Each(columnTestItem{Name: "x", Currency: "JPY"}),
+	}))
+
+	for _, item := range order.items {
+		if item.Currency != "JPY" || item.Name == "" {
+			t.Fatalf("expected every item in JPY with its name kept, got %+v", order.items)
+		}
+	}
+	if order.pointers[0].Currency != "JPY" || order.pointers[1] != nil {
+		t.Fatalf("expected pointer elements updated and nil kept, got %+v", order.pointers)
+	}
+	if order.fixed[1].Currency != "JPY" {
+		t.Fatalf("expected array elements updated, got %+v", order.fixed)
+	}
+}
+
+func TestEachDoesNotMutateTheOriginal(t *testing.T) {
+	builder := Builder(&columnTestOrderFactory{})
+	original := builder.Build(nil)
+
+	duplicate := builder.Duplicate(original, Override[columnTestOrderProperties](map[string]any{
+		"items":    Each(map[string]any{"Currency": "JPY"}),
+		"pointers": Each(map[string]any{"Currency": "JPY"}),
+	}))
+
+	if duplicate.items[0].Currency != "JPY" || duplicate.pointers[0].Currency != "JPY" {
+		t.Fatalf("expected duplicate updated, got %+v", duplicate)
+	}
+	if original.items[0].Currency != "USD" || original.pointers[0].Currency != "USD" {
+		t.Fatalf("expected original untouched, got %+v", original)
+	}
+}
+
+func TestEachCombinesWithDirectEntries(t *testing.T) {
+	order := Builder(&columnTestOrderFactory{}).Build(Override[columnTestOrderProperties](map[string]any{
+		"count": 3,
+		"items": Each(map[string]any{"Currency": "JPY"}),
+	}))
+
+	if order.count != 3 || order.items[1].Currency != "JPY" {
+		t.Fatalf("expected both overrides applied, got %+v", order)
+	}
+}
+
+func TestEachWithSometimes(t *testing.T) {
+	builder := Builder(&columnTestOrderFactory{})
+	overrides := Sometimes(0.5, Override[columnTestOrderProperties](map[string]any{
+		"items": Each(map[string]any{"Currency": "JPY"}),
+	}))
+
+	converted := 0
+	for _, order := range builder.BuildList(200, overrides) {
+		if order.items[0].Currency == "JPY" {
+			converted++
+		}
+	}
+
+	if converted == 0 || converted == 200 {
+		t.Fatalf("expected roughly half of the orders converted, got %d", converted)
+	}
+}
+
+func TestEachRejectsNonSliceField(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if err == nil || !strings.Contains(err.Error(), "slice or array") {
+			t.Fatalf("expected slice error, got %v", err)
+		}
+	}()
+
+	Builder(&columnTestOrderFactory{}).Build(Override[columnTestOrderProperties](map[string]any{
+		"count": Each(map[string]any{"Currency": "JPY"}),
+	}))
+}
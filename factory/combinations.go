@@ -0,0 +1,54 @@
+package factory
+
+// Variation lists the candidate values of one property for BuildCombinations.
+type Variation struct {
+	field  string
+	values []any
+}
+
+// Vary declares the candidate values of field for BuildCombinations.
+func Vary(field string, values ...any) Variation {
+	return Variation{field: field, values: values}
+}
+
+// BuildCombinations builds one instance for every combination of the variations' values, with the
+// remaining properties generated as usual, for exhaustive matrix tests:
+//
+//	users := factory.BuildCombinations(builder,
+//		factory.Vary("Role", RoleAdmin, RoleMember),
+//		factory.Vary("Active", true, false),
+//	) // 4 users
+//
+// Combinations are emitted in lexicographic order, with the first variation changing slowest.
+// A variation without values yields no instances.
+func BuildCombinations[T any](builder BuilderOf[T], variations ...Variation) []T {
+	return extensionsOf(builder, "build combinations").buildCombinations(variations...)
+}
+
+func (b *builderInstance[T, P]) buildCombinations(variations ...Variation) []T {
+	total := 1
+	for _, variation := range variations {
+		total *= len(variation.values)
+	}
+
+	results := make([]T, 0, total)
+	indexes := make([]int, len(variations))
+
+	for range total {
+		literal := make(map[string]any, len(variations))
+		for position, variation := range variations {
+			literal[variation.field] = variation.values[indexes[position]]
+		}
+		results = append(results, b.Build(Override[P](literal)))
+
+		for position := len(indexes) - 1; position >= 0; position-- {
+			indexes[position]++
+			if indexes[position] < len(variations[position].values) {
+				break
+			}
+			indexes[position] = 0
+		}
+	}
+
+	return results
+}
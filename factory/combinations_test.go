@@ -0,0 +1,64 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+)
+
+type combinationProps struct {
+	Role   string
+	Active bool
+	Name   string
+}
+
+type combinationFactory struct{}
+
+func (f *combinationFactory) Instantiate(props combinationProps) combinationProps {
+	return props
+}
+
+func (f *combinationFactory) Prepare(overrides Partial[combinationProps], seed int64) combinationProps {
+	props := combinationProps{Name: fmt.Sprintf("user-%d", seed)}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *combinationFactory) Retrieve(instance combinationProps) combinationProps {
+	return instance
+}
+
+func TestCombinationsCoversCrossProduct(t *testing.T) {
+	results := BuildCombinations(Builder(&combinationFactory{}),
+		Vary("Role", "admin", "member", "guest"),
+		Vary("Active", true, false),
+	)
+
+	var got []string
+	for _, result := range results {
+		got = append(got, fmt.Sprintf("%s/%t", result.Role, result.Active))
+		if result.Name == "" {
+			t.Fatal("expected remaining fields to be generated")
+		}
+	}
+
+	expected := "[admin/true admin/false member/true member/false guest/true guest/false]"
+	if fmt.Sprint(got) != expected {
+		t.Fatalf("expected %s, got %v", expected, got)
+	}
+}
+
+func TestCombinationsWithEmptyVariation(t *testing.T) {
+	results := BuildCombinations(Builder(&combinationFactory{}), Vary("Role"), Vary("Active", true))
+
+	if len(results) != 0 {
+		t.Fatalf("expected no combinations, got %d", len(results))
+	}
+}
+
+func TestCombinationsWithoutVariations(t *testing.T) {
+	if results := BuildCombinations(Builder(&combinationFactory{})); len(results) != 1 {
+		t.Fatalf("expected a single instance, got %d", len(results))
+	}
+}
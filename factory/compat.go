@@ -0,0 +1,116 @@
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Blueprint is the subset of a factory-go (github.com/bluele/factory-go) *factory.Factory used by
+// FromBlueprint, so existing blueprints can be reused without this module depending on factory-go.
+type Blueprint interface {
+	CreateWithOption(options map[string]interface{}) (interface{}, error)
+}
+
+// BlueprintProperties carries the factory-go options and the created value for BlueprintFactory.
+type BlueprintProperties[T any] struct {
+	options map[string]any
+	value   T
+}
+
+// BlueprintFactory adapts a factory-go blueprint to Factory; see FromBlueprint.
+type BlueprintFactory[T any] struct {
+	blueprint Blueprint
+}
+
+// FromBlueprint wraps a factory-go blueprint producing T, easing migration to forge. The
+// "options" override is passed to CreateWithOption to set fields:
+//
+//	users := Builder(FromBlueprint[*User](UserBlueprint))
+//	users.Build(Override[BlueprintProperties[*User]](map[string]any{
+//		"options": map[string]any{"Name": "alice"},
+//	}))
+//
+// factory-go draws its own randomness, so instances do not depend on the seed.
+func FromBlueprint[T any](blueprint Blueprint) *BlueprintFactory[T] {
+	return &BlueprintFactory[T]{blueprint: blueprint}
+}
+
+// Instantiate returns the value created by the blueprint.
+func (f *BlueprintFactory[T]) Instantiate(properties BlueprintProperties[T]) T {
+	return properties.value
+}
+
+// Prepare creates the value through the blueprint. It panics when the blueprint fails or
+// creates a value that is not a T.
+func (f *BlueprintFactory[T]) Prepare(overrides Partial[BlueprintProperties[T]], seed int64) BlueprintProperties[T] {
+	properties := BlueprintProperties[T]{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	created, err := f.blueprint.CreateWithOption(properties.options)
+	if err != nil {
+		panic(fmt.Sprintf("blueprint: %v", err))
+	}
+
+	value, ok := created.(T)
+	if !ok {
+		panic(fmt.Sprintf("blueprint: created %T, expected %T", created, properties.value))
+	}
+	properties.value = value
+
+	return properties
+}
+
+// Retrieve wraps an existing instance into BlueprintProperties.
+func (f *BlueprintFactory[T]) Retrieve(instance T) BlueprintProperties[T] {
+	return BlueprintProperties[T]{
+		value: instance,
+	}
+}
+
+// DBTX is satisfied by *sql.DB, *sql.Tx and *sql.Conn. Passing the *sql.DB opened through a
+// go-txdb driver (github.com/DATA-DOG/go-txdb) makes Create and CreateList write inside the
+// test's transaction, which is rolled back when the connection is closed.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Persist stores instance through db. It may update instance, e.g. with a generated ID.
+type Persist[T any] func(ctx context.Context, db DBTX, instance *T) error
+
+// Create builds an instance with builder and stores it through persist. Like BuildE, it returns
+// a *RequiredFieldsError when required fields stay zero.
+func Create[T any](ctx context.Context, db DBTX, builder BuilderOf[T], persist Persist[T], overrides any) (T, error) {
+	instance, err := BuildE(builder, overrides)
+	if err != nil {
+		return instance, fmt.Errorf("create: %w", err)
+	}
+	if err := persist(ctx, db, &instance); err != nil {
+		var zero T
+		return zero, fmt.Errorf("create: %w", err)
+	}
+
+	return instance, nil
+}
+
+// CreateList builds size instances with builder and stores them through persist in order,
+// stopping at the first error. Like BuildListE, it returns a *RequiredFieldsError when required
+// fields stay zero.
+func CreateList[T any](ctx context.Context, db DBTX, builder BuilderOf[T], persist Persist[T], size int, overrides any) ([]T, error) {
+	instances, err := BuildListE(builder, size, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("create: %w", err)
+	}
+	for index := range instances {
+		if err := persist(ctx, db, &instances[index]); err != nil {
+			return nil, fmt.Errorf("create: instance %d: %w", index, err)
+		}
+	}
+
+	return instances, nil
+}
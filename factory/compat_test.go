@@ -0,0 +1,137 @@
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type blueprintUser struct {
+	ID   int
+	Name string
+}
+
+type fakeBlueprint struct {
+	created int
+}
+
+func (b *fakeBlueprint) CreateWithOption(options map[string]interface{}) (interface{}, error) {
+	b.created++
+	user := &blueprintUser{ID: b.created, Name: "default"}
+	if name, ok := options["Name"].(string); ok {
+		user.Name = name
+	}
+	return user, nil
+}
+
+type recordingDB struct {
+	statements []string
+	fail       bool
+}
+
+func (db *recordingDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if db.fail {
+		return nil, errors.New("connection closed")
+	}
+	db.statements = append(db.statements, query)
+	return driver.RowsAffected(1), nil
+}
+
+func (db *recordingDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func (db *recordingDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return nil
+}
+
+var _ DBTX = (*sql.DB)(nil)
+var _ DBTX = (*sql.Tx)(nil)
+
+func TestFromBlueprintWrapsFactoryGo(t *testing.T) {
+	blueprint := &fakeBlueprint{}
+	builder := Builder(FromBlueprint[*blueprintUser](blueprint))
+
+	if user := builder.Build(nil); user.Name != "default" || user.ID != 1 {
+		t.Fatalf("unexpected user %+v", user)
+	}
+
+	user := builder.Build(Override[BlueprintProperties[*blueprintUser]](map[string]any{
+		"options": map[string]any{"Name": "alice"},
+	}))
+	if user.Name != "alice" {
+		t.Fatalf("expected options to reach the blueprint, got %+v", user)
+	}
+}
+
+func TestFromBlueprintRejectsOtherTypes(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for a blueprint of another type")
+		}
+	}()
+
+	Builder(FromBlueprint[string](&fakeBlueprint{})).Build(nil)
+}
+
+func TestCreateListPersistsThroughDB(t *testing.T) {
+	db := &recordingDB{}
+	nextID := 0
+	persist := func(ctx context.Context, db DBTX, user *blueprintUser) error {
+		if _, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", user.Name); err != nil {
+			return err
+		}
+		nextID++
+		user.ID = nextID
+		return nil
+	}
+
+	builder := Builder(FromBlueprint[blueprintUser](blueprintValues{}))
+	users, err := CreateList(context.Background(), db, builder, persist, 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(db.statements) != 3 || users[2].ID != 3 {
+		t.Fatalf("expected three inserts with assigned IDs, got %d statements and %+v", len(db.statements), users)
+	}
+
+	user, err := Create(context.Background(), db, builder, persist, nil)
+	if err != nil || user.ID != 4 {
+		t.Fatalf("expected a fourth user, got %+v %v", user, err)
+	}
+
+	db.fail = true
+	if _, err := Create(context.Background(), db, builder, persist, nil); err == nil || !strings.Contains(err.Error(), "connection closed") {
+		t.Fatalf("expected the persist error, got %v", err)
+	}
+}
+
+func TestCreateReturnsRequiredFieldsError(t *testing.T) {
+	db := &recordingDB{}
+	persist := func(ctx context.Context, db DBTX, instance *stubInstance) error {
+		_, err := db.ExecContext(ctx, "INSERT INTO stubs (value) VALUES (?)", instance.Value)
+		return err
+	}
+	builder := Builder(&requiringStubFactory{})
+	missing := Override[stubProps](map[string]any{"Value": ""})
+
+	var required *RequiredFieldsError
+	if _, err := Create(context.Background(), db, builder, persist, missing); !errors.As(err, &required) {
+		t.Fatalf("expected a *RequiredFieldsError, got %v", err)
+	}
+	if _, err := CreateList(context.Background(), db, builder, persist, 3, missing); !errors.As(err, &required) {
+		t.Fatalf("expected a *RequiredFieldsError, got %v", err)
+	}
+	if len(db.statements) != 0 {
+		t.Fatalf("expected nothing to be persisted, got %v", db.statements)
+	}
+}
+
+type blueprintValues struct{}
+
+func (blueprintValues) CreateWithOption(options map[string]interface{}) (interface{}, error) {
+	return blueprintUser{Name: "value"}, nil
+}
@@ -0,0 +1,82 @@
+package factory
+
+import (
+	"cmp"
+	"time"
+)
+
+// Constraint re-establishes a relation between properties after overrides. before holds the
+// properties as prepared by the factory, after holds them once overrides were applied.
+type Constraint[P any] func(before, after *P)
+
+// Constrain applies overrides and then every constraint, so overriding one side of a relation
+// moves the other side instead of producing an inconsistent fixture. Factories typically call it
+// from Prepare once defaults are generated:
+//
+//	Constrain(overrides,
+//		Chronological(func(p *EventProperties) *time.Time { return &p.StartAt },
+//			func(p *EventProperties) *time.Time { return &p.EndAt }),
+//	)(&properties)
+func Constrain[P any](overrides Partial[P], constraints ...Constraint[P]) Partial[P] {
+	return func(properties *P) {
+		before := *properties
+
+		if overrides != nil {
+			overrides(properties)
+		}
+
+		for _, constraint := range constraints {
+			if constraint != nil {
+				constraint(&before, properties)
+			}
+		}
+	}
+}
+
+// Ordered keeps *low(p) <= *high(p), e.g. DiscountedPrice <= Price. When the relation is broken,
+// the side the overrides did not touch is set to the other side's value; when both sides were
+// overridden, they are kept as given.
+func Ordered[P any, V cmp.Ordered](low, high func(*P) *V) Constraint[P] {
+	return func(before, after *P) {
+		lowValue, highValue := low(after), high(after)
+		if *lowValue <= *highValue {
+			return
+		}
+
+		lowChanged := *low(before) != *lowValue
+		highChanged := *high(before) != *highValue
+		switch {
+		case lowChanged && !highChanged:
+			*highValue = *lowValue
+		case highChanged && !lowChanged:
+			*lowValue = *highValue
+		}
+	}
+}
+
+// Chronological keeps *end(p) strictly after *start(p), e.g. EndAt > StartAt. When the relation is
+// broken, the side the overrides did not touch is moved so that the prepared duration is kept
+// (or one second when the prepared duration was not positive); when both sides were overridden,
+// they are kept as given.
+func Chronological[P any](start, end func(*P) *time.Time) Constraint[P] {
+	return func(before, after *P) {
+		startValue, endValue := start(after), end(after)
+		if endValue.After(*startValue) {
+			return
+		}
+
+		duration := end(before).Sub(*start(before))
+		if duration <= 0 {
+			duration = time.Second
+		}
+
+		startChanged := !start(before).Equal(*startValue)
+		endChanged := !end(before).Equal(*endValue)
+		switch {
+		case startChanged && !endChanged:
+			*endValue = startValue.Add(duration)
+		case endChanged && !startChanged:
+			*startValue = endValue.Add(-duration)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package factory
+
+import (
+	"testing"
+	"time"
+)
+
+type constraintEvent struct {
+	StartAt         time.Time
+	EndAt           time.Time
+	Price           int
+	DiscountedPrice int
+}
+
+type constraintEventFactory struct{}
+
+var constraintStart = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func (f *constraintEventFactory) Instantiate(props constraintEvent) constraintEvent {
+	return props
+}
+
+func (f *constraintEventFactory) Prepare(overrides Partial[constraintEvent], _ int64) constraintEvent {
+	props := constraintEvent{
+		StartAt:         constraintStart,
+		EndAt:           constraintStart.Add(2 * time.Hour),
+		Price:           100,
+		DiscountedPrice: 80,
+	}
+
+	Constrain(overrides,
+		Chronological(
+			func(p *constraintEvent) *time.Time { return &p.StartAt },
+			func(p *constraintEvent) *time.Time { return &p.EndAt },
+		),
+		Ordered(
+			func(p *constraintEvent) *int { return &p.DiscountedPrice },
+			func(p *constraintEvent) *int { return &p.Price },
+		),
+	)(&props)
+
+	return props
+}
+
+func (f *constraintEventFactory) Retrieve(instance constraintEvent) constraintEvent {
+	return instance
+}
+
+func TestConstrainMovesEndAfterOverriddenStart(t *testing.T) {
+	start := constraintStart.Add(24 * time.Hour)
+
+	event := Builder(&constraintEventFactory{}).Build(Override[constraintEvent](map[string]any{"StartAt": start}))
+
+	if !event.EndAt.Equal(start.Add(2 * time.Hour)) {
+		t.Fatalf("expected end to keep the 2h duration, got %s", event.EndAt)
+	}
+}
+
+func TestConstrainMovesStartBeforeOverriddenEnd(t *testing.T) {
+	end := constraintStart.Add(-time.Hour)
+
+	event := Builder(&constraintEventFactory{}).Build(Override[constraintEvent](map[string]any{"EndAt": end}))
+
+	if !event.StartAt.Equal(end.Add(-2 * time.Hour)) {
+		t.Fatalf("expected start to move before end, got %s", event.StartAt)
+	}
+}
+
+func TestConstrainLowersDiscountBelowOverriddenPrice(t *testing.T) {
+	event := Builder(&constraintEventFactory{}).Build(Override[constraintEvent](map[string]any{"Price": 50}))
+
+	if event.DiscountedPrice != 50 {
+		t.Fatalf("expected discounted price to follow price, got %d", event.DiscountedPrice)
+	}
+}
+
+func TestConstrainRaisesPriceAboveOverriddenDiscount(t *testing.T) {
+	event := Builder(&constraintEventFactory{}).Build(Override[constraintEvent](map[string]any{"DiscountedPrice": 150}))
+
+	if event.Price != 150 {
+		t.Fatalf("expected price to follow discounted price, got %d", event.Price)
+	}
+}
+
+func TestConstrainKeepsBothOverriddenSides(t *testing.T) {
+	event := Builder(&constraintEventFactory{}).Build(Override[constraintEvent](map[string]any{
+		"Price":           10,
+		"DiscountedPrice": 20,
+	}))
+
+	if event.Price != 10 || event.DiscountedPrice != 20 {
+		t.Fatalf("expected explicit overrides to be kept, got %+v", event)
+	}
+}
+
+func TestConstrainLeavesSatisfiedRelations(t *testing.T) {
+	event := Builder(&constraintEventFactory{}).Build(Override[constraintEvent](map[string]any{"Price": 90}))
+
+	if event.DiscountedPrice != 80 {
+		t.Fatalf("expected discounted price to stay, got %d", event.DiscountedPrice)
+	}
+}
@@ -0,0 +1,116 @@
+package factory
+
+import (
+	_ "embed"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// iso3166 lists every ISO 3166-1 country as tab-separated alpha-2 code, alpha-3 code and name.
+//
+//go:embed data/iso3166.tsv
+var iso3166 string
+
+const countrySalt = 0x0C0_3166
+
+// Country is an ISO 3166-1 country.
+type Country struct {
+	Alpha2 string
+	Alpha3 string
+	Name   string
+}
+
+var countries = sync.OnceValue(func() []Country {
+	lines := strings.Split(strings.TrimSpace(iso3166), "\n")
+	parsed := make([]Country, 0, len(lines))
+	for _, line := range lines {
+		fields := strings.Split(line, "\t")
+		parsed = append(parsed, Country{Alpha2: fields[0], Alpha3: fields[1], Name: fields[2]})
+	}
+	return parsed
+})
+
+// LookupCountry returns the country with the given alpha-2 or alpha-3 code, case-insensitively.
+func LookupCountry(code string) (Country, bool) {
+	code = strings.ToUpper(code)
+	for _, country := range countries() {
+		if country.Alpha2 == code || country.Alpha3 == code {
+			return country, true
+		}
+	}
+	return Country{}, false
+}
+
+// CountryProperties carries the chosen alpha-2 code and exclusions for CountryFactory.
+type CountryProperties struct {
+	code       string
+	exclusions []string
+}
+
+// CountryFactory picks ISO 3166-1 countries with their alpha-2 and alpha-3 codes and English
+// names. Countries restricts the candidates to the listed codes when set; Exclude removes
+// candidates by alpha-2 or alpha-3 code and is the default of the "exclusions" property, which
+// overrides replace.
+type CountryFactory struct {
+	Countries []string
+	Exclude   []string
+}
+
+// Instantiate returns the country for the chosen code. It panics when an overridden code is not
+// an ISO 3166-1 code.
+func (f *CountryFactory) Instantiate(properties CountryProperties) Country {
+	country, ok := LookupCountry(properties.code)
+	if !ok {
+		panic("country: unknown code " + properties.code)
+	}
+	return country
+}
+
+// Prepare applies overrides and exclusions before choosing a country.
+func (f *CountryFactory) Prepare(overrides Partial[CountryProperties], seed int64) CountryProperties {
+	properties := CountryProperties{
+		exclusions: slices.Clone(f.Exclude),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.code == "" {
+		candidates := f.candidates(properties.exclusions)
+		if len(candidates) == 0 {
+			panic("country: no candidates available after exclusions")
+		}
+		properties.code = candidates[IntAt(seed^countrySalt, 0, int64(len(candidates)-1))].Alpha2
+	}
+
+	return properties
+}
+
+// Retrieve converts a country back into CountryProperties.
+func (f *CountryFactory) Retrieve(instance Country) CountryProperties {
+	return CountryProperties{
+		code:       instance.Alpha2,
+		exclusions: []string{},
+	}
+}
+
+func (f *CountryFactory) candidates(exclusions []string) []Country {
+	matches := func(codes []string, country Country) bool {
+		return slices.ContainsFunc(codes, func(code string) bool {
+			return strings.EqualFold(code, country.Alpha2) || strings.EqualFold(code, country.Alpha3)
+		})
+	}
+
+	candidates := make([]Country, 0, len(countries()))
+	for _, country := range countries() {
+		if len(f.Countries) > 0 && !matches(f.Countries, country) {
+			continue
+		}
+		if !matches(exclusions, country) {
+			candidates = append(candidates, country)
+		}
+	}
+	return candidates
+}
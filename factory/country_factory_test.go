@@ -0,0 +1,59 @@
+package factory
+
+import (
+	"testing"
+)
+
+func TestCountryFactoryCodes(t *testing.T) {
+	if count := len(countries()); count != 249 {
+		t.Fatalf("expected every ISO 3166-1 country, got %d", count)
+	}
+
+	seen := make(map[string]bool)
+	for _, country := range Builder(&CountryFactory{}).BuildList(500, nil) {
+		if len(country.Alpha2) != 2 || len(country.Alpha3) != 3 || country.Name == "" {
+			t.Fatalf("unexpected country %+v", country)
+		}
+		seen[country.Alpha2] = true
+	}
+	if len(seen) < 100 {
+		t.Fatalf("expected varied countries, got %d distinct", len(seen))
+	}
+}
+
+func TestCountryFactoryRestrictsAndExcludes(t *testing.T) {
+	builder := Builder(&CountryFactory{Countries: []string{"JP", "usa", "DEU"}, Exclude: []string{"US"}})
+
+	for _, country := range builder.BuildList(50, nil) {
+		if country.Alpha2 != "JP" && country.Alpha2 != "DE" {
+			t.Fatalf("unexpected country %+v", country)
+		}
+	}
+
+	excluded := Override[CountryProperties](map[string]any{"exclusions": []string{"JPN", "US"}})
+	for _, country := range builder.BuildList(20, excluded) {
+		if country.Alpha2 != "DE" {
+			t.Fatalf("expected only Germany after exclusions, got %+v", country)
+		}
+	}
+}
+
+func TestCountryFactoryPanicsWithoutCandidates(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic")
+		}
+	}()
+
+	Builder(&CountryFactory{Countries: []string{"JP"}, Exclude: []string{"JP"}}).Build(nil)
+}
+
+func TestLookupCountry(t *testing.T) {
+	country, ok := LookupCountry("jpn")
+	if !ok || country != (Country{Alpha2: "JP", Alpha3: "JPN", Name: "Japan"}) {
+		t.Fatalf("unexpected lookup %+v", country)
+	}
+	if _, ok := LookupCountry("XX"); ok {
+		t.Fatal("expected XX to be unknown")
+	}
+}
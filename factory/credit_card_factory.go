@@ -0,0 +1,174 @@
+package factory
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	creditCardBrandSalt  = 0x0CA2_DB7A
+	creditCardExpirySalt = 0x0CA2_DE21
+	creditCardCVVSalt    = 0x0CA2_DC77
+	maxCardExpiryMonths  = 60
+)
+
+// CardBrand identifies a payment card network.
+type CardBrand string
+
+// Card brands supported by CreditCardFactory.
+const (
+	CardVisa       CardBrand = "visa"
+	CardMastercard CardBrand = "mastercard"
+	CardAmex       CardBrand = "amex"
+)
+
+// cardFormat describes the number and CVV layout of a brand. Prefixes are inclusive ranges of the
+// leading digits.
+type cardFormat struct {
+	prefixes  [][2]int
+	length    int
+	cvvLength int
+}
+
+var cardFormats = map[CardBrand]cardFormat{
+	CardVisa:       {prefixes: [][2]int{{4, 4}}, length: 16, cvvLength: 3},
+	CardMastercard: {prefixes: [][2]int{{51, 55}, {2221, 2720}}, length: 16, cvvLength: 3},
+	CardAmex:       {prefixes: [][2]int{{34, 34}, {37, 37}}, length: 15, cvvLength: 4},
+}
+
+// CreditCard is a generated payment card. The number passes the Luhn check.
+type CreditCard struct {
+	Brand       CardBrand
+	Number      string
+	ExpiryMonth time.Month
+	ExpiryYear  int
+	CVV         string
+}
+
+// Expiry formats the expiry date as printed on the card, e.g. "07/29".
+func (c CreditCard) Expiry() string {
+	return fmt.Sprintf("%02d/%02d", int(c.ExpiryMonth), c.ExpiryYear%100)
+}
+
+// CreditCardProperties carries the parts of the card for CreditCardFactory.
+type CreditCardProperties struct {
+	brand  CardBrand
+	number string
+	expiry time.Time
+	cvv    string
+}
+
+// CreditCardFactory generates card numbers that pass the Luhn check for payment-flow tests, with
+// a brand picked from Brands (Visa, Mastercard and Amex when empty), an expiry 1 to 60 months after
+// the current month, or the anchor's with WithTimeAnchor, and a CVV of the brand's length.
+// Override "expiry" with a past month to build expired cards.
+type CreditCardFactory struct {
+	Brands []CardBrand
+
+	anchor time.Time
+}
+
+// Anchored returns a copy of the factory whose expiry dates follow anchor instead of the current
+// time.
+func (f *CreditCardFactory) Anchored(anchor time.Time) Factory[CreditCard, CreditCardProperties] {
+	anchored := *f
+	anchored.anchor = anchor
+	return &anchored
+}
+
+// Instantiate assembles the card.
+func (f *CreditCardFactory) Instantiate(properties CreditCardProperties) CreditCard {
+	return CreditCard{
+		Brand:       properties.brand,
+		Number:      properties.number,
+		ExpiryMonth: properties.expiry.Month(),
+		ExpiryYear:  properties.expiry.Year(),
+		CVV:         properties.cvv,
+	}
+}
+
+// Prepare generates every part that overrides leave empty; the number and CVV follow an
+// overridden brand. It panics on an unsupported brand.
+func (f *CreditCardFactory) Prepare(overrides Partial[CreditCardProperties], seed int64) CreditCardProperties {
+	properties := CreditCardProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.brand == "" {
+		brands := f.Brands
+		if len(brands) == 0 {
+			brands = []CardBrand{CardVisa, CardMastercard, CardAmex}
+		}
+		properties.brand = brands[IntAt(seed^creditCardBrandSalt, 0, int64(len(brands)-1))]
+	}
+
+	format, ok := cardFormats[properties.brand]
+	if !ok {
+		panic(fmt.Sprintf("credit card: unsupported brand %q", properties.brand))
+	}
+
+	if properties.number == "" {
+		properties.number = cardNumber(format, seed)
+	}
+	if properties.expiry.IsZero() {
+		now := f.anchor
+		if now.IsZero() {
+			now = time.Now()
+		}
+		month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		properties.expiry = month.AddDate(0, int(IntAt(seed^creditCardExpirySalt, 1, maxCardExpiryMonths)), 0)
+	}
+	if properties.cvv == "" {
+		properties.cvv = seededDigits(format.cvvLength, seed^creditCardCVVSalt)
+	}
+
+	return properties
+}
+
+// Retrieve converts a card back into CreditCardProperties.
+func (f *CreditCardFactory) Retrieve(instance CreditCard) CreditCardProperties {
+	return CreditCardProperties{
+		brand:  instance.Brand,
+		number: instance.Number,
+		expiry: time.Date(instance.ExpiryYear, instance.ExpiryMonth, 1, 0, 0, 0, 0, time.UTC),
+		cvv:    instance.CVV,
+	}
+}
+
+// cardNumber draws a prefix of format, fills the digits up to the check digit and appends it.
+func cardNumber(format cardFormat, seed int64) string {
+	prefixRange := format.prefixes[IntAt(seed, 0, int64(len(format.prefixes)-1))]
+	prefix := fmt.Sprint(IntAt(seed+1, int64(prefixRange[0]), int64(prefixRange[1])))
+
+	payload := prefix + seededDigits(format.length-len(prefix)-1, seed+2)
+	return payload + string(luhnCheckDigit(payload))
+}
+
+// seededDigits returns count decimal digits drawn from seed.
+func seededDigits(count int, seed int64) string {
+	digits := make([]byte, count)
+	for index := range digits {
+		//nolint:gosec // G115: The digit is within 0-9
+		digits[index] = byte('0' + IntAt(seed+int64(index), 0, 9))
+	}
+	return string(digits)
+}
+
+// luhnCheckDigit returns the digit that makes payload followed by it pass the Luhn check.
+func luhnCheckDigit(payload string) byte {
+	sum := 0
+	for index := range len(payload) {
+		digit := int(payload[len(payload)-1-index] - '0')
+		if index%2 == 0 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	//nolint:gosec // G115: The digit is within 0-9
+	return byte('0' + (10-sum%10)%10)
+}
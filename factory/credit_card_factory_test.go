@@ -0,0 +1,78 @@
+package factory
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+// luhnValid reports whether number passes the Luhn check.
+func luhnValid(number string) bool {
+	return len(number) > 1 && luhnCheckDigit(number[:len(number)-1]) == number[len(number)-1]
+}
+
+func TestCreditCardFactoryBrands(t *testing.T) {
+	patterns := map[CardBrand]*regexp.Regexp{
+		CardVisa:       regexp.MustCompile(`^4[0-9]{15}$`),
+		CardMastercard: regexp.MustCompile(`^(5[1-5][0-9]{14}|2(22[1-9]|2[3-9][0-9]|[3-6][0-9]{2}|7[01][0-9]|720)[0-9]{12})$`),
+		CardAmex:       regexp.MustCompile(`^3[47][0-9]{13}$`),
+	}
+	cvv := map[CardBrand]*regexp.Regexp{
+		CardVisa:       regexp.MustCompile(`^[0-9]{3}$`),
+		CardMastercard: regexp.MustCompile(`^[0-9]{3}$`),
+		CardAmex:       regexp.MustCompile(`^[0-9]{4}$`),
+	}
+
+	seen := make(map[CardBrand]bool)
+	for _, card := range Builder(&CreditCardFactory{}).BuildList(300, nil) {
+		if !patterns[card.Brand].MatchString(card.Number) || !cvv[card.Brand].MatchString(card.CVV) {
+			t.Fatalf("unexpected %s card %+v", card.Brand, card)
+		}
+		if !luhnValid(card.Number) {
+			t.Fatalf("number %s fails the Luhn check", card.Number)
+		}
+		seen[card.Brand] = true
+	}
+
+	if len(seen) != 3 {
+		t.Fatalf("expected every brand, got %v", seen)
+	}
+}
+
+func TestCreditCardFactoryExpiresInTheFuture(t *testing.T) {
+	anchor := time.Date(2030, time.November, 15, 0, 0, 0, 0, time.UTC)
+	builder := Builder(&CreditCardFactory{Brands: []CardBrand{CardVisa}}, WithTimeAnchor(anchor))
+
+	for _, card := range builder.BuildList(200, nil) {
+		expiry := time.Date(card.ExpiryYear, card.ExpiryMonth, 1, 0, 0, 0, 0, time.UTC)
+		if !expiry.After(anchor) || expiry.After(anchor.AddDate(0, maxCardExpiryMonths, 0)) {
+			t.Fatalf("expiry %s outside the five years after the anchor", card.Expiry())
+		}
+	}
+}
+
+func TestCreditCardFactoryOverrides(t *testing.T) {
+	builder := Builder(&CreditCardFactory{})
+
+	card := builder.Build(Override[CreditCardProperties](map[string]any{
+		"brand":  CardAmex,
+		"expiry": time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC),
+	}))
+	if card.Brand != CardAmex || len(card.Number) != 15 || len(card.CVV) != 4 {
+		t.Fatalf("expected an Amex card, got %+v", card)
+	}
+	if card.Expiry() != "03/20" {
+		t.Fatalf("expected the overridden expiry, got %s", card.Expiry())
+	}
+}
+
+func TestLuhnCheckDigit(t *testing.T) {
+	for _, number := range []string{"4111111111111111", "5555555555554444", "378282246310005", "79927398713"} {
+		if !luhnValid(number) {
+			t.Fatalf("expected %s to pass the Luhn check", number)
+		}
+	}
+	if luhnValid("4111111111111112") {
+		t.Fatal("expected an invalid number to fail")
+	}
+}
@@ -0,0 +1,120 @@
+package factory
+
+import (
+	_ "embed"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// iso4217 lists the circulating ISO 4217 currencies as tab-separated code and minor units. Fund
+// codes, precious metals and testing codes are left out.
+//
+//go:embed data/iso4217.tsv
+var iso4217 string
+
+const currencySalt = 0x0C0_4217
+
+var currencies = sync.OnceValue(func() []Currency {
+	lines := strings.Split(strings.TrimSpace(iso4217), "\n")
+	parsed := make([]Currency, 0, len(lines))
+	for _, line := range lines {
+		code, minorUnits, _ := strings.Cut(line, "\t")
+		units, err := strconv.Atoi(minorUnits)
+		if err != nil {
+			panic("currency: invalid minor units for " + code)
+		}
+		parsed = append(parsed, Currency{Code: code, MinorUnits: units})
+	}
+	return parsed
+})
+
+// LookupCurrency returns the circulating ISO 4217 currency with code, case-insensitively.
+func LookupCurrency(code string) (Currency, bool) {
+	code = strings.ToUpper(code)
+	for _, currency := range currencies() {
+		if currency.Code == code {
+			return currency, true
+		}
+	}
+	return Currency{}, false
+}
+
+// mustLookupCurrency returns the currency with code and panics when it is not circulating.
+func mustLookupCurrency(code string) Currency {
+	currency, ok := LookupCurrency(code)
+	if !ok {
+		panic("currency: unknown code " + code)
+	}
+	return currency
+}
+
+// CurrencyProperties carries the chosen code and exclusions for CurrencyFactory.
+type CurrencyProperties struct {
+	code       string
+	exclusions []string
+}
+
+// CurrencyFactory picks circulating ISO 4217 currencies with their minor units, e.g. for the
+// currency column of a ledger or as the pool of a MoneyFactory. Codes restricts the candidates
+// when set; Exclude removes codes and is the default of the "exclusions" property, which
+// overrides replace.
+type CurrencyFactory struct {
+	Codes   []string
+	Exclude []string
+}
+
+// Instantiate returns the currency for the chosen code. It panics when an overridden code is not
+// a circulating ISO 4217 code.
+func (f *CurrencyFactory) Instantiate(properties CurrencyProperties) Currency {
+	return mustLookupCurrency(properties.code)
+}
+
+// Prepare applies overrides and exclusions before choosing a currency.
+func (f *CurrencyFactory) Prepare(overrides Partial[CurrencyProperties], seed int64) CurrencyProperties {
+	properties := CurrencyProperties{
+		exclusions: slices.Clone(f.Exclude),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.code == "" {
+		candidates := f.candidates(properties.exclusions)
+		if len(candidates) == 0 {
+			panic("currency: no candidates available after exclusions")
+		}
+		properties.code = candidates[IntAt(seed^currencySalt, 0, int64(len(candidates)-1))].Code
+	}
+
+	return properties
+}
+
+// Retrieve converts a currency back into CurrencyProperties.
+func (f *CurrencyFactory) Retrieve(instance Currency) CurrencyProperties {
+	return CurrencyProperties{
+		code:       instance.Code,
+		exclusions: []string{},
+	}
+}
+
+func (f *CurrencyFactory) candidates(exclusions []string) []Currency {
+	matches := func(codes []string, currency Currency) bool {
+		return slices.ContainsFunc(codes, func(code string) bool {
+			return strings.EqualFold(code, currency.Code)
+		})
+	}
+
+	candidates := make([]Currency, 0, len(currencies()))
+	for _, currency := range currencies() {
+		if len(f.Codes) > 0 && !matches(f.Codes, currency) {
+			continue
+		}
+		if !matches(exclusions, currency) {
+			candidates = append(candidates, currency)
+		}
+	}
+	return candidates
+}
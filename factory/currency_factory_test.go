@@ -0,0 +1,69 @@
+package factory
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCurrencyFactoryCodes(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, currency := range Builder(&CurrencyFactory{}).BuildList(500, nil) {
+		if len(currency.Code) != 3 || currency.MinorUnits < 0 || currency.MinorUnits > 3 {
+			t.Fatalf("unexpected currency %+v", currency)
+		}
+		if currency.Code[0] == 'X' && !slices.Contains([]string{"XAF", "XCD", "XCG", "XOF", "XPF"}, currency.Code) {
+			t.Fatalf("expected only circulating currencies, got %s", currency.Code)
+		}
+		seen[currency.Code] = true
+	}
+	if len(seen) < 80 {
+		t.Fatalf("expected varied currencies, got %d distinct", len(seen))
+	}
+}
+
+func TestCurrencyFactoryMatchesPredefinedCurrencies(t *testing.T) {
+	for _, expected := range []Currency{Currencies.USD, Currencies.JPY, Currencies.KWD, Currencies.EUR} {
+		if currency, ok := LookupCurrency(expected.Code); !ok || currency != expected {
+			t.Fatalf("expected %+v, got %+v", expected, currency)
+		}
+	}
+}
+
+func TestLookupCurrencyFollowsCurrentList(t *testing.T) {
+	for _, withdrawn := range []string{"ANG", "BGN", "CUC", "HRK", "SLL", "ZWL"} {
+		if currency, ok := LookupCurrency(withdrawn); ok {
+			t.Fatalf("expected withdrawn %s to be absent, got %+v", withdrawn, currency)
+		}
+	}
+	for _, code := range []string{"XCG", "ZWG", "SLE"} {
+		if currency, ok := LookupCurrency(code); !ok || currency.MinorUnits != 2 {
+			t.Fatalf("expected %s with 2 minor units, got %+v, %v", code, currency, ok)
+		}
+	}
+}
+
+func TestCurrencyFactoryRestrictsAndExcludes(t *testing.T) {
+	builder := Builder(&CurrencyFactory{Codes: []string{"usd", "EUR", "JPY"}, Exclude: []string{"EUR"}})
+
+	for _, currency := range builder.BuildList(50, nil) {
+		if currency.Code != "USD" && currency.Code != "JPY" {
+			t.Fatalf("unexpected currency %+v", currency)
+		}
+	}
+
+	for _, currency := range builder.BuildList(20, Override[CurrencyProperties](map[string]any{"exclusions": []string{"USD", "EUR"}})) {
+		if currency != Currencies.JPY {
+			t.Fatalf("expected JPY after exclusions, got %+v", currency)
+		}
+	}
+}
+
+func TestCurrencyFactoryFeedsMoneyFactory(t *testing.T) {
+	pool := Builder(&CurrencyFactory{Codes: []string{"JPY", "BHD"}}).BuildListWith(2, 1, nil)
+
+	for _, money := range Builder(&MoneyFactory{Currencies: pool}).BuildList(20, nil) {
+		if money.Currency != Currencies.JPY && money.Currency != Currencies.BHD {
+			t.Fatalf("unexpected currency %+v", money.Currency)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package factory
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Dataset names used by the data packs under github.com/lihs-ie/forge/datapack.
+const (
+	DatasetFirstNames = "person.first_name"
+	DatasetLastNames  = "person.last_name"
+	DatasetCities     = "address.city"
+	DatasetStreets    = "address.street"
+	DatasetWords      = "lorem.word"
+)
+
+type datasetKey struct {
+	locale Locale
+	name   string
+}
+
+var (
+	datasetsMu sync.RWMutex
+	datasets   = make(map[datasetKey][]string)
+)
+
+// RegisterDataset makes values available as the named dataset for locale. Data packs call it
+// from init, so importing a pack for its side effects is enough to enable it:
+//
+//	import _ "github.com/lihs-ie/forge/datapack/ja"
+//
+// It panics when name is empty, values is empty or the dataset is already registered.
+func RegisterDataset(locale Locale, name string, values []string) {
+	if name == "" || len(values) == 0 {
+		panic("dataset: name and values are required")
+	}
+
+	datasetsMu.Lock()
+	defer datasetsMu.Unlock()
+
+	key := datasetKey{locale: locale, name: name}
+	if _, exists := datasets[key]; exists {
+		panic(fmt.Sprintf("dataset: %q is already registered for locale %q", name, locale))
+	}
+
+	datasets[key] = slices.Clone(values)
+}
+
+// LookupDataset returns the named dataset for locale, falling back to DefaultLocale when the
+// locale has none. The returned slice must not be modified.
+func LookupDataset(locale Locale, name string) ([]string, bool) {
+	datasetsMu.RLock()
+	defer datasetsMu.RUnlock()
+
+	if values, ok := datasets[datasetKey{locale: resolveLocale(locale), name: name}]; ok {
+		return values, true
+	}
+
+	values, ok := datasets[datasetKey{locale: DefaultLocale, name: name}]
+	return values, ok
+}
+
+// LookupLocaleDataset returns the named dataset registered for exactly locale, without falling
+// back to DefaultLocale. The returned slice must not be modified.
+func LookupLocaleDataset(locale Locale, name string) ([]string, bool) {
+	datasetsMu.RLock()
+	defer datasetsMu.RUnlock()
+
+	values, ok := datasets[datasetKey{locale: resolveLocale(locale), name: name}]
+	return values, ok
+}
+
+// DatasetProperties carries the dataset name, locale and chosen value for DatasetFactory.
+type DatasetProperties struct {
+	name   string
+	locale Locale
+	value  string
+}
+
+// DatasetFactory picks values from a registered dataset, such as DatasetFirstNames, in the
+// builder's locale. Prepare panics when no pack providing the dataset has been imported.
+type DatasetFactory struct {
+	Name string
+
+	locale Locale
+}
+
+// Localized returns a copy of the factory bound to locale.
+func (f *DatasetFactory) Localized(locale Locale) Factory[string, DatasetProperties] {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Instantiate returns the chosen value.
+func (f *DatasetFactory) Instantiate(properties DatasetProperties) string {
+	return properties.value
+}
+
+// Prepare picks a value from the dataset; a non-empty value from overrides is kept.
+func (f *DatasetFactory) Prepare(overrides Partial[DatasetProperties], seed int64) DatasetProperties {
+	properties := DatasetProperties{
+		name:   f.Name,
+		locale: f.locale,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	properties.locale = resolveLocale(properties.locale)
+
+	if properties.value == "" {
+		values, ok := LookupDataset(properties.locale, properties.name)
+		if !ok {
+			panic(fmt.Sprintf("dataset: %q is not registered; import a data pack that provides it", properties.name))
+		}
+		properties.value = values[IntAt(seed, 0, int64(len(values)-1))]
+	}
+
+	return properties
+}
+
+// Retrieve converts a value back into DatasetProperties.
+func (f *DatasetFactory) Retrieve(instance string) DatasetProperties {
+	return DatasetProperties{
+		name:   f.Name,
+		locale: f.locale,
+		value:  instance,
+	}
+}
@@ -0,0 +1,75 @@
+package factory
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDatasetFactoryPicksFromRegisteredValues(t *testing.T) {
+	RegisterDataset(LocaleEnglish, "test.color", []string{"red", "green", "blue"})
+	RegisterDataset(LocaleJapanese, "test.color", []string{"赤", "緑", "青"})
+
+	english := Builder(&DatasetFactory{Name: "test.color"}, WithLocale(LocaleEnglish))
+	for _, value := range english.BuildList(20, nil) {
+		if !slices.Contains([]string{"red", "green", "blue"}, value) {
+			t.Fatalf("unexpected english value %q", value)
+		}
+	}
+
+	japanese := Builder(&DatasetFactory{Name: "test.color"}, WithLocale(LocaleJapanese))
+	for _, value := range japanese.BuildList(20, nil) {
+		if !slices.Contains([]string{"赤", "緑", "青"}, value) {
+			t.Fatalf("unexpected japanese value %q", value)
+		}
+	}
+
+	if english.BuildWith(3, nil) != english.BuildWith(3, nil) {
+		t.Fatal("expected the same value for the same seed")
+	}
+}
+
+func TestLookupDatasetFallsBackToDefaultLocale(t *testing.T) {
+	RegisterDataset(DefaultLocale, "test.fallback", []string{"only"})
+
+	values, ok := LookupDataset(LocaleGerman, "test.fallback")
+	if !ok || len(values) != 1 || values[0] != "only" {
+		t.Fatalf("expected fallback to the default locale, got %v %v", values, ok)
+	}
+
+	if _, ok := LookupDataset(LocaleGerman, "test.missing"); ok {
+		t.Fatal("expected missing dataset")
+	}
+}
+
+func TestLookupLocaleDatasetDoesNotFallBack(t *testing.T) {
+	RegisterDataset(DefaultLocale, "test.exact", []string{"default"})
+
+	if _, ok := LookupLocaleDataset(LocaleGerman, "test.exact"); ok {
+		t.Fatal("expected no fallback to the default locale")
+	}
+	if values, ok := LookupLocaleDataset(DefaultLocale, "test.exact"); !ok || values[0] != "default" {
+		t.Fatalf("expected the exact locale dataset, got %v %v", values, ok)
+	}
+}
+
+func TestDatasetFactoryPanicsWithoutPack(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for an unregistered dataset")
+		}
+	}()
+
+	Builder(&DatasetFactory{Name: "test.unregistered"}).Build(nil)
+}
+
+func TestRegisterDatasetRejectsDuplicates(t *testing.T) {
+	RegisterDataset(LocaleEnglish, "test.duplicate", []string{"a"})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for a duplicate dataset")
+		}
+	}()
+
+	RegisterDataset(LocaleEnglish, "test.duplicate", []string{"b"})
+}
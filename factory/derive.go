@@ -0,0 +1,22 @@
+package factory
+
+// Derive combines overrides with derivations that recompute dependent properties.
+// Derivations run in order after overrides, so overriding an input keeps derived
+// properties consistent. Factories typically call it from Prepare:
+//
+//	Derive(overrides, func(p *UserProperties) {
+//		p.FullName = p.First + " " + p.Last
+//	})(&properties)
+func Derive[P any](overrides Partial[P], derivations ...Partial[P]) Partial[P] {
+	return func(properties *P) {
+		if overrides != nil {
+			overrides(properties)
+		}
+
+		for _, derivation := range derivations {
+			if derivation != nil {
+				derivation(properties)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package factory
+
+import "testing"
+
+type deriveOrder struct {
+	Items []int
+	Total int
+	Label string
+}
+
+type deriveOrderFactory struct{}
+
+func (f *deriveOrderFactory) Instantiate(props deriveOrder) deriveOrder {
+	return props
+}
+
+func (f *deriveOrderFactory) Prepare(overrides Partial[deriveOrder], seed int64) deriveOrder {
+	props := deriveOrder{Items: []int{int(seed), 1}}
+
+	Derive(overrides, func(p *deriveOrder) {
+		p.Total = 0
+		for _, item := range p.Items {
+			p.Total += item
+		}
+	}, func(p *deriveOrder) {
+		if p.Label == "" {
+			p.Label = "order"
+		}
+	})(&props)
+
+	return props
+}
+
+func (f *deriveOrderFactory) Retrieve(instance deriveOrder) deriveOrder {
+	return instance
+}
+
+func TestDeriveComputesFromDefaults(t *testing.T) {
+	builder := Builder(&deriveOrderFactory{})
+
+	order := builder.BuildWith(4, nil)
+	if order.Total != 5 {
+		t.Fatalf("expected total 5, got %d", order.Total)
+	}
+	if order.Label != "order" {
+		t.Fatalf("expected default label, got %s", order.Label)
+	}
+}
+
+func TestDeriveRunsAfterOverrides(t *testing.T) {
+	builder := Builder(&deriveOrderFactory{})
+
+	order := builder.BuildWith(4, Override[deriveOrder](map[string]any{
+		"Items": []int{10, 20, 30},
+		"Label": "custom",
+	}))
+
+	if order.Total != 60 {
+		t.Fatalf("expected derived total 60, got %d", order.Total)
+	}
+	if order.Label != "custom" {
+		t.Fatalf("expected overridden label, got %s", order.Label)
+	}
+}
+
+func TestDeriveRunsInOrder(t *testing.T) {
+	var calls []string
+	partial := Derive(nil, func(*deriveOrder) {
+		calls = append(calls, "first")
+	}, nil, func(*deriveOrder) {
+		calls = append(calls, "second")
+	})
+
+	partial(&deriveOrder{})
+
+	if len(calls) != 2 || calls[0] != "first" || calls[1] != "second" {
+		t.Fatalf("expected ordered derivations, got %v", calls)
+	}
+}
@@ -0,0 +1,60 @@
+package factory
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// PropertyDiff describes one property whose value differs between two instances.
+type PropertyDiff struct {
+	Field  string
+	Before any
+	After  any
+}
+
+func (d PropertyDiff) String() string {
+	return fmt.Sprintf("%s: %v -> %v", d.Field, d.Before, d.After)
+}
+
+// Diff compares a and b through factory.Retrieve and returns the top-level properties that differ,
+// in field order. Unexported properties are compared too; time.Time values are compared with Equal.
+//
+//	diffs := factory.Diff(users, before, after)
+//	// len(diffs) == 1 && diffs[0].Field == "Status"
+func Diff[T any, P any](factory Factory[T, P], a, b T) []PropertyDiff {
+	before := factory.Retrieve(a)
+	after := factory.Retrieve(b)
+
+	beforeValue := reflect.ValueOf(&before).Elem()
+	afterValue := reflect.ValueOf(&after).Elem()
+	if beforeValue.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("diff: properties must be a struct, got %s", beforeValue.Kind()))
+	}
+
+	var diffs []PropertyDiff
+	for index := range beforeValue.NumField() {
+		beforeField := exposeField(beforeValue.Field(index))
+		afterField := exposeField(afterValue.Field(index))
+
+		if propertyEqual(beforeField, afterField) {
+			continue
+		}
+
+		diffs = append(diffs, PropertyDiff{
+			Field:  beforeValue.Type().Field(index).Name,
+			Before: beforeField.Interface(),
+			After:  afterField.Interface(),
+		})
+	}
+
+	return diffs
+}
+
+func propertyEqual(a, b reflect.Value) bool {
+	if first, ok := a.Interface().(time.Time); ok {
+		second, _ := b.Interface().(time.Time)
+		return first.Equal(second)
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
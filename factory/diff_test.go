@@ -0,0 +1,60 @@
+package factory
+
+import (
+	"testing"
+	"time"
+)
+
+type diffProps struct {
+	ID        string
+	Status    string
+	CreatedAt time.Time
+	tags      []string
+}
+
+type diffFactory struct{}
+
+func (f *diffFactory) Instantiate(props diffProps) diffProps {
+	return props
+}
+
+func (f *diffFactory) Prepare(overrides Partial[diffProps], _ int64) diffProps {
+	props := diffProps{}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *diffFactory) Retrieve(instance diffProps) diffProps {
+	return instance
+}
+
+func TestDiffReportsChangedProperties(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := diffProps{ID: "1", Status: "draft", CreatedAt: created, tags: []string{"a"}}
+	after := diffProps{ID: "1", Status: "published", CreatedAt: created.In(time.FixedZone("JST", 9*3600)), tags: []string{"a", "b"}}
+
+	diffs := Diff(&diffFactory{}, before, after)
+
+	if len(diffs) != 2 {
+		t.Fatalf("expected 2 diffs, got %v", diffs)
+	}
+	if diffs[0].Field != "Status" || diffs[0].Before != "draft" || diffs[0].After != "published" {
+		t.Fatalf("unexpected status diff: %v", diffs[0])
+	}
+	if diffs[1].Field != "tags" {
+		t.Fatalf("expected unexported field diff, got %v", diffs[1])
+	}
+	if got := diffs[0].String(); got != "Status: draft -> published" {
+		t.Fatalf("unexpected string form %q", got)
+	}
+}
+
+func TestDiffOfEqualInstancesIsEmpty(t *testing.T) {
+	instance := diffProps{ID: "1", tags: []string{"a"}}
+
+	if diffs := Diff(&diffFactory{}, instance, instance); len(diffs) != 0 {
+		t.Fatalf("expected no diffs, got %v", diffs)
+	}
+}
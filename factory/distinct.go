@@ -0,0 +1,170 @@
+package factory
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"time"
+)
+
+// DistinctValues makes BuildList, BuildListWith and BuildListChunked guarantee that the instances
+// of one call are pairwise distinct in their properties, as returned by Retrieve. Distinct seeds
+// do not imply distinct values for small domains, so colliding instances are rebuilt with fresh
+// seeds, up to 100 times each before the builder panics. BuildListWith draws the fresh seeds after
+// the list's own, so its lists stay deterministic. Properties are compared by hash, ignoring func
+// and channel fields such as the resolver of a Lazy.
+func DistinctValues() BuilderOption {
+	return func(opts *builderOptions) {
+		opts.distinct = true
+	}
+}
+
+// distinctTracker remembers the property values built so far within one list.
+type distinctTracker map[uint64]struct{}
+
+// newDistinctTracker returns the tracker for a list of size instances, or nil without
+// DistinctValues. Under BulkAllocation it is sized for the whole list up front.
+func (b *builderInstance[T, P]) newDistinctTracker(size int) distinctTracker {
+	if !b.distinct {
+		return nil
+	}
+	if b.bulk {
+		return make(distinctTracker, size)
+	}
+	return make(distinctTracker)
+}
+
+// createListed builds an instance for a list, enforcing distinct values when seen is non-nil.
+// A non-nil buffer receives the prepared properties instead of a fresh allocation.
+func (b *builderInstance[T, P]) createListed(overrides Overrider[P], seed int64, seen distinctTracker, buffer *P) T {
+	if seen == nil {
+		if buffer != nil {
+			return createInto(b.factory, overrides, seed, buffer)
+		}
+		return create(b.factory, overrides, seed)
+	}
+	return b.createDistinct(overrides, seed, seen, b.nextSeed)
+}
+
+// createDistinct builds an instance for seed, retrying with seeds from next while its properties
+// collide with an instance already tracked by seen.
+func (b *builderInstance[T, P]) createDistinct(overrides Overrider[P], seed int64, seen distinctTracker, next func() int64) T {
+	for range defaultFilterAttempts {
+		instance := create(b.factory, overrides, seed)
+
+		key := propertiesKey(b.factory.Retrieve(instance))
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			return instance
+		}
+
+		seed = next()
+	}
+
+	panic(fmt.Sprintf("builder: no distinct value found within %d attempts", defaultFilterAttempts))
+}
+
+// propertiesKey hashes properties including unexported fields. Func, channel and unsafe pointer
+// fields are skipped, and pointers already being hashed are not followed again, so cyclic values
+// terminate. A hash collision only costs a retry.
+func propertiesKey[P any](properties P) uint64 {
+	hasher := fnv.New64a()
+	writePropertiesHash(hasher, reflect.ValueOf(&properties).Elem(), make(map[uintptr]struct{}))
+	return hasher.Sum64()
+}
+
+var distinctTimeType = reflect.TypeOf(time.Time{})
+
+func writePropertiesHash(hasher hash.Hash64, value reflect.Value, visited map[uintptr]struct{}) {
+	var scratch [8]byte
+	writeUint := func(number uint64) {
+		binary.LittleEndian.PutUint64(scratch[:], number)
+		hasher.Write(scratch[:])
+	}
+
+	if !value.IsValid() {
+		writeUint(0)
+		return
+	}
+	writeUint(uint64(value.Kind()))
+
+	if value.Type() == distinctTimeType {
+		instant := exposeField(value).Interface().(time.Time)
+		writeUint(uint64(instant.UnixNano()))
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(math.Float64bits(value.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		writeUint(math.Float64bits(real(value.Complex())))
+		writeUint(math.Float64bits(imag(value.Complex())))
+	case reflect.String:
+		writeUint(uint64(value.Len()))
+		hasher.Write([]byte(value.String()))
+	case reflect.Pointer:
+		if value.IsNil() {
+			return
+		}
+		address := value.Pointer()
+		if _, seen := visited[address]; seen {
+			return
+		}
+		visited[address] = struct{}{}
+		writePropertiesHash(hasher, value.Elem(), visited)
+		delete(visited, address)
+	case reflect.Interface:
+		if !value.IsNil() {
+			writePropertiesHash(hasher, addressable(value.Elem()), visited)
+		}
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return
+		}
+		writeUint(uint64(value.Len()))
+		for index := range value.Len() {
+			writePropertiesHash(hasher, value.Index(index), visited)
+		}
+	case reflect.Map:
+		if value.IsNil() {
+			return
+		}
+		writeUint(uint64(value.Len()))
+		var combined uint64
+		iterator := value.MapRange()
+		for iterator.Next() {
+			entry := fnv.New64a()
+			writePropertiesHash(entry, addressable(iterator.Key()), visited)
+			writePropertiesHash(entry, addressable(iterator.Value()), visited)
+			combined ^= entry.Sum64()
+		}
+		writeUint(combined)
+	case reflect.Struct:
+		for index := range value.NumField() {
+			writePropertiesHash(hasher, exposeField(value.Field(index)), visited)
+		}
+	default:
+		// Func, channel and unsafe pointer values carry nothing comparable.
+	}
+}
+
+// addressable copies value into a fresh variable so exposeField can read its unexported fields.
+func addressable(value reflect.Value) reflect.Value {
+	copied := reflect.New(value.Type()).Elem()
+	copied.Set(value)
+	return copied
+}
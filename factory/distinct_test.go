@@ -0,0 +1,80 @@
+package factory
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDistinctValuesBuildListIsPairwiseDistinct(t *testing.T) {
+	builder := Builder(&IntFactory{Min: 1, Max: 20}, DistinctValues())
+
+	values := builder.BuildList(20, nil)
+	slices.Sort(values)
+	for index, value := range values {
+		if value != index+1 {
+			t.Fatalf("expected every value of the domain exactly once, got %v", values)
+		}
+	}
+}
+
+func TestDistinctValuesBuildListWithIsDeterministic(t *testing.T) {
+	builder := Builder(&IntFactory{Min: 1, Max: 10}, DistinctValues())
+
+	first := builder.BuildListWith(10, 42, nil)
+	second := builder.BuildListWith(10, 42, nil)
+	if !slices.Equal(first, second) {
+		t.Fatalf("expected the same list for the same seed, got %v and %v", first, second)
+	}
+
+	sorted := slices.Clone(first)
+	slices.Sort(sorted)
+	if len(slices.Compact(sorted)) != 10 {
+		t.Fatalf("expected pairwise distinct values, got %v", first)
+	}
+}
+
+func TestDistinctValuesPanicsWhenDomainIsExhausted(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic when no distinct value is left")
+		}
+	}()
+
+	Builder(NewEnumFactory([]string{"a", "b"}), DistinctValues()).BuildList(3, nil)
+}
+
+type lazyProperties struct {
+	name  string
+	owner *Lazy[string]
+	done  chan struct{}
+}
+
+func TestPropertiesKeyIgnoresFuncAndChanFields(t *testing.T) {
+	first := propertiesKey(lazyProperties{name: "a", owner: NewLazy(func() string { return "x" }), done: make(chan struct{})})
+	second := propertiesKey(lazyProperties{name: "a", owner: NewLazy(func() string { return "y" })})
+	other := propertiesKey(lazyProperties{name: "b", owner: NewLazy(func() string { return "x" })})
+
+	if first != second {
+		t.Fatal("expected func and chan fields to be ignored")
+	}
+	if first == other {
+		t.Fatal("expected unexported fields to be compared")
+	}
+}
+
+type cyclicProperties struct {
+	value int
+	next  *cyclicProperties
+}
+
+func TestPropertiesKeyTerminatesOnCycles(t *testing.T) {
+	node := &cyclicProperties{value: 1}
+	node.next = node
+
+	if propertiesKey(node) != propertiesKey(node) {
+		t.Fatal("expected a stable key for a cyclic value")
+	}
+	if propertiesKey(map[string]any{"a": 1, "b": 2}) != propertiesKey(map[string]any{"b": 2, "a": 1}) {
+		t.Fatal("expected map keys to be hashed independently of iteration order")
+	}
+}
@@ -0,0 +1,146 @@
+package factory
+
+import (
+	"fmt"
+	stdmath "math"
+	"math/rand"
+)
+
+// Distribution draws a number from a probability distribution, deterministically from seed.
+type Distribution interface {
+	Sample(seed int64) float64
+}
+
+type uniformDistribution struct {
+	minimum float64
+	maximum float64
+}
+
+// Uniform returns the continuous uniform distribution over [minimum, maximum). It panics when
+// maximum is below minimum.
+func Uniform(minimum, maximum float64) Distribution {
+	if maximum < minimum {
+		panic(fmt.Sprintf("distribution: uniform maximum %v is below minimum %v", maximum, minimum))
+	}
+	return uniformDistribution{minimum: minimum, maximum: maximum}
+}
+
+func (d uniformDistribution) Sample(seed int64) float64 {
+	return FloatAt(seed, d.minimum, d.maximum)
+}
+
+type normalDistribution struct {
+	mean   float64
+	stddev float64
+}
+
+// Normal returns the normal distribution with the given mean and standard deviation. It panics
+// when stddev is negative.
+func Normal(mean, stddev float64) Distribution {
+	if stddev < 0 {
+		panic(fmt.Sprintf("distribution: negative standard deviation %v", stddev))
+	}
+	return normalDistribution{mean: mean, stddev: stddev}
+}
+
+// Sample uses the Box-Muller transform on two uniform draws.
+func (d normalDistribution) Sample(seed int64) float64 {
+	radius := stdmath.Sqrt(-2 * stdmath.Log(1-FloatAt(seed, 0, 1)))
+	angle := 2 * stdmath.Pi * FloatAt(childSeed(seed, 0), 0, 1)
+	return d.mean + d.stddev*radius*stdmath.Cos(angle)
+}
+
+type exponentialDistribution struct {
+	rate float64
+}
+
+// Exponential returns the exponential distribution with the given rate, whose mean is 1/rate. It
+// panics when rate is not positive.
+func Exponential(rate float64) Distribution {
+	if !(rate > 0) {
+		panic(fmt.Sprintf("distribution: exponential rate %v must be positive", rate))
+	}
+	return exponentialDistribution{rate: rate}
+}
+
+func (d exponentialDistribution) Sample(seed int64) float64 {
+	return -stdmath.Log(1-FloatAt(seed, 0, 1)) / d.rate
+}
+
+type zipfDistribution struct {
+	exponent float64
+	offset   float64
+	maximum  uint64
+}
+
+// Zipf returns the Zipf distribution over the whole numbers [0, maximum] used by rand.Zipf, where
+// k is drawn in proportion to (offset+k)^-exponent: small values dominate, as with popular keys
+// or hot products. It panics unless exponent > 1 and offset >= 1.
+func Zipf(exponent, offset float64, maximum uint64) Distribution {
+	if !(exponent > 1) || !(offset >= 1) {
+		panic(fmt.Sprintf("distribution: zipf needs exponent > 1 and offset >= 1, got %v and %v", exponent, offset))
+	}
+	return zipfDistribution{exponent: exponent, offset: offset, maximum: maximum}
+}
+
+// Sample runs rand.Zipf on a SeedStream seeded with seed.
+func (d zipfDistribution) Sample(seed int64) float64 {
+	stream := &SeedStream{}
+	stream.Seed(seed)
+	//nolint:gosec // G404: math/rand is acceptable for deterministic test data generation
+	return float64(rand.NewZipf(rand.New(stream), d.exponent, d.offset, d.maximum).Uint64())
+}
+
+// DistributionProperties carries the sampled number for DistributionFactory.
+type DistributionProperties struct {
+	value float64
+}
+
+// DistributionFactory generates numbers drawn from Distribution (Uniform(0, 1) when nil), for
+// load-test data shaped like production: normal response sizes, exponential gaps between events
+// or Zipf-distributed key popularity. Precision rounds values to that many fraction digits (0
+// keeps full precision).
+type DistributionFactory struct {
+	Distribution Distribution
+	Precision    int
+}
+
+// Instantiate returns the sampled number.
+func (f *DistributionFactory) Instantiate(properties DistributionProperties) float64 {
+	return properties.value
+}
+
+// Prepare samples the distribution before applying overrides, so overriding with 0 is honored.
+func (f *DistributionFactory) Prepare(overrides Partial[DistributionProperties], seed int64) DistributionProperties {
+	properties := DistributionProperties{
+		value: f.Generate(seed),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return properties
+}
+
+// Retrieve wraps an existing number into DistributionProperties.
+func (f *DistributionFactory) Retrieve(instance float64) DistributionProperties {
+	return DistributionProperties{
+		value: instance,
+	}
+}
+
+// Generate produces the same number as building with seed and no overrides.
+func (f *DistributionFactory) Generate(seed int64) float64 {
+	distribution := f.Distribution
+	if distribution == nil {
+		distribution = Uniform(0, 1)
+	}
+
+	value := distribution.Sample(seed)
+	if f.Precision > 0 {
+		scale := stdmath.Pow10(min(f.Precision, maxFloatPrecisionDigit))
+		value = stdmath.Round(value*scale) / scale
+	}
+	return value
+}
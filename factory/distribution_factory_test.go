@@ -0,0 +1,109 @@
+package factory
+
+import (
+	stdmath "math"
+	"testing"
+)
+
+func sampleStatistics(t *testing.T, factory *DistributionFactory, count int) (mean, stddev float64, values []float64) {
+	t.Helper()
+
+	values = Builder(factory).BuildListWith(count, 1, nil)
+	for _, value := range values {
+		mean += value
+	}
+	mean /= float64(count)
+	for _, value := range values {
+		stddev += (value - mean) * (value - mean)
+	}
+	return mean, stdmath.Sqrt(stddev / float64(count)), values
+}
+
+func TestDistributionFactoryUniform(t *testing.T) {
+	mean, _, values := sampleStatistics(t, &DistributionFactory{Distribution: Uniform(10, 20)}, 5000)
+
+	for _, value := range values {
+		if value < 10 || value >= 20 {
+			t.Fatalf("value %v out of range", value)
+		}
+	}
+	if stdmath.Abs(mean-15) > 0.3 {
+		t.Fatalf("expected a mean near 15, got %v", mean)
+	}
+}
+
+func TestDistributionFactoryNormal(t *testing.T) {
+	mean, stddev, _ := sampleStatistics(t, &DistributionFactory{Distribution: Normal(100, 15)}, 5000)
+
+	if stdmath.Abs(mean-100) > 1.5 || stdmath.Abs(stddev-15) > 1.5 {
+		t.Fatalf("expected mean 100 and stddev 15, got %v and %v", mean, stddev)
+	}
+}
+
+func TestDistributionFactoryExponential(t *testing.T) {
+	mean, _, values := sampleStatistics(t, &DistributionFactory{Distribution: Exponential(0.5)}, 5000)
+
+	for _, value := range values {
+		if value < 0 {
+			t.Fatalf("negative value %v", value)
+		}
+	}
+	if stdmath.Abs(mean-2) > 0.2 {
+		t.Fatalf("expected a mean near 2, got %v", mean)
+	}
+}
+
+func TestDistributionFactoryZipf(t *testing.T) {
+	_, _, values := sampleStatistics(t, &DistributionFactory{Distribution: Zipf(1.5, 1, 99)}, 5000)
+
+	counts := make(map[float64]int)
+	for _, value := range values {
+		if value < 0 || value > 99 || value != stdmath.Trunc(value) {
+			t.Fatalf("value %v is not a whole number in [0, 99]", value)
+		}
+		counts[value]++
+	}
+	if counts[0] < counts[1] || counts[1] < counts[10] || counts[0] < len(values)/4 {
+		t.Fatalf("expected small values to dominate, got %v", counts)
+	}
+}
+
+func TestDistributionFactoryDefaultsAndPrecision(t *testing.T) {
+	for _, value := range Builder(&DistributionFactory{}).BuildListWith(100, 1, nil) {
+		if value < 0 || value >= 1 {
+			t.Fatalf("value %v out of the default range", value)
+		}
+	}
+
+	factory := &DistributionFactory{Distribution: Normal(0, 10), Precision: 1}
+	for seed := range int64(20) {
+		value := factory.Generate(seed)
+		if value != Builder(factory).BuildWith(seed, nil) {
+			t.Fatalf("expected Build and Generate to agree for seed %d", seed)
+		}
+		if stdmath.Abs(value*10-stdmath.Round(value*10)) > 1e-9 {
+			t.Fatalf("expected one fraction digit, got %v", value)
+		}
+	}
+}
+
+func TestDistributionConstructorsPanicForInvalidParameters(t *testing.T) {
+	invalid := map[string]func(){
+		"uniform":     func() { Uniform(2, 1) },
+		"normal":      func() { Normal(0, -1) },
+		"exponential": func() { Exponential(0) },
+		"zipf":        func() { Zipf(1, 1, 10) },
+	}
+
+	for name, construct := range invalid {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected %s to panic", name)
+				}
+			}()
+
+			construct()
+		}()
+	}
+}
@@ -0,0 +1,103 @@
+package factory
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	emailDomainSalt        = 0x0E3A_11D0
+	defaultEmailLocalMin   = 5
+	defaultEmailLocalMax   = 12
+	emailUniqueSeparator   = "."
+	emailLocalFirstLetters = "abcdefghijklmnopqrstuvwxyz"
+)
+
+// exampleDomains are reserved by RFC 2606, so generated addresses and URLs never reach a real host.
+var exampleDomains = []string{"example.com", "example.net", "example.org"}
+
+var emailLocalCharacters = CharacterSet([]rune(emailLocalFirstLetters + "0123456789"))
+
+// EmailProperties carries the local part, domain and resulting address for EmailFactory.
+type EmailProperties struct {
+	local  string
+	domain string
+	value  string
+}
+
+// EmailFactory generates syntactically valid addresses such as "k3xq9ab@example.com". The local
+// part is MinLocal to MaxLocal lowercase letters and digits (5 to 12 when zero) and starts with a
+// letter; the domain is drawn from Domains (the RFC 2606 example domains when empty). With Unique,
+// the base-36 seed is appended to the local part, so addresses built with distinct seeds, such as
+// those from one builder, never collide.
+type EmailFactory struct {
+	MinLocal int
+	MaxLocal int
+	Domains  []string
+	Unique   bool
+}
+
+// Instantiate returns the prepared address.
+func (f *EmailFactory) Instantiate(properties EmailProperties) string {
+	return properties.value
+}
+
+// Prepare generates the local part and picks a domain; a non-empty local part, domain or address
+// from overrides is kept.
+func (f *EmailFactory) Prepare(overrides Partial[EmailProperties], seed int64) EmailProperties {
+	properties := EmailProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value != "" {
+		return properties
+	}
+
+	if properties.local == "" {
+		properties.local = f.Generate(seed)
+	}
+	if properties.domain == "" {
+		properties.domain = pickString(f.Domains, exampleDomains, seed^emailDomainSalt)
+	}
+	properties.value = properties.local + "@" + properties.domain
+
+	return properties
+}
+
+// Retrieve splits an address back into EmailProperties.
+func (f *EmailFactory) Retrieve(instance string) EmailProperties {
+	local, domain := instance, ""
+	if index := strings.LastIndex(instance, "@"); index >= 0 {
+		local, domain = instance[:index], instance[index+1:]
+	}
+
+	return EmailProperties{
+		local:  local,
+		domain: domain,
+		value:  instance,
+	}
+}
+
+// Generate returns the local part for seed without building properties.
+func (f *EmailFactory) Generate(seed int64) string {
+	minLength, maxLength := f.MinLocal, f.MaxLocal
+	if minLength <= 0 {
+		minLength = defaultEmailLocalMin
+	}
+	if maxLength <= 0 {
+		maxLength = defaultEmailLocalMax
+	}
+	if maxLength < minLength {
+		maxLength = minLength
+	}
+
+	first := emailLocalFirstLetters[IntAt(seed, 0, int64(len(emailLocalFirstLetters)-1))]
+	local := string(first) + generateString(seed, minLength-1, maxLength-1, emailLocalCharacters)
+	if f.Unique {
+		local += emailUniqueSeparator + strconv.FormatInt(seed, 36)
+	}
+
+	return local
+}
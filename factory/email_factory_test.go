@@ -0,0 +1,66 @@
+package factory
+
+import (
+	"net/mail"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestEmailFactoryProducesValidAddresses(t *testing.T) {
+	builder := Builder(&EmailFactory{})
+
+	for _, address := range builder.BuildList(200, nil) {
+		parsed, err := mail.ParseAddress(address)
+		if err != nil || parsed.Address != address {
+			t.Fatalf("expected a valid address, got %q: %v", address, err)
+		}
+
+		local, domain, _ := strings.Cut(address, "@")
+		if len(local) < defaultEmailLocalMin || len(local) > defaultEmailLocalMax {
+			t.Fatalf("local part %q outside default length", local)
+		}
+		if !slices.Contains(exampleDomains, domain) {
+			t.Fatalf("unexpected default domain %q", domain)
+		}
+	}
+}
+
+func TestEmailFactoryConfiguration(t *testing.T) {
+	factory := &EmailFactory{MinLocal: 3, MaxLocal: 3, Domains: []string{"corp.test"}}
+
+	for seed := range int64(50) {
+		address := Builder(factory).BuildWith(seed, nil)
+		if len(address) != len("abc@corp.test") || !strings.HasSuffix(address, "@corp.test") {
+			t.Fatalf("unexpected address %q", address)
+		}
+		if address != factory.Generate(seed)+"@corp.test" {
+			t.Fatalf("expected Build and Generate to agree for seed %d", seed)
+		}
+	}
+}
+
+func TestEmailFactoryUnique(t *testing.T) {
+	builder := Builder(&EmailFactory{MinLocal: 1, MaxLocal: 1, Domains: []string{"a.test"}, Unique: true})
+
+	seen := make(map[string]bool)
+	for _, address := range builder.BuildList(1000, nil) {
+		if seen[address] {
+			t.Fatalf("duplicate address %q", address)
+		}
+		seen[address] = true
+	}
+}
+
+func TestEmailFactoryOverrides(t *testing.T) {
+	builder := Builder(&EmailFactory{})
+
+	if address := builder.Build(Override[EmailProperties](map[string]any{"domain": "fixed.test"})); !strings.HasSuffix(address, "@fixed.test") {
+		t.Fatalf("expected overridden domain, got %q", address)
+	}
+
+	properties := (&EmailFactory{}).Retrieve("someone@example.com")
+	if properties.local != "someone" || properties.domain != "example.com" {
+		t.Fatalf("unexpected retrieved properties %+v", properties)
+	}
+}
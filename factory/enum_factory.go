@@ -1,6 +1,10 @@
 package factory
 
-import "github.com/lihs-ie/forge/internal/collections"
+import (
+	"fmt"
+
+	"github.com/lihs-ie/forge/internal/collections"
+)
 
 // EnumProperties captures the selected value and exclusions for EnumFactory.
 type EnumProperties[T comparable] struct {
@@ -11,6 +15,8 @@ type EnumProperties[T comparable] struct {
 // EnumFactory selects values from a predefined candidate set.
 type EnumFactory[T comparable] struct {
 	candidates *collections.Set[T]
+	weights    map[T]float64
+	quotas     quotas[T]
 }
 
 // NewEnumFactory constructs an EnumFactory with the provided candidates.
@@ -20,6 +26,40 @@ func NewEnumFactory[T comparable](candidates []T) *EnumFactory[T] {
 	}
 }
 
+// NewWeightedEnumFactory constructs an EnumFactory that picks each candidate in proportion to its
+// weight, e.g. {"active": 8, "pending": 1, "closed": 1} for a realistic status mix. A zero weight
+// keeps the candidate valid for overrides but never generates it, unless exclusions leave only
+// zero-weight candidates, which are then picked uniformly. It panics on a negative weight.
+func NewWeightedEnumFactory[T comparable](weights map[T]float64) *EnumFactory[T] {
+	candidates := make([]T, 0, len(weights))
+	copied := make(map[T]float64, len(weights))
+	for candidate, weight := range weights {
+		if weight < 0 {
+			panic(fmt.Sprintf("enum: negative weight %v for %v", weight, candidate))
+		}
+		candidates = append(candidates, candidate)
+		copied[candidate] = weight
+	}
+
+	return &EnumFactory[T]{
+		candidates: collections.NewFromSlice(candidates),
+		weights:    copied,
+	}
+}
+
+// WithQuota returns a copy of the factory that picks candidate for at most limit of every window
+// consecutive seeds (e.g. at most 2 admins per 10 users). The decision depends on the seed alone,
+// so builds stay deterministic: lists built with BuildListWith or under BulkAllocation, whose
+// seeds are consecutive, never exceed the limit, while lists of random seeds keep the ratio on
+// average only. Values set through overrides are not limited.
+func (f *EnumFactory[T]) WithQuota(candidate T, limit, window int) *EnumFactory[T] {
+	return &EnumFactory[T]{
+		candidates: f.candidates,
+		weights:    f.weights,
+		quotas:     f.quotas.with(candidate, limit, window),
+	}
+}
+
 // Instantiate returns the chosen enum value.
 func (f *EnumFactory[T]) Instantiate(properties EnumProperties[T]) T {
 	return properties.value
@@ -41,11 +81,10 @@ func (f *EnumFactory[T]) Prepare(overrides Partial[EnumProperties[T]], seed int6
 		panic("no candidates available after exclusions")
 	}
 
-	index := int(seed % int64(len(actuals)))
-
 	var zero T
 	if properties.value == zero {
-		properties.value = actuals[index]
+		allowed := f.quotas.filter(actuals, seed)
+		properties.value = allowed[f.pick(allowed, seed)]
 	}
 
 	return properties
@@ -59,6 +98,33 @@ func (f *EnumFactory[T]) Retrieve(instance T) EnumProperties[T] {
 	}
 }
 
+// pick chooses an index of candidates, uniformly by seed or in proportion to the weights.
+func (f *EnumFactory[T]) pick(candidates []T, seed int64) int {
+	total := 0.0
+	for _, candidate := range candidates {
+		total += f.weights[candidate]
+	}
+	if total == 0 {
+		return int(seed % int64(len(candidates)))
+	}
+
+	point := FloatAt(seed, 0, total)
+	last := 0
+	for index, candidate := range candidates {
+		weight := f.weights[candidate]
+		if weight == 0 {
+			continue
+		}
+		point -= weight
+		if point < 0 {
+			return index
+		}
+		last = index
+	}
+
+	return last
+}
+
 func (f *EnumFactory[T]) filterExclusions(exclusions []T) []T {
 	exclusionSet := collections.NewFromSlice(exclusions)
 	result := make([]T, 0)
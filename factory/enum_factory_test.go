@@ -251,3 +251,91 @@ func TestEnumFactoryWithInlineOverride(t *testing.T) {
 		t.Errorf("Expected StatusPending, got %v", status)
 	}
 }
+
+func TestEnumFactoryWithQuota(t *testing.T) {
+	base := NewEnumFactory([]Status{StatusActive, StatusClosed})
+	limited := base.WithQuota(StatusClosed, 2, 10)
+
+	for round := range int64(5) {
+		closed := 0
+		for _, status := range Builder(limited).BuildListWith(10, round*7, nil) {
+			if status == StatusClosed {
+				closed++
+			}
+		}
+		if closed > 2 {
+			t.Fatalf("round %d: expected at most 2 closed statuses per 10, got %d", round, closed)
+		}
+	}
+
+	if base.quotas != nil {
+		t.Fatal("expected WithQuota to leave the receiver untouched")
+	}
+}
+
+func TestEnumFactoryWithQuotaIsDeterministic(t *testing.T) {
+	limited := NewEnumFactory([]Status{StatusActive, StatusClosed}).WithQuota(StatusClosed, 1, 3)
+
+	first := limited.Prepare(nil, 42).value
+	for range 10 {
+		limited.Prepare(nil, 7)
+	}
+
+	if second := limited.Prepare(nil, 42).value; second != first {
+		t.Fatalf("expected the same status for the same seed, got %v and %v", first, second)
+	}
+}
+
+func TestWeightedEnumFactoryFollowsWeights(t *testing.T) {
+	factory := NewWeightedEnumFactory(map[Status]float64{
+		StatusActive:  8,
+		StatusPending: 2,
+		StatusClosed:  0,
+	})
+
+	counts := make(map[Status]int)
+	for _, status := range Builder(factory).BuildListWith(2000, 1, nil) {
+		counts[status]++
+	}
+
+	if counts[StatusClosed] != 0 {
+		t.Fatalf("expected zero-weight candidate never to be generated, got %d", counts[StatusClosed])
+	}
+	if counts[StatusActive] < 1400 || counts[StatusActive] > 1800 {
+		t.Fatalf("expected about 80%% active, got %d of 2000", counts[StatusActive])
+	}
+
+	closed := Builder(factory).Build(Override[EnumProperties[Status]](map[string]any{"value": StatusClosed}))
+	if closed != StatusClosed {
+		t.Fatalf("expected override to a zero-weight candidate, got %v", closed)
+	}
+}
+
+func TestWeightedEnumFactoryFallsBackToUniform(t *testing.T) {
+	factory := NewWeightedEnumFactory(map[Status]float64{
+		StatusActive:   1,
+		StatusClosed:   0,
+		StatusInactive: 0,
+	})
+
+	seen := make(map[Status]bool)
+	for _, status := range Builder(factory).BuildListWith(50, 1, Override[EnumProperties[Status]](map[string]any{
+		"Exclusions": []Status{StatusActive},
+	})) {
+		seen[status] = true
+	}
+
+	if !seen[StatusClosed] || !seen[StatusInactive] || seen[StatusActive] {
+		t.Fatalf("expected uniform choice among zero-weight candidates, got %v", seen)
+	}
+}
+
+func TestWeightedEnumFactoryRejectsNegativeWeights(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for a negative weight")
+		}
+	}()
+
+	NewWeightedEnumFactory(map[Status]float64{StatusActive: -1})
+}
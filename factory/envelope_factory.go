@@ -0,0 +1,187 @@
+package factory
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/lihs-ie/forge/internal/math"
+)
+
+const envelopeTraceSalt = 0x7EACE_1D5
+
+// SchemaValidator checks the JSON encoding of an event payload against a schema.
+type SchemaValidator func(payload []byte) error
+
+var (
+	schemasMu sync.RWMutex
+	schemas   = make(map[string]SchemaValidator)
+)
+
+// RegisterSchema registers validate as the schema of eventType, replacing any previous one, so
+// EnvelopeFactory can reject payloads that drift from the contract. It is the hook for plugging
+// in a schema registry client or a JSON Schema library.
+func RegisterSchema(eventType string, validate SchemaValidator) {
+	if eventType == "" || validate == nil {
+		panic("envelope: event type and validator are required")
+	}
+
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+
+	schemas[eventType] = validate
+}
+
+func lookupSchema(eventType string) (SchemaValidator, bool) {
+	schemasMu.RLock()
+	defer schemasMu.RUnlock()
+
+	validate, ok := schemas[eventType]
+	return validate, ok
+}
+
+// Envelope wraps an event payload in its metadata.
+type Envelope[T any] struct {
+	ID      string    `json:"id"`
+	Type    string    `json:"type"`
+	Source  string    `json:"source,omitempty"`
+	Time    time.Time `json:"time"`
+	TraceID string    `json:"traceId"`
+	SpanID  string    `json:"spanId"`
+	Payload T         `json:"payload"`
+}
+
+// EnvelopeProperties carries the metadata and payload for EnvelopeFactory.
+type EnvelopeProperties[T any] struct {
+	id        string
+	eventType string
+	source    string
+	time      time.Time
+	traceID   string
+	spanID    string
+	payload   T
+}
+
+// EnvelopeFactory wraps payloads built by another factory in event metadata: an ID, the event
+// type, a timestamp (within 30 days before the anchor with WithTimeAnchor) and W3C-sized trace
+// and span IDs. With Validate set, every payload is checked against the schema registered for its
+// type through RegisterSchema, and building panics when it does not conform or no schema exists.
+type EnvelopeFactory[T any, P any] struct {
+	payload  Factory[T, P]
+	Type     string
+	Source   string
+	Validate bool
+
+	anchor time.Time
+}
+
+// NewEnvelopeFactory creates an EnvelopeFactory for events of eventType carrying payloads built by
+// payload.
+func NewEnvelopeFactory[T any, P any](eventType string, payload Factory[T, P]) *EnvelopeFactory[T, P] {
+	return &EnvelopeFactory[T, P]{
+		payload: payload,
+		Type:    eventType,
+	}
+}
+
+// Anchored returns a copy of the factory whose event times fall within 30 days before anchor.
+func (f *EnvelopeFactory[T, P]) Anchored(anchor time.Time) Factory[Envelope[T], EnvelopeProperties[T]] {
+	anchored := *f
+	anchored.anchor = anchor
+	return &anchored
+}
+
+// Instantiate assembles the envelope.
+func (f *EnvelopeFactory[T, P]) Instantiate(properties EnvelopeProperties[T]) Envelope[T] {
+	return Envelope[T]{
+		ID:      properties.id,
+		Type:    properties.eventType,
+		Source:  properties.source,
+		Time:    properties.time,
+		TraceID: properties.traceID,
+		SpanID:  properties.spanID,
+		Payload: properties.payload,
+	}
+}
+
+// Prepare generates the metadata and payload missing from the overrides, then validates the
+// payload when requested.
+func (f *EnvelopeFactory[T, P]) Prepare(overrides Partial[EnvelopeProperties[T]], seed int64) EnvelopeProperties[T] {
+	properties := EnvelopeProperties[T]{
+		eventType: f.Type,
+		source:    f.Source,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.id == "" {
+		properties.id = seededHex(seed, 16)
+	}
+	if properties.traceID == "" {
+		properties.traceID = seededHex(seed^envelopeTraceSalt, 32)
+	}
+	if properties.spanID == "" {
+		properties.spanID = seededHex(seed+envelopeTraceSalt, 16)
+	}
+	if properties.time.IsZero() {
+		times := TimeFactory{Truncate: time.Millisecond, anchor: f.anchor}
+		properties.time = times.Prepare(nil, seed).value
+	}
+	if reflect.ValueOf(&properties.payload).Elem().IsZero() {
+		properties.payload = create(f.payload, Overrider[P]{}, seed)
+	}
+
+	if f.Validate {
+		validateEnvelopePayload(properties.eventType, properties.payload)
+	}
+
+	return properties
+}
+
+// Retrieve converts an envelope back into EnvelopeProperties.
+func (f *EnvelopeFactory[T, P]) Retrieve(instance Envelope[T]) EnvelopeProperties[T] {
+	return EnvelopeProperties[T]{
+		id:        instance.ID,
+		eventType: instance.Type,
+		source:    instance.Source,
+		time:      instance.Time,
+		traceID:   instance.TraceID,
+		spanID:    instance.SpanID,
+		payload:   instance.Payload,
+	}
+}
+
+func validateEnvelopePayload(eventType string, payload any) {
+	validate, ok := lookupSchema(eventType)
+	if !ok {
+		panic(fmt.Sprintf("envelope: no schema registered for %q", eventType))
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		panic(fmt.Sprintf("envelope: %v", err))
+	}
+	if err := validate(encoded); err != nil {
+		panic(fmt.Sprintf("envelope: %s payload does not match its schema: %v", eventType, err))
+	}
+}
+
+// seededHex returns length lowercase hex digits derived from seed.
+func seededHex(seed int64, length int) string {
+	const digits = "0123456789abcdef"
+
+	value := make([]byte, length)
+	//nolint:gosec // G115: Two's complement reinterpretation keeps the seed bits intact
+	state := uint64(seed)
+	for index := range value {
+		if index%16 == 0 {
+			state = math.Mix64(state + uint64(index))
+		}
+		value[index] = digits[state>>(4*(index%16))&0xF]
+	}
+	return string(value)
+}
@@ -0,0 +1,89 @@
+package factory
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnvelopeFactoryWrapsPayload(t *testing.T) {
+	builder := Builder(NewEnvelopeFactory("user.created", &stubFactory{}))
+
+	envelope := builder.BuildWith(12, nil)
+	if envelope.Type != "user.created" || envelope.Payload.Value != "seed-12" || envelope.Time.IsZero() {
+		t.Fatalf("unexpected envelope %+v", envelope)
+	}
+
+	hex := regexp.MustCompile(`^[0-9a-f]+$`)
+	if len(envelope.TraceID) != 32 || len(envelope.SpanID) != 16 || !hex.MatchString(envelope.TraceID+envelope.SpanID+envelope.ID) {
+		t.Fatalf("expected hex trace and span IDs, got %q %q", envelope.TraceID, envelope.SpanID)
+	}
+
+	if other := builder.BuildWith(12, nil); other != envelope {
+		t.Fatalf("expected identical envelopes for the same seed, got %+v and %+v", envelope, other)
+	}
+}
+
+func TestEnvelopeFactoryOverridesMetadata(t *testing.T) {
+	builder := Builder(NewEnvelopeFactory("user.created", &stubFactory{}))
+
+	envelope := builder.Build(Override[EnvelopeProperties[stubInstance]](map[string]any{
+		"traceID": "trace",
+		"payload": stubInstance{Value: "fixed"},
+	}))
+	if envelope.TraceID != "trace" || envelope.Payload.Value != "fixed" {
+		t.Fatalf("expected overridden metadata, got %+v", envelope)
+	}
+}
+
+func TestEnvelopeFactoryAnchored(t *testing.T) {
+	anchor := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	builder := Builder(NewEnvelopeFactory("tick", &stubFactory{}), WithTimeAnchor(anchor))
+
+	for _, envelope := range builder.BuildList(20, nil) {
+		if envelope.Time.Before(anchor.Add(-defaultAnchorWindow)) || envelope.Time.After(anchor) {
+			t.Fatalf("time %v outside anchored window", envelope.Time)
+		}
+	}
+}
+
+func TestEnvelopeFactoryValidatesSchema(t *testing.T) {
+	RegisterSchema("test.order.placed", func(payload []byte) error {
+		if !bytes.Contains(payload, []byte(`"Value":"seed-`)) {
+			return errors.New("value must be generated")
+		}
+		return nil
+	})
+
+	factory := NewEnvelopeFactory("test.order.placed", &stubFactory{})
+	factory.Validate = true
+	builder := Builder(factory)
+
+	builder.Build(nil)
+
+	defer func() {
+		if message, _ := recover().(string); !strings.Contains(message, "does not match its schema") {
+			t.Fatalf("expected schema violation panic, got %q", message)
+		}
+	}()
+
+	builder.Build(Override[EnvelopeProperties[stubInstance]](map[string]any{
+		"payload": stubInstance{Value: "manual"},
+	}))
+}
+
+func TestEnvelopeFactoryRequiresRegisteredSchema(t *testing.T) {
+	factory := NewEnvelopeFactory("test.unregistered", &stubFactory{})
+	factory.Validate = true
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for missing schema")
+		}
+	}()
+
+	Builder(factory).Build(nil)
+}
@@ -0,0 +1,126 @@
+package factory
+
+import (
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// PathStyle selects the separator and root conventions of FilePathFactory.
+type PathStyle int
+
+// Path styles supported by FilePathFactory.
+const (
+	// PathNative follows the operating system the code runs on.
+	PathNative PathStyle = iota
+	// PathPOSIX separates with '/' and roots absolute paths at '/'.
+	PathPOSIX
+	// PathWindows separates with '\' and roots absolute paths at a drive letter.
+	PathWindows
+)
+
+const (
+	defaultPathMinDepth = 1
+	defaultPathMaxDepth = 3
+	filePathDepthSalt   = 0x0F17_E9A7
+	filePathDriveSalt   = 0x0F17_E0D1
+	filePathNameSalt    = 0x0F17_E4A3
+	filePathExtSalt     = 0x0F17_E3E7
+)
+
+var defaultPathExtensions = []string{".txt", ".json", ".csv", ".log", ".png", ".jpg", ".pdf", ".go", ".yaml", ".md"}
+
+// FilePathProperties carries the directories, file name and rendered path for FilePathFactory.
+type FilePathProperties struct {
+	directories []string
+	name        string
+	value       string
+}
+
+// FilePathFactory generates file paths with MinDepth to MaxDepth directories (1 to 3 when zero)
+// and a file name with an extension from Extensions (common extensions when empty). Paths are
+// absolute unless Relative is set, and rendered in Style: absolute POSIX paths start with '/',
+// absolute Windows paths with a drive such as "C:\".
+type FilePathFactory struct {
+	Style      PathStyle
+	Relative   bool
+	MinDepth   int
+	MaxDepth   int
+	Extensions []string
+}
+
+// Instantiate returns the rendered path.
+func (f *FilePathFactory) Instantiate(properties FilePathProperties) string {
+	return properties.value
+}
+
+// Prepare generates the directories and file name missing from the overrides and renders the
+// path; an overridden path is kept as is.
+func (f *FilePathFactory) Prepare(overrides Partial[FilePathProperties], seed int64) FilePathProperties {
+	properties := FilePathProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value != "" {
+		return properties
+	}
+
+	if properties.directories == nil {
+		minimum, maximum := f.MinDepth, f.MaxDepth
+		if minimum <= 0 {
+			minimum = defaultPathMinDepth
+		}
+		if maximum <= 0 {
+			maximum = max(minimum, defaultPathMaxDepth)
+		}
+
+		properties.directories = make([]string, IntAt(seed^filePathDepthSalt, int64(minimum), int64(max(minimum, maximum))))
+		for index := range properties.directories {
+			properties.directories[index] = slugWords[IntAt(seed+int64(index), 0, int64(len(slugWords)-1))]
+		}
+	}
+	if properties.name == "" {
+		word := slugWords[IntAt(seed^filePathNameSalt, 0, int64(len(slugWords)-1))]
+		properties.name = word + "_" + seededDigits(3, seed+100) + pickString(f.Extensions, defaultPathExtensions, seed^filePathExtSalt)
+	}
+
+	properties.value = f.render(append(slices.Clone(properties.directories), properties.name), seed)
+
+	return properties
+}
+
+// Retrieve wraps an existing path into FilePathProperties.
+func (f *FilePathFactory) Retrieve(instance string) FilePathProperties {
+	return FilePathProperties{
+		value: instance,
+	}
+}
+
+func (f *FilePathFactory) style() PathStyle {
+	if f.Style != PathNative {
+		return f.Style
+	}
+	if runtime.GOOS == "windows" {
+		return PathWindows
+	}
+	return PathPOSIX
+}
+
+func (f *FilePathFactory) render(elements []string, seed int64) string {
+	if f.style() == PathWindows {
+		path := strings.Join(elements, `\`)
+		if f.Relative {
+			return path
+		}
+		//nolint:gosec // G115: The drive letter is within C-F
+		return string(rune('C'+IntAt(seed^filePathDriveSalt, 0, 3))) + `:\` + path
+	}
+
+	path := strings.Join(elements, "/")
+	if f.Relative {
+		return path
+	}
+	return "/" + path
+}
@@ -0,0 +1,61 @@
+package factory
+
+import (
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestFilePathFactoryStyles(t *testing.T) {
+	cases := []struct {
+		factory *FilePathFactory
+		pattern *regexp.Regexp
+	}{
+		{&FilePathFactory{Style: PathPOSIX}, regexp.MustCompile(`^(/[a-z]+){1,3}/[a-z]+_[0-9]{3}\.[a-z]+$`)},
+		{&FilePathFactory{Style: PathPOSIX, Relative: true}, regexp.MustCompile(`^([a-z]+/){1,3}[a-z]+_[0-9]{3}\.[a-z]+$`)},
+		{&FilePathFactory{Style: PathWindows}, regexp.MustCompile(`^[C-F]:(\\[a-z]+){1,3}\\[a-z]+_[0-9]{3}\.[a-z]+$`)},
+		{&FilePathFactory{Style: PathWindows, Relative: true}, regexp.MustCompile(`^([a-z]+\\){1,3}[a-z]+_[0-9]{3}\.[a-z]+$`)},
+	}
+
+	for _, c := range cases {
+		for _, path := range Builder(c.factory).BuildList(100, nil) {
+			if !c.pattern.MatchString(path) {
+				t.Fatalf("unexpected path %q for %+v", path, c.factory)
+			}
+		}
+	}
+}
+
+func TestFilePathFactoryNativeStyle(t *testing.T) {
+	path := Builder(&FilePathFactory{}).Build(nil)
+	if !filepath.IsAbs(path) {
+		t.Fatalf("expected an absolute %s path, got %q", runtime.GOOS, path)
+	}
+	if filepath.Clean(path) != path {
+		t.Fatalf("expected a clean path, got %q", path)
+	}
+}
+
+func TestFilePathFactoryDepthAndExtensions(t *testing.T) {
+	builder := Builder(&FilePathFactory{Style: PathPOSIX, Relative: true, MinDepth: 4, MaxDepth: 4, Extensions: []string{".tar.gz"}})
+
+	for _, path := range builder.BuildList(50, nil) {
+		if strings.Count(path, "/") != 4 || !strings.HasSuffix(path, ".tar.gz") {
+			t.Fatalf("unexpected path %q", path)
+		}
+	}
+}
+
+func TestFilePathFactoryOverrides(t *testing.T) {
+	builder := Builder(&FilePathFactory{Style: PathWindows})
+
+	path := builder.BuildWith(3, Override[FilePathProperties](map[string]any{
+		"directories": []string{"Users", "alice"},
+		"name":        "notes.txt",
+	}))
+	if !strings.HasSuffix(path, `:\Users\alice\notes.txt`) {
+		t.Fatalf("unexpected path %q", path)
+	}
+}
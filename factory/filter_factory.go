@@ -0,0 +1,58 @@
+package factory
+
+import "fmt"
+
+const (
+	defaultFilterAttempts = 100
+	filterSeedStride      = 7919
+)
+
+// FilterFactory rejects instances produced by an inner factory until a predicate passes.
+type FilterFactory[T any, P any] struct {
+	inner       Factory[T, P]
+	predicate   func(T) bool
+	maxAttempts int
+}
+
+var _ Factory[any, any] = (*FilterFactory[any, any])(nil)
+
+// Filter wraps inner so that only instances satisfying predicate are produced.
+// Each rejected attempt is rebuilt with a seed derived from the original one; when
+// maxAttempts is not positive a default limit is used.
+func Filter[T any, P any](inner Factory[T, P], predicate func(T) bool, maxAttempts int) *FilterFactory[T, P] {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultFilterAttempts
+	}
+
+	return &FilterFactory[T, P]{
+		inner:       inner,
+		predicate:   predicate,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Instantiate delegates to the inner factory.
+func (f *FilterFactory[T, P]) Instantiate(properties P) T {
+	return f.inner.Instantiate(properties)
+}
+
+// Prepare retries the inner factory with derived seeds until the predicate accepts the instance.
+func (f *FilterFactory[T, P]) Prepare(overrides Partial[P], seed int64) P {
+	for attempt := range f.maxAttempts {
+		properties := f.inner.Prepare(overrides, deriveFilterSeed(seed, attempt))
+		if f.predicate == nil || f.predicate(f.inner.Instantiate(properties)) {
+			return properties
+		}
+	}
+
+	panic(fmt.Sprintf("filter: no instance satisfied the predicate after %d attempts", f.maxAttempts))
+}
+
+// Retrieve delegates to the inner factory.
+func (f *FilterFactory[T, P]) Retrieve(instance T) P {
+	return f.inner.Retrieve(instance)
+}
+
+func deriveFilterSeed(seed int64, attempt int) int64 {
+	return seed + int64(attempt)*filterSeedStride
+}
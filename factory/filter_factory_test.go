@@ -0,0 +1,74 @@
+package factory
+
+import "testing"
+
+func TestFilterFactoryRejectsUntilPredicatePasses(t *testing.T) {
+	inner := &stubFactory{}
+	builder := Builder(Filter[stubInstance, stubProps](inner, func(instance stubInstance) bool {
+		return instance.Seed != 10
+	}, 5))
+
+	result := builder.BuildWith(10, nil)
+
+	if result.Seed == 10 {
+		t.Fatalf("expected rejected seed to be retried, got %d", result.Seed)
+	}
+	if len(inner.prepareSeeds) != 2 {
+		t.Fatalf("expected two prepare calls, got %d", len(inner.prepareSeeds))
+	}
+}
+
+func TestFilterFactoryKeepsSeedWhenAccepted(t *testing.T) {
+	builder := Builder(Filter[stubInstance, stubProps](&stubFactory{}, func(stubInstance) bool {
+		return true
+	}, 5))
+
+	result := builder.BuildWith(42, nil)
+
+	if result.Seed != 42 {
+		t.Fatalf("expected original seed 42, got %d", result.Seed)
+	}
+}
+
+func TestFilterFactoryIsDeterministic(t *testing.T) {
+	builder := Builder(Filter[string, StringProperties](&StringFactory{}, func(value string) bool {
+		return len(value) > 10
+	}, 0))
+
+	first := builder.BuildWith(7, nil)
+	second := builder.BuildWith(7, nil)
+
+	if first != second {
+		t.Fatalf("expected identical values for same seed, got %q and %q", first, second)
+	}
+	if len(first) <= 10 {
+		t.Fatalf("expected value longer than 10, got %q", first)
+	}
+}
+
+func TestFilterFactoryAppliesOverrides(t *testing.T) {
+	builder := Builder(Filter[stubInstance, stubProps](&stubFactory{}, func(instance stubInstance) bool {
+		return instance.Value == "fixed"
+	}, 3))
+
+	result := builder.Build(Override[stubProps](map[string]any{
+		"Value": "fixed",
+	}))
+
+	if result.Value != "fixed" {
+		t.Fatalf("expected overridden value, got %s", result.Value)
+	}
+}
+
+func TestFilterFactoryPanicsWhenAttemptsExhausted(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic when predicate never passes")
+		}
+	}()
+
+	builder := Builder(Filter[stubInstance, stubProps](&stubFactory{}, func(stubInstance) bool {
+		return false
+	}, 3))
+	builder.BuildWith(0, nil)
+}
@@ -0,0 +1,160 @@
+package factory
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"sync"
+
+	"github.com/lihs-ie/forge/internal/hamt"
+)
+
+// FixtureCache persists generated fixtures on disk, so test packages that rebuild the same large
+// dataset on every run decode it instead. Entries are keyed by the factory type, its exported
+// configuration, the size, the seed and the overrides, as well as GeneratorVersion and the forge
+// module version, so upgrading the library never serves stale fixtures. Configuration held in
+// unexported fields is not part of the key, so call Clear after changing such a factory.
+type FixtureCache struct {
+	dir string
+}
+
+// NewFixtureCache returns a cache storing entries in dir, or in a forge-fixtures directory under
+// os.TempDir when dir is empty. Every test package pointing at the same directory shares entries.
+func NewFixtureCache(dir string) *FixtureCache {
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "forge-fixtures")
+	}
+
+	return &FixtureCache{dir: dir}
+}
+
+// Dir returns the directory holding the cache entries.
+func (c *FixtureCache) Dir() string {
+	return c.dir
+}
+
+// Clear removes every cache entry.
+func (c *FixtureCache) Clear() error {
+	if err := os.RemoveAll(c.dir); err != nil {
+		return fmt.Errorf("fixture cache: %w", err)
+	}
+	return nil
+}
+
+// CachedList returns the same instances as Builder(factory).BuildListWith(size, seed, overrides),
+// where overrides is applied as an Override literal. Instances are encoded like MarshalProperties,
+// including unexported fields; a missing or unreadable entry is rebuilt and written back. Overrides
+// that cannot be encoded, such as funcs or Lazy values, bypass the cache. An error is returned when
+// the instances cannot be encoded or the entry cannot be written.
+func CachedList[T any, P any](cache *FixtureCache, factory Factory[T, P], size int, seed int64, overrides map[string]any) ([]T, error) {
+	var override any
+	if len(overrides) > 0 {
+		override = Override[P](overrides)
+	}
+
+	key, err := fixtureKey(factory, size, seed, overrides)
+	if err != nil {
+		return Builder(factory).BuildListWith(size, seed, override), nil
+	}
+	path := filepath.Join(cache.dir, fmt.Sprintf("%016x.json", key))
+
+	if data, err := os.ReadFile(path); err == nil {
+		if instances, err := UnmarshalProperties[[]T](data); err == nil && len(instances) == size {
+			return instances, nil
+		}
+	}
+
+	instances := Builder(factory).BuildListWith(size, seed, override)
+
+	data, err := MarshalProperties(instances)
+	if err != nil {
+		return nil, fmt.Errorf("fixture cache: %w", err)
+	}
+	if err := cache.write(path, data); err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+// write stores data at path through a temporary file, so concurrent test packages never read a
+// partially written entry.
+func (c *FixtureCache) write(path string, data []byte) error {
+	if err := os.MkdirAll(c.dir, 0o750); err != nil {
+		return fmt.Errorf("fixture cache: %w", err)
+	}
+
+	file, err := os.CreateTemp(c.dir, "entry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("fixture cache: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("fixture cache: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("fixture cache: %w", err)
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return fmt.Errorf("fixture cache: %w", err)
+	}
+
+	return nil
+}
+
+// fixtureKey identifies a cache entry. Overrides are keyed by their MarshalProperties encoding,
+// which includes unexported fields such as those of Each; an error is returned for overrides that
+// cannot be encoded, such as funcs or Lazy values.
+func fixtureKey[T any, P any](factory Factory[T, P], size int, seed int64, overrides map[string]any) (uint64, error) {
+	factoryType := reflect.TypeOf(factory)
+	if factoryType.Kind() == reflect.Pointer {
+		factoryType = factoryType.Elem()
+	}
+
+	encoded, err := MarshalProperties(overrides)
+	if err != nil {
+		return 0, err
+	}
+
+	return hamt.Hash([]any{
+		GeneratorVersion,
+		moduleVersion(),
+		snapshotTypeName(factoryType),
+		snapshotTypeName(reflect.TypeFor[T]()),
+		hamt.Hash(factory),
+		size,
+		seed,
+		string(encoded),
+	}), nil
+}
+
+// moduleVersion returns the version of the forge module linked into the binary, or "(devel)" when
+// it is built from a working tree or without build information.
+var moduleVersion = sync.OnceValue(func() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+
+	packagePath := reflect.TypeFor[FixtureCache]().PkgPath()
+	modules := append([]*debug.Module{&info.Main}, info.Deps...)
+	for _, module := range modules {
+		if module.Path == "" || !strings.HasPrefix(packagePath, module.Path+"/") {
+			continue
+		}
+		if module.Replace != nil {
+			module = module.Replace
+		}
+		if module.Version == "" {
+			return "(devel)"
+		}
+		return module.Version
+	}
+
+	return "(devel)"
+})
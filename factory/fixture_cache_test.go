@@ -0,0 +1,201 @@
+package factory
+
+import (
+	"os"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+type cachedUser struct {
+	name string
+	age  int
+}
+
+type cachedUserProperties struct {
+	name string
+	age  int
+}
+
+type cachedUserFactory struct {
+	MaxAge int
+
+	prepared *int
+}
+
+func (f *cachedUserFactory) Instantiate(properties cachedUserProperties) cachedUser {
+	return cachedUser(properties)
+}
+
+func (f *cachedUserFactory) Prepare(overrides Partial[cachedUserProperties], seed int64) cachedUserProperties {
+	*f.prepared++
+
+	properties := cachedUserProperties{
+		name: generateString(seed, 8, 8, Characters.Alpha),
+		age:  int(IntAt(seed, 0, int64(f.MaxAge))),
+	}
+	if overrides != nil {
+		overrides(&properties)
+	}
+	return properties
+}
+
+func (f *cachedUserFactory) Retrieve(instance cachedUser) cachedUserProperties {
+	return cachedUserProperties(instance)
+}
+
+func TestCachedListReusesEntries(t *testing.T) {
+	cache := NewFixtureCache(t.TempDir())
+	prepared := 0
+	factory := &cachedUserFactory{MaxAge: 90, prepared: &prepared}
+
+	first, err := CachedList(cache, factory, 20, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prepared != 20 {
+		t.Fatalf("expected 20 builds on a miss, got %d", prepared)
+	}
+
+	second, err := CachedList(cache, factory, 20, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prepared != 20 {
+		t.Fatalf("expected no builds on a hit, got %d", prepared-20)
+	}
+	if !slices.Equal(first, second) || !slices.Equal(first, Builder(factory).BuildListWith(20, 1, nil)) {
+		t.Fatal("expected cached instances to match a fresh build")
+	}
+}
+
+func TestCachedListKeysOnSeedConfigurationAndOverrides(t *testing.T) {
+	cache := NewFixtureCache(t.TempDir())
+	prepared := 0
+
+	base, _ := CachedList(cache, &cachedUserFactory{MaxAge: 90, prepared: &prepared}, 5, 1, nil)
+	otherSeed, _ := CachedList(cache, &cachedUserFactory{MaxAge: 90, prepared: &prepared}, 5, 2, nil)
+	otherConfig, _ := CachedList(cache, &cachedUserFactory{MaxAge: 10, prepared: &prepared}, 5, 1, nil)
+	overridden, _ := CachedList(cache, &cachedUserFactory{MaxAge: 90, prepared: &prepared}, 5, 1, map[string]any{"name": "fixed"})
+
+	if prepared != 20 {
+		t.Fatalf("expected every variation to miss, got %d builds", prepared)
+	}
+	if slices.Equal(base, otherSeed) || slices.Equal(base, otherConfig) {
+		t.Fatal("expected different seeds and configurations to produce different fixtures")
+	}
+	for _, user := range overridden {
+		if user.name != "fixed" {
+			t.Fatalf("expected override to apply, got %q", user.name)
+		}
+	}
+}
+
+func TestFixtureKeyIncludesModuleVersion(t *testing.T) {
+	factory := &cachedUserFactory{MaxAge: 90}
+	current, _ := fixtureKey(factory, 5, 1, nil)
+
+	original := moduleVersion
+	defer func() { moduleVersion = original }()
+	moduleVersion = func() string { return "v9.9.9" }
+
+	if next, _ := fixtureKey(factory, 5, 1, nil); next == current {
+		t.Fatal("expected a different key for another module version")
+	}
+}
+
+func TestCachedListKeysOnColumnOverrides(t *testing.T) {
+	cache := NewFixtureCache(t.TempDir())
+	factory := &columnTestOrderFactory{}
+
+	if _, err := CachedList(cache, factory, 2, 1, map[string]any{"items": Each(map[string]any{"Currency": "JPY"})}); err != nil {
+		t.Fatal(err)
+	}
+	orders, err := CachedList(cache, factory, 2, 1, map[string]any{"items": Each(map[string]any{"Currency": "EUR"})})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, order := range orders {
+		for _, item := range order.items {
+			if item.Currency != "EUR" {
+				t.Fatalf("expected the EUR override to get its own entry, got %+v", order.items)
+			}
+		}
+	}
+}
+
+type cachedLabelProperties struct {
+	format func(seed int64) string
+	seed   int64
+}
+
+type cachedLabelFactory struct {
+	prepared int
+}
+
+func (f *cachedLabelFactory) Instantiate(properties cachedLabelProperties) string {
+	return properties.format(properties.seed)
+}
+
+func (f *cachedLabelFactory) Prepare(overrides Partial[cachedLabelProperties], seed int64) cachedLabelProperties {
+	f.prepared++
+
+	properties := cachedLabelProperties{format: func(seed int64) string { return strconv.FormatInt(seed, 10) }, seed: seed}
+	if overrides != nil {
+		overrides(&properties)
+	}
+	return properties
+}
+
+func (f *cachedLabelFactory) Retrieve(string) cachedLabelProperties {
+	return cachedLabelProperties{}
+}
+
+func TestCachedListBypassesCacheForUnencodableOverrides(t *testing.T) {
+	cache := NewFixtureCache(t.TempDir())
+	factory := &cachedLabelFactory{}
+	overrides := map[string]any{"format": func(seed int64) string { return "label" }}
+
+	for range 2 {
+		labels, err := CachedList(cache, factory, 3, 1, overrides)
+		if err != nil || labels[0] != "label" {
+			t.Fatalf("expected overridden labels, got %v %v", labels, err)
+		}
+	}
+
+	if factory.prepared != 6 {
+		t.Fatalf("expected both calls to build, got %d builds", factory.prepared)
+	}
+	if entries, _ := os.ReadDir(cache.Dir()); len(entries) != 0 {
+		t.Fatalf("expected no cache entry, got %d", len(entries))
+	}
+}
+
+func TestCachedListRebuildsCorruptEntries(t *testing.T) {
+	cache := NewFixtureCache(t.TempDir())
+	prepared := 0
+	factory := &cachedUserFactory{MaxAge: 90, prepared: &prepared}
+
+	expected, _ := CachedList(cache, factory, 3, 1, nil)
+
+	entries, err := os.ReadDir(cache.Dir())
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("expected one entry, got %v %v", entries, err)
+	}
+	if err := os.WriteFile(cache.Dir()+"/"+entries[0].Name(), []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	rebuilt, err := CachedList(cache, factory, 3, 1, nil)
+	if err != nil || !slices.Equal(expected, rebuilt) || prepared != 6 {
+		t.Fatalf("expected a rebuild after corruption, got %v %v with %d builds", rebuilt, err, prepared)
+	}
+
+	if err := cache.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(cache.Dir()); !os.IsNotExist(err) {
+		t.Fatal("expected Clear to remove the cache directory")
+	}
+}
@@ -0,0 +1,137 @@
+package factory
+
+import stdmath "math"
+
+const (
+	defaultFloatMax        = 1
+	floatEdgeCases         = 8
+	finiteFloatEdgeCases   = 5
+	maxFloatPrecisionDigit = 15
+)
+
+// FloatProperties carries the generated value for FloatFactory.
+type FloatProperties struct {
+	value float64
+}
+
+// FloatFactory generates float64 values within [Min, Max]. When both bounds are zero the range
+// is [0, 1]. Precision rounds values to that many fraction digits (0 keeps full precision).
+// NaN and ±Inf are never generated unless AllowNonFinite is set, in which case adversarial mode
+// includes them among its edge cases.
+type FloatFactory struct {
+	Min            float64
+	Max            float64
+	Precision      int
+	AllowNonFinite bool
+
+	adversarial bool
+}
+
+// Adversarial returns a copy of the factory that favours zero, negative zero, the bounds, the
+// smallest positive float and, with AllowNonFinite, NaN and ±Inf.
+func (f *FloatFactory) Adversarial() Factory[float64, FloatProperties] {
+	adversarial := *f
+	adversarial.adversarial = true
+	return &adversarial
+}
+
+// Boundaries returns Min, the next representable value above Min, the previous one below Max and
+// Max. With Precision the neighbours are one step of the last fraction digit away instead.
+func (f *FloatFactory) Boundaries() []float64 {
+	minimum, maximum := f.bounds()
+
+	above := stdmath.Nextafter(minimum, maximum)
+	below := stdmath.Nextafter(maximum, minimum)
+	if f.Precision > 0 {
+		step := stdmath.Pow10(-f.precision())
+		above = f.round(minimum + step)
+		below = f.round(maximum - step)
+	}
+
+	values := make([]float64, 0, 4)
+	for _, point := range []float64{minimum, above, below, maximum} {
+		if point < minimum || point > maximum {
+			continue
+		}
+		if len(values) > 0 && values[len(values)-1] >= point {
+			continue
+		}
+		values = append(values, point)
+	}
+
+	return values
+}
+
+// Instantiate returns the prepared float64.
+func (f *FloatFactory) Instantiate(properties FloatProperties) float64 {
+	return properties.value
+}
+
+// Prepare generates the value before applying overrides, so overriding with 0 is honored.
+// Overridden values are used as is, without rounding or range checks.
+func (f *FloatFactory) Prepare(overrides Partial[FloatProperties], seed int64) FloatProperties {
+	properties := FloatProperties{
+		value: f.Generate(seed),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return properties
+}
+
+// Retrieve converts a float64 back into FloatProperties.
+func (f *FloatFactory) Retrieve(instance float64) FloatProperties {
+	return FloatProperties{
+		value: instance,
+	}
+}
+
+// Generate produces the same value as building with seed and no overrides, without allocating.
+func (f *FloatFactory) Generate(seed int64) float64 {
+	minimum, maximum := f.bounds()
+
+	if f.adversarial {
+		cases := finiteFloatEdgeCases
+		if f.AllowNonFinite {
+			cases = floatEdgeCases
+		}
+
+		if index, ok := adversarialCase(seed, cases); ok {
+			return [floatEdgeCases]float64{
+				0, stdmath.Copysign(0, -1), minimum, maximum, stdmath.SmallestNonzeroFloat64,
+				stdmath.NaN(), stdmath.Inf(1), stdmath.Inf(-1),
+			}[index]
+		}
+	}
+
+	value := f.round(FloatAt(seed, minimum, maximum))
+
+	return stdmath.Min(stdmath.Max(value, minimum), maximum)
+}
+
+func (f *FloatFactory) bounds() (minimum, maximum float64) {
+	minimum, maximum = f.Min, f.Max
+	if minimum == 0 && maximum == 0 {
+		maximum = defaultFloatMax
+	}
+	if maximum < minimum {
+		maximum = minimum
+	}
+	return minimum, maximum
+}
+
+func (f *FloatFactory) precision() int {
+	return min(f.Precision, maxFloatPrecisionDigit)
+}
+
+// round rounds value to the configured number of fraction digits.
+func (f *FloatFactory) round(value float64) float64 {
+	if f.Precision <= 0 {
+		return value
+	}
+
+	scale := stdmath.Pow10(f.precision())
+	return stdmath.Round(value*scale) / scale
+}
@@ -0,0 +1,98 @@
+package factory
+
+import (
+	"fmt"
+	stdmath "math"
+	"testing"
+)
+
+func TestFloatFactoryStaysWithinBounds(t *testing.T) {
+	builder := Builder(&FloatFactory{Min: -2.5, Max: 2.5})
+
+	for _, value := range builder.BuildListWith(500, 1, nil) {
+		if value < -2.5 || value > 2.5 {
+			t.Fatalf("value %v out of range", value)
+		}
+	}
+}
+
+func TestFloatFactoryDefaults(t *testing.T) {
+	for _, value := range Builder(&FloatFactory{}).BuildList(100, nil) {
+		if value < 0 || value > defaultFloatMax {
+			t.Fatalf("value %v outside default range", value)
+		}
+	}
+
+	if value := Builder(&FloatFactory{Min: 7, Max: 3}).Build(nil); value != 7 {
+		t.Fatalf("expected Max below Min to collapse to Min, got %v", value)
+	}
+}
+
+func TestFloatFactoryPrecision(t *testing.T) {
+	builder := Builder(&FloatFactory{Min: 0, Max: 100, Precision: 2})
+
+	for _, value := range builder.BuildListWith(200, 1, nil) {
+		if formatted := fmt.Sprint(value); len(formatted) > len("100.00") {
+			t.Fatalf("expected at most two fraction digits, got %s", formatted)
+		}
+	}
+}
+
+func TestFloatFactoryIsDeterministic(t *testing.T) {
+	factory := &FloatFactory{Min: -1000, Max: 1000, Precision: 3}
+
+	for seed := range int64(50) {
+		if Builder(factory).BuildWith(seed, nil) != factory.Generate(seed) {
+			t.Fatalf("expected Build and Generate to agree for seed %d", seed)
+		}
+	}
+}
+
+func TestFloatFactoryOverrideWithZero(t *testing.T) {
+	builder := Builder(&FloatFactory{Min: 10, Max: 20})
+
+	if value := builder.Build(Override[FloatProperties](map[string]any{"value": 0.0})); value != 0 {
+		t.Fatalf("expected override to zero, got %v", value)
+	}
+}
+
+func TestFloatFactoryBoundaries(t *testing.T) {
+	if got := fmt.Sprint(Boundary(&FloatFactory{Min: 1, Max: 2, Precision: 1})); got != "[1 1.1 1.9 2]" {
+		t.Fatalf("unexpected boundaries %s", got)
+	}
+
+	boundaries := Boundary(&FloatFactory{Min: 1, Max: 2})
+	if len(boundaries) != 4 || boundaries[1] != stdmath.Nextafter(1, 2) || boundaries[2] != stdmath.Nextafter(2, 1) {
+		t.Fatalf("expected adjacent representable values, got %v", boundaries)
+	}
+}
+
+func TestFloatFactoryAdversarialExcludesNonFinite(t *testing.T) {
+	builder := Builder(&FloatFactory{Min: 10, Max: 20}, AdversarialMode())
+
+	seenZero := false
+	for _, value := range builder.BuildListWith(500, 1, nil) {
+		if stdmath.IsNaN(value) || stdmath.IsInf(value, 0) {
+			t.Fatalf("expected finite values, got %v", value)
+		}
+		seenZero = seenZero || value == 0
+	}
+
+	if !seenZero {
+		t.Fatal("expected zero among adversarial values")
+	}
+}
+
+func TestFloatFactoryAdversarialAllowNonFinite(t *testing.T) {
+	builder := Builder(&FloatFactory{AllowNonFinite: true}, AdversarialMode())
+
+	seenNaN, seenInf := false, false
+	for _, value := range builder.BuildListWith(1000, 1, nil) {
+		seenNaN = seenNaN || stdmath.IsNaN(value)
+		seenInf = seenInf || stdmath.IsInf(value, 0)
+	}
+
+	if !seenNaN || !seenInf {
+		t.Fatalf("expected NaN and Inf edge cases, got NaN=%v Inf=%v", seenNaN, seenInf)
+	}
+}
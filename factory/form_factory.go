@@ -0,0 +1,335 @@
+package factory
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultFormFileMaxSize = 1024
+	formFileNameLength     = 8
+	defaultFormContentType = "application/octet-stream"
+)
+
+var byteSliceType = reflect.TypeFor[[]byte]()
+
+// FormProperties carries the encoded values for FormFactory.
+type FormProperties struct {
+	values url.Values
+}
+
+// FormFactory encodes instances of a source factory as url.Values, for handler tests that parse
+// query strings or application/x-www-form-urlencoded bodies. Every exported field of T becomes a
+// key named by its `form` tag or field name; `form:"-"` skips the field and nil pointers are
+// omitted. Slices produce one value per element, time.Time is formatted as RFC 3339 and []byte
+// fields are left to MultipartFactory.
+type FormFactory[T any, P any] struct {
+	source Factory[T, P]
+}
+
+// NewFormFactory creates a FormFactory that encodes instances built by source.
+// It panics when T is not a struct.
+func NewFormFactory[T any, P any](source Factory[T, P]) *FormFactory[T, P] {
+	requireFormStruct[T]()
+
+	return &FormFactory[T, P]{
+		source: source,
+	}
+}
+
+// Instantiate returns the prepared values.
+func (f *FormFactory[T, P]) Instantiate(properties FormProperties) url.Values {
+	return properties.values
+}
+
+// Prepare encodes a source instance built with the same seed, unless values are overridden.
+func (f *FormFactory[T, P]) Prepare(overrides Partial[FormProperties], seed int64) FormProperties {
+	properties := FormProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.values == nil {
+		properties.values, _ = encodeForm(create(f.source, Overrider[P]{}, seed))
+	}
+
+	return properties
+}
+
+// Retrieve wraps existing values into FormProperties.
+func (f *FormFactory[T, P]) Retrieve(instance url.Values) FormProperties {
+	return FormProperties{
+		values: instance,
+	}
+}
+
+// FormFile declares a generated file part of a multipart body.
+type FormFile struct {
+	Field string
+	// FileName is generated with a ".bin" extension when empty.
+	FileName string
+	// ContentType defaults to application/octet-stream.
+	ContentType string
+	// MinSize and MaxSize bound the generated content in bytes (1 KiB at most when both are zero).
+	MinSize int
+	MaxSize int
+}
+
+// MultipartBody is an encoded multipart/form-data request body.
+type MultipartBody struct {
+	Body        []byte
+	ContentType string
+}
+
+// Reader returns a reader over the body, for use with httptest.NewRequest.
+func (b MultipartBody) Reader() io.Reader {
+	return bytes.NewReader(b.Body)
+}
+
+// MultipartProperties carries the values, file parts and boundary for MultipartFactory.
+type MultipartProperties struct {
+	values   url.Values
+	files    []MultipartFile
+	boundary string
+}
+
+// MultipartFile is one file part of a MultipartBody.
+type MultipartFile struct {
+	Field       string
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// MultipartFactory encodes instances of a source factory as multipart/form-data bodies. Fields are
+// encoded as in FormFactory, except that []byte fields become file parts; Files adds further file
+// parts filled with seed-determined bytes.
+type MultipartFactory[T any, P any] struct {
+	source Factory[T, P]
+	Files  []FormFile
+}
+
+// NewMultipartFactory creates a MultipartFactory that encodes instances built by source.
+// It panics when T is not a struct.
+func NewMultipartFactory[T any, P any](source Factory[T, P]) *MultipartFactory[T, P] {
+	requireFormStruct[T]()
+
+	return &MultipartFactory[T, P]{
+		source: source,
+	}
+}
+
+// Instantiate writes the values and file parts, in that order, into a multipart body.
+func (f *MultipartFactory[T, P]) Instantiate(properties MultipartProperties) MultipartBody {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	if err := writer.SetBoundary(properties.boundary); err != nil {
+		panic(fmt.Sprintf("multipart: %v", err))
+	}
+
+	keys := make([]string, 0, len(properties.values))
+	for key := range properties.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range properties.values[key] {
+			_ = writer.WriteField(key, value)
+		}
+	}
+
+	for _, file := range properties.files {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{
+			"name":     file.Field,
+			"filename": file.FileName,
+		}))
+		header.Set("Content-Type", file.ContentType)
+
+		part, _ := writer.CreatePart(header)
+		_, _ = part.Write(file.Content)
+	}
+
+	_ = writer.Close()
+
+	return MultipartBody{
+		Body:        buffer.Bytes(),
+		ContentType: writer.FormDataContentType(),
+	}
+}
+
+// Prepare encodes a source instance built with the same seed and generates the configured file
+// parts, unless values or files are overridden.
+func (f *MultipartFactory[T, P]) Prepare(overrides Partial[MultipartProperties], seed int64) MultipartProperties {
+	properties := MultipartProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.values == nil || properties.files == nil {
+		values, files := encodeForm(create(f.source, Overrider[P]{}, seed))
+		if properties.values == nil {
+			properties.values = values
+		}
+		if properties.files == nil {
+			properties.files = append(files, f.generateFiles(seed)...)
+		}
+	}
+	if properties.boundary == "" {
+		//nolint:gosec // G115: Two's complement reinterpretation keeps the seed bits intact
+		properties.boundary = fmt.Sprintf("forge%016x", uint64(seed))
+	}
+
+	return properties
+}
+
+// Retrieve parses a multipart body back into MultipartProperties. It panics when the body is not
+// valid multipart/form-data.
+func (f *MultipartFactory[T, P]) Retrieve(instance MultipartBody) MultipartProperties {
+	_, parameters, err := mime.ParseMediaType(instance.ContentType)
+	if err != nil {
+		panic(fmt.Sprintf("multipart: %v", err))
+	}
+
+	properties := MultipartProperties{
+		values:   make(url.Values),
+		files:    []MultipartFile{},
+		boundary: parameters["boundary"],
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(instance.Body), properties.boundary)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			panic(fmt.Sprintf("multipart: %v", err))
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			panic(fmt.Sprintf("multipart: %v", err))
+		}
+
+		if part.FileName() == "" {
+			properties.values.Add(part.FormName(), string(content))
+			continue
+		}
+		properties.files = append(properties.files, MultipartFile{
+			Field:       part.FormName(),
+			FileName:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Content:     content,
+		})
+	}
+
+	return properties
+}
+
+func (f *MultipartFactory[T, P]) generateFiles(seed int64) []MultipartFile {
+	files := make([]MultipartFile, 0, len(f.Files))
+	for index, file := range f.Files {
+		fileSeed := seed + int64(index)
+
+		maxSize := file.MaxSize
+		if file.MinSize == 0 && maxSize == 0 {
+			maxSize = defaultFormFileMaxSize
+		}
+		size := int(IntAt(fileSeed, int64(file.MinSize), int64(max(maxSize, file.MinSize))))
+
+		name := file.FileName
+		if name == "" {
+			name = generateString(fileSeed, formFileNameLength, formFileNameLength, Characters.Alphanumeric) + ".bin"
+		}
+
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = defaultFormContentType
+		}
+
+		files = append(files, MultipartFile{
+			Field:       file.Field,
+			FileName:    name,
+			ContentType: contentType,
+			Content:     seededBytes(fileSeed, size),
+		})
+	}
+
+	return files
+}
+
+func requireFormStruct[T any]() {
+	if typ := reflect.TypeFor[T](); typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("form: %s must be a struct", typ))
+	}
+}
+
+// encodeForm encodes the exported fields of instance as form values, returning []byte fields
+// separately as file parts.
+func encodeForm(instance any) (url.Values, []MultipartFile) {
+	values := make(url.Values)
+	files := []MultipartFile{}
+
+	value := reflect.ValueOf(instance)
+	for index := range value.NumField() {
+		field := value.Type().Field(index)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := field.Name
+		if tag, ok := field.Tag.Lookup("form"); ok {
+			if tag == "-" {
+				continue
+			}
+			key = strings.Split(tag, ",")[0]
+		}
+
+		fieldValue := value.Field(index)
+		if fieldValue.Kind() == reflect.Pointer {
+			if fieldValue.IsNil() {
+				continue
+			}
+			fieldValue = fieldValue.Elem()
+		}
+
+		switch {
+		case fieldValue.Type() == byteSliceType:
+			files = append(files, MultipartFile{
+				Field:       key,
+				FileName:    key + ".bin",
+				ContentType: defaultFormContentType,
+				Content:     fieldValue.Bytes(),
+			})
+		case fieldValue.Kind() == reflect.Slice || fieldValue.Kind() == reflect.Array:
+			for element := range fieldValue.Len() {
+				values.Add(key, formatFormValue(fieldValue.Index(element)))
+			}
+		default:
+			values.Add(key, formatFormValue(fieldValue))
+		}
+	}
+
+	return values, files
+}
+
+func formatFormValue(value reflect.Value) string {
+	if moment, ok := value.Interface().(time.Time); ok {
+		return moment.Format(time.RFC3339)
+	}
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,134 @@
+package factory
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type formTestSignup struct {
+	Name     string    `form:"name"`
+	Tags     []string  `form:"tag"`
+	Age      *int      `form:"age"`
+	Joined   time.Time `form:"joined"`
+	Avatar   []byte    `form:"avatar"`
+	Password string    `form:"-"`
+	Plain    string
+}
+
+type formTestSignupProperties struct {
+	seed int64
+}
+
+type formTestSignupFactory struct{}
+
+func (f *formTestSignupFactory) Instantiate(properties formTestSignupProperties) formTestSignup {
+	return formTestSignup{
+		Name:     generateString(properties.seed, 5, 5, Characters.Alpha),
+		Tags:     []string{"a", "b"},
+		Joined:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Avatar:   []byte{1, 2, 3},
+		Password: "secret",
+		Plain:    "plain",
+	}
+}
+
+func (f *formTestSignupFactory) Prepare(overrides Partial[formTestSignupProperties], seed int64) formTestSignupProperties {
+	properties := formTestSignupProperties{seed: seed}
+	if overrides != nil {
+		overrides(&properties)
+	}
+	return properties
+}
+
+func (f *formTestSignupFactory) Retrieve(instance formTestSignup) formTestSignupProperties {
+	return formTestSignupProperties{}
+}
+
+func TestFormFactoryEncodesFields(t *testing.T) {
+	values := Builder(NewFormFactory(&formTestSignupFactory{})).BuildWith(3, nil)
+
+	if len(values.Get("name")) != 5 || values.Get("joined") != "2024-01-02T03:04:05Z" || values.Get("Plain") != "plain" {
+		t.Fatalf("unexpected values %v", values)
+	}
+	if tags := values["tag"]; len(tags) != 2 || tags[0] != "a" || tags[1] != "b" {
+		t.Fatalf("expected one value per tag, got %v", tags)
+	}
+	for _, skipped := range []string{"age", "avatar", "Password"} {
+		if values.Has(skipped) {
+			t.Fatalf("expected %s to be omitted, got %v", skipped, values)
+		}
+	}
+}
+
+func TestFormFactoryOverridesValues(t *testing.T) {
+	values := Builder(NewFormFactory(&formTestSignupFactory{})).Build(Override[FormProperties](map[string]any{
+		"values": url.Values{"q": {"forge"}},
+	}))
+
+	if values.Encode() != "q=forge" {
+		t.Fatalf("expected overridden values, got %v", values)
+	}
+}
+
+func TestMultipartFactoryParsesWithNetHTTP(t *testing.T) {
+	factory := NewMultipartFactory(&formTestSignupFactory{})
+	factory.Files = []FormFile{{Field: "document", ContentType: "application/pdf", MinSize: 10, MaxSize: 20}}
+
+	body := Builder(factory).BuildWith(4, nil)
+
+	request := httptest.NewRequest("POST", "/signup", body.Reader())
+	request.Header.Set("Content-Type", body.ContentType)
+	if err := request.ParseMultipartForm(1 << 20); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(request.FormValue("name")) != 5 {
+		t.Fatalf("expected name field, got %v", request.MultipartForm.Value)
+	}
+
+	avatar := request.MultipartForm.File["avatar"]
+	if len(avatar) != 1 || avatar[0].Size != 3 {
+		t.Fatalf("expected avatar file part, got %v", avatar)
+	}
+
+	document := request.MultipartForm.File["document"]
+	if len(document) != 1 || document[0].Size < 10 || document[0].Size > 20 ||
+		document[0].Header.Get("Content-Type") != "application/pdf" {
+		t.Fatalf("expected generated document part, got %v", document)
+	}
+}
+
+func TestMultipartFactoryIsDeterministic(t *testing.T) {
+	factory := NewMultipartFactory(&formTestSignupFactory{})
+	factory.Files = []FormFile{{Field: "upload"}}
+	builder := Builder(factory)
+
+	first, second := builder.BuildWith(8, nil), builder.BuildWith(8, nil)
+	if !bytes.Equal(first.Body, second.Body) || first.ContentType != second.ContentType {
+		t.Fatal("expected identical bodies for the same seed")
+	}
+}
+
+func TestMultipartFactoryRetrieve(t *testing.T) {
+	factory := NewMultipartFactory(&formTestSignupFactory{})
+	properties := factory.Prepare(nil, 6)
+
+	retrieved := factory.Retrieve(factory.Instantiate(properties))
+	if retrieved.boundary != properties.boundary || retrieved.values.Encode() != properties.values.Encode() ||
+		len(retrieved.files) != 1 || !bytes.Equal(retrieved.files[0].Content, []byte{1, 2, 3}) {
+		t.Fatalf("expected round-tripped properties, got %+v", retrieved)
+	}
+}
+
+func TestFormFactoryRequiresStruct(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for non-struct source")
+		}
+	}()
+
+	NewFormFactory[int, IntProperties](&IntFactory{})
+}
@@ -0,0 +1,197 @@
+package factory
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/lihs-ie/forge/internal/math"
+)
+
+const (
+	defaultFrameLengthWidth = 2
+	defaultFrameBlobLength  = 16
+	frameMutationSalt       = 0x0F2A_3E5D
+)
+
+// FrameField declares one field of a binary frame layout.
+type FrameField struct {
+	Name string
+	// Width is the field size in bytes: 1, 2, 4 or 8 for integers, or 0 for a byte string that
+	// is prefixed with its own length using the frame's LengthWidth.
+	Width int
+	// Fixed makes the field always hold Value, e.g. for magic numbers and versions.
+	Fixed bool
+	Value uint64
+	// MaxLength bounds generated byte strings (16 when zero).
+	MaxLength int
+}
+
+// FrameMutation describes how a generated frame is corrupted.
+type FrameMutation int
+
+// Supported frame mutations.
+const (
+	MutationNone FrameMutation = iota
+	// MutationTruncate drops the last byte of the frame.
+	MutationTruncate
+	// MutationLength makes the length prefix one larger than the body.
+	MutationLength
+	// MutationFlip inverts the bits of one body byte.
+	MutationFlip
+)
+
+// FrameProperties carries field values, the mutation and the encoded frame for FrameFactory.
+type FrameProperties struct {
+	values   map[string]uint64
+	blobs    map[string][]byte
+	mutation FrameMutation
+	frame    []byte
+}
+
+// FrameFactory produces length-prefixed binary frames from a declarative layout, for testing codecs
+// and network parsers. Each frame is a length prefix of LengthWidth bytes (2 when zero) holding the
+// body size, followed by the fields in order. MutationRate is the fraction of frames that are
+// corrupted with a seed-determined FrameMutation.
+type FrameFactory struct {
+	ByteOrder    binary.AppendByteOrder
+	LengthWidth  int
+	Fields       []FrameField
+	MutationRate float64
+}
+
+// Instantiate returns the encoded frame.
+func (f *FrameFactory) Instantiate(properties FrameProperties) []byte {
+	return properties.frame
+}
+
+// Prepare generates a value for every field missing from the overrides, encodes the frame and
+// applies the mutation. Values and byte strings are overridden per field name through "values"
+// and "blobs"; an overridden frame is kept as is.
+func (f *FrameFactory) Prepare(overrides Partial[FrameProperties], seed int64) FrameProperties {
+	properties := FrameProperties{
+		values: make(map[string]uint64),
+		blobs:  make(map[string][]byte),
+	}
+	if BoolAt(seed^frameMutationSalt, f.MutationRate) {
+		properties.mutation = FrameMutation(IntAt(seed, int64(MutationTruncate), int64(MutationFlip)))
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.frame != nil {
+		return properties
+	}
+
+	body := make([]byte, 0)
+	for index, field := range f.Fields {
+		fieldSeed := seed + int64(index)
+		if field.Width == 0 {
+			blob, ok := properties.blobs[field.Name]
+			if !ok {
+				blob = frameBlob(fieldSeed, field.MaxLength)
+			}
+			body = f.appendUint(body, f.lengthWidth(), uint64(len(blob)))
+			body = append(body, blob...)
+			continue
+		}
+
+		value, ok := properties.values[field.Name]
+		if !ok {
+			value = field.Value
+			if !field.Fixed {
+				//nolint:gosec // G115: Two's complement reinterpretation keeps the seed bits intact
+				value = math.Mix64(uint64(fieldSeed))
+			}
+		}
+		body = f.appendUint(body, field.Width, value)
+	}
+
+	declared := uint64(len(body))
+	if properties.mutation == MutationLength {
+		declared++
+	}
+
+	frame := f.appendUint(nil, f.lengthWidth(), declared)
+	properties.frame = mutateBody(append(frame, body...), f.lengthWidth(), properties.mutation, seed)
+
+	return properties
+}
+
+// Retrieve wraps an existing frame into FrameProperties.
+func (f *FrameFactory) Retrieve(instance []byte) FrameProperties {
+	return FrameProperties{
+		frame: instance,
+	}
+}
+
+func (f *FrameFactory) byteOrder() binary.AppendByteOrder {
+	if f.ByteOrder == nil {
+		return binary.BigEndian
+	}
+	return f.ByteOrder
+}
+
+func (f *FrameFactory) lengthWidth() int {
+	if f.LengthWidth <= 0 {
+		return defaultFrameLengthWidth
+	}
+	return f.LengthWidth
+}
+
+// appendUint appends the low width bytes of value in the factory's byte order.
+func (f *FrameFactory) appendUint(dst []byte, width int, value uint64) []byte {
+	order := f.byteOrder()
+
+	switch width {
+	case 1:
+		return append(dst, byte(value))
+	case 2:
+		//nolint:gosec // G115: Truncation to the field width is intended
+		return order.AppendUint16(dst, uint16(value))
+	case 4:
+		//nolint:gosec // G115: Truncation to the field width is intended
+		return order.AppendUint32(dst, uint32(value))
+	case 8:
+		return order.AppendUint64(dst, value)
+	default:
+		panic(fmt.Sprintf("frame: unsupported width %d", width))
+	}
+}
+
+func frameBlob(seed int64, maxLength int) []byte {
+	if maxLength <= 0 {
+		maxLength = defaultFrameBlobLength
+	}
+
+	return seededBytes(seed, int(IntAt(seed, 0, int64(maxLength))))
+}
+
+// seededBytes returns size bytes derived from seed.
+func seededBytes(seed int64, size int) []byte {
+	//nolint:gosec // G115: Two's complement reinterpretation keeps the seed bits intact
+	base := math.Mix64(uint64(seed))
+	data := make([]byte, size)
+	for index := range data {
+		//nolint:gosec // G115: Truncation keeps the low byte of the mixed value
+		data[index] = byte(math.Mix64(base + uint64(index)))
+	}
+	return data
+}
+
+// mutateBody applies the mutations that corrupt the frame after encoding.
+func mutateBody(frame []byte, lengthWidth int, mutation FrameMutation, seed int64) []byte {
+	bodyLength := len(frame) - lengthWidth
+
+	switch mutation {
+	case MutationTruncate:
+		frame = frame[:len(frame)-1]
+	case MutationFlip:
+		if bodyLength > 0 {
+			frame[lengthWidth+int(IntAt(seed, 0, int64(bodyLength-1)))] ^= 0xFF
+		}
+	}
+
+	return frame
+}
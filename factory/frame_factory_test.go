@@ -0,0 +1,110 @@
+package factory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+var testFrameFields = []FrameField{
+	{Name: "magic", Width: 2, Fixed: true, Value: 0xCAFE},
+	{Name: "kind", Width: 1},
+	{Name: "sequence", Width: 4},
+	{Name: "payload", MaxLength: 8},
+}
+
+func decodeTestFrame(t *testing.T, frame []byte, order binary.ByteOrder) (magic uint16, kind byte, sequence uint32, payload []byte) {
+	t.Helper()
+
+	if len(frame) < 2 || int(order.Uint16(frame)) != len(frame)-2 {
+		t.Fatalf("invalid length prefix in %x", frame)
+	}
+	body := frame[2:]
+	magic, kind, sequence = order.Uint16(body), body[2], order.Uint32(body[3:])
+	length := order.Uint16(body[7:])
+	payload = body[9:]
+	if int(length) != len(payload) {
+		t.Fatalf("invalid payload length in %x", frame)
+	}
+	return magic, kind, sequence, payload
+}
+
+func TestFrameFactoryProducesValidFrames(t *testing.T) {
+	builder := Builder(&FrameFactory{ByteOrder: binary.LittleEndian, Fields: testFrameFields})
+
+	for _, frame := range builder.BuildList(50, nil) {
+		magic, _, _, payload := decodeTestFrame(t, frame, binary.LittleEndian)
+		if magic != 0xCAFE {
+			t.Fatalf("expected fixed magic, got %x", magic)
+		}
+		if len(payload) > 8 {
+			t.Fatalf("payload longer than MaxLength: %d", len(payload))
+		}
+	}
+}
+
+func TestFrameFactoryOverridesFieldValues(t *testing.T) {
+	builder := Builder(&FrameFactory{Fields: testFrameFields})
+
+	frame := builder.Build(Override[FrameProperties](map[string]any{
+		"values": map[string]uint64{"kind": 7, "sequence": 42},
+		"blobs":  map[string][]byte{"payload": []byte("hi")},
+	}))
+
+	_, kind, sequence, payload := decodeTestFrame(t, frame, binary.BigEndian)
+	if kind != 7 || sequence != 42 || !bytes.Equal(payload, []byte("hi")) {
+		t.Fatalf("expected overridden fields, got kind %d sequence %d payload %q", kind, sequence, payload)
+	}
+}
+
+func TestFrameFactoryMutations(t *testing.T) {
+	builder := Builder(&FrameFactory{Fields: testFrameFields})
+	valid := builder.BuildWith(3, nil)
+
+	mutated := func(mutation FrameMutation) []byte {
+		return builder.BuildWith(3, Override[FrameProperties](map[string]any{"mutation": mutation}))
+	}
+
+	if truncated := mutated(MutationTruncate); !bytes.Equal(truncated, valid[:len(valid)-1]) {
+		t.Fatalf("expected truncated frame, got %x", truncated)
+	}
+	if lengthened := mutated(MutationLength); int(binary.BigEndian.Uint16(lengthened)) != len(valid)-1 {
+		t.Fatalf("expected length prefix one larger than the body, got %x", lengthened)
+	}
+
+	flipped := mutated(MutationFlip)
+	differences := 0
+	for index := range valid {
+		if valid[index] != flipped[index] {
+			differences++
+		}
+	}
+	if differences != 1 || !bytes.Equal(flipped[:2], valid[:2]) {
+		t.Fatalf("expected exactly one flipped body byte, got %x vs %x", flipped, valid)
+	}
+}
+
+func TestFrameFactoryMutationRate(t *testing.T) {
+	frameFactory := &FrameFactory{Fields: testFrameFields, MutationRate: 1}
+
+	for seed := range int64(20) {
+		if properties := frameFactory.Prepare(nil, seed); properties.mutation == MutationNone {
+			t.Fatalf("expected every frame to be mutated, seed %d was not", seed)
+		}
+	}
+
+	frameFactory.MutationRate = 0
+	for seed := range int64(20) {
+		if properties := frameFactory.Prepare(nil, seed); properties.mutation != MutationNone {
+			t.Fatalf("expected no mutation, seed %d got %d", seed, properties.mutation)
+		}
+	}
+}
+
+func TestFrameFactoryIsDeterministic(t *testing.T) {
+	builder := Builder(&FrameFactory{Fields: testFrameFields, MutationRate: 0.5})
+
+	if first, second := builder.BuildWith(11, nil), builder.BuildWith(11, nil); !bytes.Equal(first, second) {
+		t.Fatalf("expected identical frames for the same seed, got %x and %x", first, second)
+	}
+}
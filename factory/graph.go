@@ -0,0 +1,148 @@
+package factory
+
+import (
+	"fmt"
+
+	"github.com/lihs-ie/forge/internal/math"
+)
+
+const graphLayerStride = 1 << 32
+
+type seededBuilder[T any] interface {
+	BuildWith(seed int64, overrides any) T
+}
+
+// GraphCount describes how many children are generated per parent.
+type GraphCount struct {
+	Min int
+	Max int
+}
+
+// Exactly generates n children per parent.
+func Exactly(n int) GraphCount {
+	return GraphCount{Min: n, Max: n}
+}
+
+// Between generates between low and high children (inclusive) per parent, chosen from the seed.
+func Between(low, high int) GraphCount {
+	return GraphCount{Min: low, Max: high}
+}
+
+func (c GraphCount) resolve(seed int64) int {
+	if c.Max <= c.Min {
+		return c.Min
+	}
+
+	//nolint:gosec // G115: Controlled conversion for hash scrambling within expected range
+	scrambled := math.Scramble(uint32(seed))
+	return c.Min + int(scrambled%uint32(c.Max-c.Min+1))
+}
+
+// Graph describes layers of related fixtures and builds all of them in one call.
+type Graph struct {
+	layers []func(seed int64)
+}
+
+// NewGraph creates an empty Graph.
+func NewGraph() *Graph {
+	return &Graph{}
+}
+
+// Build generates every layer in declaration order, replacing the contents of previous builds.
+func (g *Graph) Build(seed int64) {
+	for _, build := range g.layers {
+		build(seed)
+	}
+}
+
+// Layer is a typed handle to one level of a Graph.
+type Layer[T any] struct {
+	graph    *Graph
+	items    []T
+	parents  []int
+	children [][]int
+}
+
+// Items returns every instance of the layer in generation order.
+func (l *Layer[T]) Items() []T {
+	return l.items
+}
+
+// Len returns the number of instances in the layer.
+func (l *Layer[T]) Len() int {
+	return len(l.items)
+}
+
+// At returns the instance at index.
+func (l *Layer[T]) At(index int) T {
+	return l.items[index]
+}
+
+// ParentOf returns the index of the parent instance in the parent layer, or -1 for root layers.
+func (l *Layer[T]) ParentOf(index int) int {
+	if l.parents == nil {
+		return -1
+	}
+	return l.parents[index]
+}
+
+// ChildrenOf returns the instances generated for the parent at parentIndex.
+func (l *Layer[T]) ChildrenOf(parentIndex int) []T {
+	if parentIndex < 0 || parentIndex >= len(l.children) {
+		panic(fmt.Sprintf("graph: parent index %d out of range", parentIndex))
+	}
+
+	result := make([]T, 0, len(l.children[parentIndex]))
+	for _, index := range l.children[parentIndex] {
+		result = append(result, l.items[index])
+	}
+
+	return result
+}
+
+// Root declares the top layer of graph with count instances built from builder.
+func Root[T any](graph *Graph, builder seededBuilder[T], count int, overrides any) *Layer[T] {
+	layer := &Layer[T]{graph: graph}
+	offset := int64(len(graph.layers)) * graphLayerStride
+
+	graph.layers = append(graph.layers, func(seed int64) {
+		layer.items = make([]T, 0, count)
+		for index := range count {
+			layer.items = append(layer.items, builder.BuildWith(seed+offset+int64(index), overrides))
+		}
+	})
+
+	return layer
+}
+
+// Child declares a layer whose instances belong to the instances of parent.
+// link receives each parent and returns the overrides (via Override()) that tie children to it,
+// typically copying the parent's ID into a foreign key.
+func Child[T any, U any](parent *Layer[T], builder seededBuilder[U], count GraphCount, link func(parent T) any) *Layer[U] {
+	graph := parent.graph
+	layer := &Layer[U]{graph: graph}
+	offset := int64(len(graph.layers)) * graphLayerStride
+
+	graph.layers = append(graph.layers, func(seed int64) {
+		layer.items = nil
+		layer.parents = []int{}
+		layer.children = make([][]int, len(parent.items))
+
+		for parentIndex, parentItem := range parent.items {
+			var overrides any
+			if link != nil {
+				overrides = link(parentItem)
+			}
+
+			size := count.resolve(seed + offset + int64(parentIndex))
+			for range size {
+				index := len(layer.items)
+				layer.items = append(layer.items, builder.BuildWith(seed+offset+int64(index), overrides))
+				layer.parents = append(layer.parents, parentIndex)
+				layer.children[parentIndex] = append(layer.children[parentIndex], index)
+			}
+		}
+	})
+
+	return layer
+}
@@ -0,0 +1,136 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+)
+
+type graphTenant struct {
+	ID string
+}
+
+type graphUser struct {
+	ID       string
+	TenantID string
+}
+
+type graphOrder struct {
+	ID     string
+	UserID string
+}
+
+type graphEntityProps struct {
+	ID       string
+	ParentID string
+}
+
+type graphEntityFactory[T any] struct {
+	prefix string
+	build  func(props graphEntityProps) T
+	unwrap func(instance T) graphEntityProps
+}
+
+func (f *graphEntityFactory[T]) Instantiate(props graphEntityProps) T {
+	return f.build(props)
+}
+
+func (f *graphEntityFactory[T]) Prepare(overrides Partial[graphEntityProps], seed int64) graphEntityProps {
+	props := graphEntityProps{ID: fmt.Sprintf("%s-%d", f.prefix, seed)}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *graphEntityFactory[T]) Retrieve(instance T) graphEntityProps {
+	return f.unwrap(instance)
+}
+
+func graphBuilders() (BuilderHandle[graphTenant, graphEntityProps], BuilderHandle[graphUser, graphEntityProps], BuilderHandle[graphOrder, graphEntityProps]) {
+	tenants := Builder(&graphEntityFactory[graphTenant]{
+		prefix: "tenant",
+		build:  func(p graphEntityProps) graphTenant { return graphTenant{ID: p.ID} },
+		unwrap: func(i graphTenant) graphEntityProps { return graphEntityProps{ID: i.ID} },
+	})
+	users := Builder(&graphEntityFactory[graphUser]{
+		prefix: "user",
+		build:  func(p graphEntityProps) graphUser { return graphUser{ID: p.ID, TenantID: p.ParentID} },
+		unwrap: func(i graphUser) graphEntityProps { return graphEntityProps{ID: i.ID, ParentID: i.TenantID} },
+	})
+	orders := Builder(&graphEntityFactory[graphOrder]{
+		prefix: "order",
+		build:  func(p graphEntityProps) graphOrder { return graphOrder{ID: p.ID, UserID: p.ParentID} },
+		unwrap: func(i graphOrder) graphEntityProps { return graphEntityProps{ID: i.ID, ParentID: i.UserID} },
+	})
+	return tenants, users, orders
+}
+
+func TestGraphBuildsConsistentRelationships(t *testing.T) {
+	tenantBuilder, userBuilder, orderBuilder := graphBuilders()
+
+	graph := NewGraph()
+	tenants := Root(graph, tenantBuilder, 1, nil)
+	users := Child(tenants, userBuilder, Exactly(5), func(tenant graphTenant) any {
+		return Override[graphEntityProps](map[string]any{"ParentID": tenant.ID})
+	})
+	orders := Child(users, orderBuilder, Between(1, 4), func(user graphUser) any {
+		return Override[graphEntityProps](map[string]any{"ParentID": user.ID})
+	})
+
+	graph.Build(100)
+
+	if tenants.Len() != 1 || users.Len() != 5 {
+		t.Fatalf("expected 1 tenant and 5 users, got %d and %d", tenants.Len(), users.Len())
+	}
+
+	for index, user := range users.Items() {
+		if user.TenantID != tenants.At(0).ID {
+			t.Errorf("user %d has tenant %s", index, user.TenantID)
+		}
+		children := orders.ChildrenOf(index)
+		if len(children) < 1 || len(children) > 4 {
+			t.Errorf("user %d has %d orders", index, len(children))
+		}
+		for _, order := range children {
+			if order.UserID != user.ID {
+				t.Errorf("order %s references %s, expected %s", order.ID, order.UserID, user.ID)
+			}
+		}
+	}
+
+	for index := range orders.Len() {
+		if orders.At(index).UserID != users.At(orders.ParentOf(index)).ID {
+			t.Errorf("order %d parent mismatch", index)
+		}
+	}
+
+	if tenants.ParentOf(0) != -1 {
+		t.Error("expected root layer to have no parent")
+	}
+}
+
+func TestGraphBuildIsDeterministicAndUnique(t *testing.T) {
+	tenantBuilder, userBuilder, _ := graphBuilders()
+
+	graph := NewGraph()
+	tenants := Root(graph, tenantBuilder, 2, nil)
+	users := Child(tenants, userBuilder, Exactly(3), nil)
+
+	graph.Build(1)
+	first := append([]graphUser(nil), users.Items()...)
+	graph.Build(1)
+
+	seen := map[string]bool{}
+	for index, user := range users.Items() {
+		if user != first[index] {
+			t.Fatalf("expected deterministic rebuild at %d", index)
+		}
+		if seen[user.ID] {
+			t.Fatalf("duplicate id %s", user.ID)
+		}
+		seen[user.ID] = true
+	}
+	if tenants.At(0).ID == users.At(0).ID {
+		t.Error("expected layers to use distinct seeds")
+	}
+}
@@ -0,0 +1,264 @@
+package factory
+
+import (
+	"fmt"
+	stdmath "math"
+	"time"
+)
+
+const (
+	defaultHotspotConcentration = 0.8
+	defaultHotspotPeakShare     = 0.8
+	defaultHotspotPeakSpread    = 30 * time.Minute
+	defaultHotspotWindow        = 30 * Day
+	kilometresPerDegree         = 111.32
+	hotspotPlaceSalt            = 0x0B07_5A01
+	hotspotPointSalt            = 0x0B07_5A02
+	hotspotTimeSalt             = 0x0B07_5A03
+)
+
+// Hotspot is a weighted circular area where events cluster.
+type Hotspot struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	// RadiusKm bounds the distance of events from the centre (1 when zero).
+	RadiusKm float64
+	// Weight is the share of hotspot events placed here relative to the other hotspots (1 when zero).
+	Weight float64
+}
+
+// PeakHour is a weighted hour of the day around which event times burst.
+type PeakHour struct {
+	Hour   int
+	Weight float64
+}
+
+// GeoBounds is a latitude/longitude rectangle.
+type GeoBounds struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// HotspotSpec describes the clustering of a HotspotFactory dataset.
+type HotspotSpec struct {
+	Hotspots []Hotspot
+	// Concentration is the fraction of events inside a hotspot (0.8 when zero); the rest are
+	// spread uniformly over Bounds, the whole globe when zero.
+	Concentration float64
+	Bounds        GeoBounds
+	// From and To bound event times (the 30 days from 2025-01-01 UTC when unset).
+	From time.Time
+	To   time.Time
+	// PeakShare is the fraction of events that burst around Peaks (0.8 when zero), each within
+	// PeakSpread (30 minutes when zero) of a peak hour on a uniformly chosen day in Location (UTC
+	// when nil). The rest are spread uniformly over the window.
+	Peaks      []PeakHour
+	PeakShare  float64
+	PeakSpread time.Duration
+	Location   *time.Location
+}
+
+// GeoEvent is a located, timestamped event; Hotspot is empty for background events.
+type GeoEvent struct {
+	Hotspot   string
+	Latitude  float64
+	Longitude float64
+	At        time.Time
+}
+
+// HotspotProperties carries the chosen hotspot, location and time for HotspotFactory.
+type HotspotProperties struct {
+	hotspot   string
+	latitude  float64
+	longitude float64
+	at        time.Time
+}
+
+// HotspotFactory generates geo/temporal events clustered by a HotspotSpec, e.g. 80% of events in
+// three cities and bursts around 9:00 and 18:00, for testing analytics and anomaly detection
+// against realistic non-uniform data. Build a dataset with BuildList.
+type HotspotFactory struct {
+	spec HotspotSpec
+}
+
+// NewHotspotFactory creates a HotspotFactory for spec. It panics on a negative weight or radius,
+// a peak hour outside 0-23, a share outside [0, 1] or duplicate hotspot names.
+func NewHotspotFactory(spec HotspotSpec) *HotspotFactory {
+	names := make(map[string]bool, len(spec.Hotspots))
+	for _, hotspot := range spec.Hotspots {
+		if hotspot.Weight < 0 || hotspot.RadiusKm < 0 {
+			panic(fmt.Sprintf("hotspot: negative weight or radius for %q", hotspot.Name))
+		}
+		if names[hotspot.Name] {
+			panic(fmt.Sprintf("hotspot: duplicate hotspot %q", hotspot.Name))
+		}
+		names[hotspot.Name] = true
+	}
+	for _, peak := range spec.Peaks {
+		if peak.Hour < 0 || peak.Hour > 23 || peak.Weight < 0 {
+			panic(fmt.Sprintf("hotspot: invalid peak hour %d with weight %v", peak.Hour, peak.Weight))
+		}
+	}
+	if spec.Concentration < 0 || spec.Concentration > 1 || spec.PeakShare < 0 || spec.PeakShare > 1 {
+		panic("hotspot: Concentration and PeakShare must be within [0, 1]")
+	}
+
+	if spec.Concentration == 0 {
+		spec.Concentration = defaultHotspotConcentration
+	}
+	if spec.PeakShare == 0 {
+		spec.PeakShare = defaultHotspotPeakShare
+	}
+	if spec.PeakSpread <= 0 {
+		spec.PeakSpread = defaultHotspotPeakSpread
+	}
+	if spec.Bounds == (GeoBounds{}) {
+		spec.Bounds = GeoBounds{MinLatitude: -90, MaxLatitude: 90, MinLongitude: -180, MaxLongitude: 180}
+	}
+	if spec.From.IsZero() {
+		spec.From = defaultTimeSeriesStart
+	}
+	if spec.To.IsZero() {
+		spec.To = spec.From.Add(defaultHotspotWindow)
+	}
+	if spec.To.Before(spec.From) {
+		spec.To = spec.From
+	}
+	if spec.Location == nil {
+		spec.Location = time.UTC
+	}
+
+	return &HotspotFactory{spec: spec}
+}
+
+// Instantiate returns the prepared event.
+func (f *HotspotFactory) Instantiate(properties HotspotProperties) GeoEvent {
+	return GeoEvent{
+		Hotspot:   properties.hotspot,
+		Latitude:  properties.latitude,
+		Longitude: properties.longitude,
+		At:        properties.at,
+	}
+}
+
+// Prepare chooses a hotspot, unless one is named through overrides, then places the event and
+// picks its time. An overridden location or time is kept.
+func (f *HotspotFactory) Prepare(overrides Partial[HotspotProperties], seed int64) HotspotProperties {
+	properties := HotspotProperties{}
+	if len(f.spec.Hotspots) > 0 && BoolAt(seed^hotspotPlaceSalt, f.spec.Concentration) {
+		properties.hotspot = f.spec.Hotspots[f.pickHotspot(seed)].Name
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.latitude == 0 && properties.longitude == 0 {
+		properties.latitude, properties.longitude = f.place(properties.hotspot, seed^hotspotPointSalt)
+	}
+	if properties.at.IsZero() {
+		properties.at = f.time(seed ^ hotspotTimeSalt)
+	}
+
+	return properties
+}
+
+// Retrieve converts an event back into HotspotProperties.
+func (f *HotspotFactory) Retrieve(instance GeoEvent) HotspotProperties {
+	return HotspotProperties{
+		hotspot:   instance.Hotspot,
+		latitude:  instance.Latitude,
+		longitude: instance.Longitude,
+		at:        instance.At,
+	}
+}
+
+func (f *HotspotFactory) pickHotspot(seed int64) int {
+	weights := make([]float64, len(f.spec.Hotspots))
+	for index, hotspot := range f.spec.Hotspots {
+		weights[index] = hotspot.Weight
+		if weights[index] == 0 {
+			weights[index] = 1
+		}
+	}
+	return pickWeighted(weights, seed)
+}
+
+// place returns a point uniformly distributed within the named hotspot, or within the bounds
+// when no hotspot matches.
+func (f *HotspotFactory) place(name string, seed int64) (float64, float64) {
+	for _, hotspot := range f.spec.Hotspots {
+		if hotspot.Name != name || name == "" {
+			continue
+		}
+
+		radius := hotspot.RadiusKm
+		if radius == 0 {
+			radius = 1
+		}
+		distance := radius * stdmath.Sqrt(FloatAt(seed, 0, 1))
+		angle := FloatAt(seed+1, 0, 2*stdmath.Pi)
+
+		latitude := hotspot.Latitude + distance*stdmath.Cos(angle)/kilometresPerDegree
+		scale := kilometresPerDegree * stdmath.Max(stdmath.Cos(hotspot.Latitude*stdmath.Pi/180), 1e-6)
+		longitude := hotspot.Longitude + distance*stdmath.Sin(angle)/scale
+
+		return stdmath.Max(-90, stdmath.Min(90, latitude)), stdmath.Remainder(longitude, 360)
+	}
+
+	bounds := f.spec.Bounds
+	return FloatAt(seed, bounds.MinLatitude, bounds.MaxLatitude), FloatAt(seed+1, bounds.MinLongitude, bounds.MaxLongitude)
+}
+
+// time returns a burst time around a peak hour or a uniform time within the window.
+func (f *HotspotFactory) time(seed int64) time.Time {
+	from, to := f.spec.From, f.spec.To
+	if len(f.spec.Peaks) == 0 || !BoolAt(seed, f.spec.PeakShare) {
+		return from.Add(time.Duration(IntAt(seed+1, 0, int64(to.Sub(from)))))
+	}
+
+	weights := make([]float64, len(f.spec.Peaks))
+	for index, peak := range f.spec.Peaks {
+		weights[index] = peak.Weight
+		if weights[index] == 0 {
+			weights[index] = 1
+		}
+	}
+	peak := f.spec.Peaks[pickWeighted(weights, seed+1)]
+
+	start := from.In(f.spec.Location)
+	days := int64(to.Sub(from) / Day)
+	year, month, day := start.Date()
+	midnight := time.Date(year, month, day+int(IntAt(seed+2, 0, days)), 0, 0, 0, 0, f.spec.Location)
+
+	spread := int64(f.spec.PeakSpread)
+	at := midnight.Add(time.Duration(peak.Hour)*time.Hour + time.Duration(IntAt(seed+3, -spread, spread)))
+	if at.Before(from) {
+		return from
+	}
+	if at.After(to) {
+		return to
+	}
+	return at
+}
+
+// pickWeighted picks an index of weights in proportion to its value; weights must sum to more than
+// zero.
+func pickWeighted(weights []float64, seed int64) int {
+	total := 0.0
+	for _, weight := range weights {
+		total += weight
+	}
+
+	point := FloatAt(seed, 0, total)
+	for index, weight := range weights {
+		point -= weight
+		if point < 0 {
+			return index
+		}
+	}
+	return len(weights) - 1
+}
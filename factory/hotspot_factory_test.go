@@ -0,0 +1,103 @@
+package factory
+
+import (
+	stdmath "math"
+	"testing"
+	"time"
+)
+
+func hotspotSpec() HotspotSpec {
+	return HotspotSpec{
+		Hotspots: []Hotspot{
+			{Name: "tokyo", Latitude: 35.68, Longitude: 139.76, RadiusKm: 10, Weight: 2},
+			{Name: "paris", Latitude: 48.85, Longitude: 2.35, RadiusKm: 5},
+			{Name: "new-york", Latitude: 40.71, Longitude: -74.0, RadiusKm: 8},
+		},
+		Peaks: []PeakHour{{Hour: 9}, {Hour: 18}},
+	}
+}
+
+func TestHotspotFactoryConcentratesEvents(t *testing.T) {
+	events := Builder(NewHotspotFactory(hotspotSpec())).BuildListWith(4000, 1, nil)
+
+	counts := make(map[string]int)
+	for _, event := range events {
+		counts[event.Hotspot]++
+	}
+
+	if share := float64(len(events)-counts[""]) / float64(len(events)); stdmath.Abs(share-0.8) > 0.03 {
+		t.Fatalf("expected about 80%% of events in hotspots, got %.3f", share)
+	}
+	if ratio := float64(counts["tokyo"]) / float64(counts["paris"]); ratio < 1.7 || ratio > 2.3 {
+		t.Fatalf("expected tokyo to get twice paris's events, got ratio %.2f", ratio)
+	}
+}
+
+func TestHotspotFactoryPlacesEventsWithinRadius(t *testing.T) {
+	spec := hotspotSpec()
+	for _, event := range Builder(NewHotspotFactory(spec)).BuildListWith(1000, 1, nil) {
+		for _, hotspot := range spec.Hotspots {
+			if hotspot.Name != event.Hotspot {
+				continue
+			}
+
+			dLatitude := (event.Latitude - hotspot.Latitude) * kilometresPerDegree
+			dLongitude := (event.Longitude - hotspot.Longitude) * kilometresPerDegree * stdmath.Cos(hotspot.Latitude*stdmath.Pi/180)
+			if distance := stdmath.Hypot(dLatitude, dLongitude); distance > hotspot.RadiusKm+1e-6 {
+				t.Fatalf("event %v is %.2fkm from %s", event, distance, hotspot.Name)
+			}
+		}
+	}
+}
+
+func TestHotspotFactoryBurstsAroundPeaks(t *testing.T) {
+	spec := hotspotSpec()
+	events := Builder(NewHotspotFactory(spec)).BuildListWith(2000, 1, nil)
+
+	bursts := 0
+	for _, event := range events {
+		at := event.At.UTC()
+		if at.Before(defaultTimeSeriesStart) || at.After(defaultTimeSeriesStart.Add(defaultHotspotWindow)) {
+			t.Fatalf("event time %v outside the window", at)
+		}
+
+		for _, peak := range spec.Peaks {
+			midnight := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+			if offset := at.Sub(midnight.Add(time.Duration(peak.Hour) * time.Hour)); offset.Abs() <= defaultHotspotPeakSpread {
+				bursts++
+			}
+		}
+	}
+
+	if share := float64(bursts) / float64(len(events)); share < 0.78 {
+		t.Fatalf("expected most events near peak hours, got %.3f", share)
+	}
+}
+
+func TestHotspotFactoryOverrideHotspot(t *testing.T) {
+	builder := Builder(NewHotspotFactory(hotspotSpec()))
+
+	for _, event := range builder.BuildList(50, Override[HotspotProperties](map[string]any{"hotspot": "paris"})) {
+		if event.Hotspot != "paris" || stdmath.Abs(event.Latitude-48.85) > 0.1 {
+			t.Fatalf("expected a paris event, got %v", event)
+		}
+	}
+}
+
+func TestHotspotFactoryRejectsInvalidSpec(t *testing.T) {
+	for name, spec := range map[string]HotspotSpec{
+		"negative weight": {Hotspots: []Hotspot{{Name: "a", Weight: -1}}},
+		"duplicate name":  {Hotspots: []Hotspot{{Name: "a"}, {Name: "a"}}},
+		"peak hour":       {Peaks: []PeakHour{{Hour: 24}}},
+		"concentration":   {Concentration: 1.5},
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("expected a panic")
+				}
+			}()
+			NewHotspotFactory(spec)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package factory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// IBANFormat selects how IBANFactory renders account numbers.
+type IBANFormat int
+
+// Account number formats supported by IBANFactory.
+const (
+	// IBANElectronic renders the IBAN without spaces, e.g. DE89370400440532013000.
+	IBANElectronic IBANFormat = iota
+	// IBANPrint renders the IBAN in groups of four, e.g. DE89 3704 0044 0532 0130 00.
+	IBANPrint
+	// IBANBBAN renders only the domestic Basic Bank Account Number, e.g. 370400440532013000.
+	IBANBBAN
+)
+
+const ibanCountrySalt = 0x01BA_4C0D
+
+// ibanCountry describes the BBAN of a country. In template, '#' is a digit and 'A' an uppercase
+// letter; check, when set, replaces the national check digits of a generated BBAN.
+type ibanCountry struct {
+	template string
+	check    func(bban string) string
+}
+
+var ibanCountries = map[string]ibanCountry{
+	"AT": {template: "################"},
+	"BE": {template: "############", check: belgianCheck},
+	"CH": {template: "#################"},
+	"DE": {template: "##################"},
+	"ES": {template: "####################", check: spanishCheck},
+	"FR": {template: "#######################", check: frenchCheck},
+	"GB": {template: "AAAA##############"},
+	"NL": {template: "AAAA##########"},
+}
+
+// IBANProperties carries the country, BBAN and rendering of an account number.
+type IBANProperties struct {
+	country string
+	bban    string
+	value   string
+}
+
+// IBANFactory generates IBANs with valid ISO 13616 check digits for Countries (AT, BE, CH, DE,
+// ES, FR, GB and NL; DE when empty), rendered in Format. The BBANs follow each country's length
+// and layout, with valid national check digits for BE, ES and FR. Prepare panics for
+// unsupported countries.
+type IBANFactory struct {
+	Countries []string
+	Format    IBANFormat
+}
+
+// Instantiate returns the rendered account number.
+func (f *IBANFactory) Instantiate(properties IBANProperties) string {
+	return properties.value
+}
+
+// Prepare picks a country and generates its BBAN; a non-empty country, BBAN or value from
+// overrides is kept.
+func (f *IBANFactory) Prepare(overrides Partial[IBANProperties], seed int64) IBANProperties {
+	properties := IBANProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value != "" {
+		return properties
+	}
+
+	if properties.country == "" {
+		properties.country = pickString(f.Countries, []string{"DE"}, seed^ibanCountrySalt)
+	}
+	properties.country = strings.ToUpper(properties.country)
+	country, ok := ibanCountries[properties.country]
+	if !ok {
+		panic(fmt.Sprintf("iban: unsupported country %q", properties.country))
+	}
+
+	if properties.bban == "" {
+		properties.bban = fillIBANTemplate(country.template, seed)
+		if country.check != nil {
+			properties.bban = country.check(properties.bban)
+		}
+	}
+
+	iban := properties.country + ibanCheckDigits(properties.country, properties.bban) + properties.bban
+	switch f.Format {
+	case IBANPrint:
+		properties.value = groupIBAN(iban)
+	case IBANBBAN:
+		properties.value = properties.bban
+	default:
+		properties.value = iban
+	}
+
+	return properties
+}
+
+// Retrieve wraps a rendered account number into IBANProperties.
+func (f *IBANFactory) Retrieve(instance string) IBANProperties {
+	return IBANProperties{
+		value: instance,
+	}
+}
+
+func fillIBANTemplate(template string, seed int64) string {
+	bban := []byte(template)
+	for index, character := range bban {
+		switch character {
+		case '#':
+			//nolint:gosec // G115: The digit is within 0-9
+			bban[index] = byte('0' + IntAt(seed+int64(index), 0, 9))
+		case 'A':
+			//nolint:gosec // G115: The letter is within A-Z
+			bban[index] = byte('A' + IntAt(seed+int64(index), 0, 25))
+		}
+	}
+	return string(bban)
+}
+
+// ibanCheckDigits computes the ISO 7064 mod 97-10 check digits of an IBAN.
+func ibanCheckDigits(country, bban string) string {
+	return fmt.Sprintf("%02d", 98-ibanMod97(bban+country+"00"))
+}
+
+// ibanMod97 returns value mod 97, reading letters as the numbers 10 to 35.
+func ibanMod97(value string) int {
+	remainder := 0
+	for _, character := range value {
+		switch {
+		case character >= '0' && character <= '9':
+			remainder = (remainder*10 + int(character-'0')) % 97
+		case character >= 'A' && character <= 'Z':
+			remainder = (remainder*100 + int(character-'A') + 10) % 97
+		}
+	}
+	return remainder
+}
+
+func groupIBAN(iban string) string {
+	var builder strings.Builder
+	for index, character := range iban {
+		if index > 0 && index%4 == 0 {
+			builder.WriteByte(' ')
+		}
+		builder.WriteRune(character)
+	}
+	return builder.String()
+}
+
+// belgianCheck sets the last two digits to the first ten mod 97, with 97 for a zero remainder.
+func belgianCheck(bban string) string {
+	number, _ := strconv.ParseInt(bban[:10], 10, 64)
+	check := number % 97
+	if check == 0 {
+		check = 97
+	}
+	return fmt.Sprintf("%s%02d", bban[:10], check)
+}
+
+// spanishCheck sets the two control digits between the branch and the account number.
+func spanishCheck(bban string) string {
+	control := func(digits string) byte {
+		weights := []int{1, 2, 4, 8, 5, 10, 9, 7, 3, 6}
+		sum := 0
+		for index, digit := range digits {
+			sum += int(digit-'0') * weights[index]
+		}
+		check := 11 - sum%11
+		switch check {
+		case 11:
+			check = 0
+		case 10:
+			check = 1
+		}
+		//nolint:gosec // G115: The digit is within 0-9
+		return byte('0' + check)
+	}
+
+	return bban[:8] + string([]byte{control("00" + bban[:8]), control(bban[10:])}) + bban[10:]
+}
+
+// frenchCheck sets the two-digit RIB key after the bank, branch and account numbers.
+func frenchCheck(bban string) string {
+	bank, _ := strconv.ParseInt(bban[:5], 10, 64)
+	branch, _ := strconv.ParseInt(bban[5:10], 10, 64)
+	account, _ := strconv.ParseInt(bban[10:21], 10, 64)
+
+	key := 97 - (89*bank+15*branch+3*account)%97
+	return fmt.Sprintf("%s%02d", bban[:21], key)
+}
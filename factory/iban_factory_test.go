@@ -0,0 +1,96 @@
+package factory
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// ibanValid reports whether iban has valid mod 97-10 check digits.
+func ibanValid(iban string) bool {
+	return len(iban) > 4 && ibanMod97(iban[4:]+iban[:4]) == 1
+}
+
+func TestIBANFactoryChecksums(t *testing.T) {
+	lengths := map[string]int{"AT": 20, "BE": 16, "CH": 21, "DE": 22, "ES": 24, "FR": 27, "GB": 22, "NL": 18}
+	countries := make([]string, 0, len(lengths))
+	for country := range lengths {
+		countries = append(countries, country)
+	}
+
+	seen := make(map[string]bool)
+	for _, iban := range Builder(&IBANFactory{Countries: countries}).BuildList(400, nil) {
+		country := iban[:2]
+		if len(iban) != lengths[country] || !ibanValid(iban) {
+			t.Fatalf("invalid IBAN %s", iban)
+		}
+
+		bban := iban[4:]
+		switch country {
+		case "BE":
+			if belgianCheck(bban) != bban {
+				t.Fatalf("invalid Belgian check digits in %s", iban)
+			}
+		case "ES":
+			if spanishCheck(bban) != bban {
+				t.Fatalf("invalid Spanish control digits in %s", iban)
+			}
+		case "FR":
+			if frenchCheck(bban) != bban {
+				t.Fatalf("invalid RIB key in %s", iban)
+			}
+		}
+		seen[country] = true
+	}
+
+	if len(seen) != len(lengths) {
+		t.Fatalf("expected every country, got %v", seen)
+	}
+}
+
+func TestIBANFactoryKnownAccounts(t *testing.T) {
+	for _, iban := range []string{
+		"DE89370400440532013000",
+		"GB29NWBK60161331926819",
+		"BE68539007547034",
+		"ES9121000418450200051332",
+		"FR7630006000011234567890189",
+	} {
+		if check := ibanCheckDigits(iban[:2], iban[4:]); check != iban[2:4] {
+			t.Fatalf("expected check digits %s for %s, got %s", iban[2:4], iban, check)
+		}
+	}
+
+	if belgianCheck("539007547099") != "539007547034" {
+		t.Fatal("unexpected Belgian check digits")
+	}
+	if spanishCheck("21000418000200051332") != "21000418450200051332" {
+		t.Fatal("unexpected Spanish control digits")
+	}
+	if frenchCheck("30006000011234567890100") != "30006000011234567890189" {
+		t.Fatal("unexpected RIB key")
+	}
+}
+
+func TestIBANFactoryFormats(t *testing.T) {
+	printed := Builder(&IBANFactory{Format: IBANPrint}).BuildWith(1, nil)
+	if !regexp.MustCompile(`^DE[0-9]{2}( [0-9]{4}){4} [0-9]{2}$`).MatchString(printed) {
+		t.Fatalf("unexpected print format %q", printed)
+	}
+
+	electronic := Builder(&IBANFactory{}).BuildWith(1, nil)
+	if strings.ReplaceAll(printed, " ", "") != electronic {
+		t.Fatalf("expected %q to group %q", printed, electronic)
+	}
+
+	if bban := Builder(&IBANFactory{Format: IBANBBAN}).BuildWith(1, nil); bban != electronic[4:] {
+		t.Fatalf("expected the BBAN of %q, got %q", electronic, bban)
+	}
+}
+
+func TestIBANFactoryOverrideBBAN(t *testing.T) {
+	iban := Builder(&IBANFactory{}).Build(Override[IBANProperties](map[string]any{"country": "de", "bban": "370400440532013000"}))
+	if iban != "DE89370400440532013000" {
+		t.Fatalf("unexpected IBAN %q", iban)
+	}
+}
@@ -0,0 +1,28 @@
+package factory
+
+// Identifiable is implemented by factories whose properties carry identity fields, such as an ID
+// or CreatedAt, that must not be copied when duplicating an instance as a new record.
+type Identifiable interface {
+	IdentityFields() []string
+}
+
+type identityConfig struct {
+	fields []string
+	clear  bool
+}
+
+// WithIdentityFields registers the identity fields used by DuplicateAsNew, taking precedence over
+// the fields reported by an Identifiable factory.
+func WithIdentityFields(fields ...string) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.identity.fields = fields
+	}
+}
+
+// ClearIdentityFields makes DuplicateAsNew reset identity fields to their zero value instead of
+// regenerating them, e.g. when the database assigns IDs on insert.
+func ClearIdentityFields() BuilderOption {
+	return func(opts *builderOptions) {
+		opts.identity.clear = true
+	}
+}
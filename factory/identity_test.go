@@ -0,0 +1,58 @@
+package factory
+
+import "testing"
+
+type identifiedStubFactory struct {
+	stubFactory
+}
+
+func (f *identifiedStubFactory) IdentityFields() []string {
+	return []string{"Seed"}
+}
+
+func TestDuplicateAsNewRegeneratesIdentityFields(t *testing.T) {
+	builder := Builder(&identifiedStubFactory{})
+	original := builder.BuildWith(1, Override[stubProps](map[string]any{"Value": "kept"}))
+
+	duplicated := DuplicateAsNew(builder, original, nil)
+
+	if duplicated.Value != "kept" {
+		t.Fatalf("expected non-identity fields to be copied, got %q", duplicated.Value)
+	}
+	if duplicated.Seed == original.Seed {
+		t.Fatal("expected identity field to be regenerated")
+	}
+}
+
+func TestDuplicateAsNewAppliesOverridesAfterMasking(t *testing.T) {
+	builder := Builder(&identifiedStubFactory{})
+	original := builder.Build(nil)
+
+	duplicated := DuplicateAsNew(builder, original, Override[stubProps](map[string]any{"Seed": int64(99)}))
+
+	if duplicated.Seed != 99 {
+		t.Fatalf("expected override to win over regenerated identity, got %d", duplicated.Seed)
+	}
+}
+
+func TestDuplicateAsNewWithClearIdentityFields(t *testing.T) {
+	builder := Builder(&stubFactory{}, WithIdentityFields("seed"), ClearIdentityFields())
+	original := builder.Build(nil)
+
+	duplicated := DuplicateAsNew(builder, original, nil)
+
+	if duplicated.Seed != 0 || duplicated.Value != original.Value {
+		t.Fatalf("expected only the identity field to be cleared, got %+v", duplicated)
+	}
+}
+
+func TestDuplicateAsNewPanicsWithoutIdentityFields(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic without identity fields")
+		}
+	}()
+
+	builder := Builder(&stubFactory{})
+	DuplicateAsNew(builder, builder.Build(nil), nil)
+}
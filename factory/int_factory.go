@@ -0,0 +1,91 @@
+package factory
+
+import stdmath "math"
+
+const (
+	defaultIntMax = 1_000_000
+	intEdgeCases  = 6
+)
+
+// IntProperties carries the generated value for IntFactory.
+type IntProperties struct {
+	value int
+}
+
+// IntFactory generates integers within [Min, Max]; negative bounds are allowed.
+// When both bounds are zero the range is [0, 1,000,000].
+type IntFactory struct {
+	Min int
+	Max int
+
+	adversarial bool
+}
+
+// Adversarial returns a copy of the factory that favours zero, -1, the bounds and the extremes of int.
+func (f *IntFactory) Adversarial() Factory[int, IntProperties] {
+	adversarial := *f
+	adversarial.adversarial = true
+	return &adversarial
+}
+
+// Boundaries returns Min, Min+1, Max-1 and Max.
+func (f *IntFactory) Boundaries() []int {
+	minimum, maximum := f.bounds()
+
+	points := boundaryPoints(int64(minimum), int64(maximum))
+	values := make([]int, len(points))
+	for index, point := range points {
+		values[index] = int(point)
+	}
+
+	return values
+}
+
+// Instantiate returns the prepared int.
+func (f *IntFactory) Instantiate(properties IntProperties) int {
+	return properties.value
+}
+
+// Prepare generates the value before applying overrides, so overriding with 0 is honored.
+func (f *IntFactory) Prepare(overrides Partial[IntProperties], seed int64) IntProperties {
+	properties := IntProperties{
+		value: f.Generate(seed),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return properties
+}
+
+// Retrieve converts an int back into IntProperties.
+func (f *IntFactory) Retrieve(instance int) IntProperties {
+	return IntProperties{
+		value: instance,
+	}
+}
+
+// Generate produces the same value as building with seed and no overrides, without allocating.
+func (f *IntFactory) Generate(seed int64) int {
+	minimum, maximum := f.bounds()
+
+	if f.adversarial {
+		if index, ok := adversarialCase(seed, intEdgeCases); ok {
+			return [intEdgeCases]int{0, -1, minimum, maximum, stdmath.MaxInt, stdmath.MinInt}[index]
+		}
+	}
+
+	return int(IntAt(seed, int64(minimum), int64(maximum)))
+}
+
+func (f *IntFactory) bounds() (minimum, maximum int) {
+	minimum, maximum = f.Min, f.Max
+	if minimum == 0 && maximum == 0 {
+		maximum = defaultIntMax
+	}
+	if maximum < minimum {
+		maximum = minimum
+	}
+	return minimum, maximum
+}
@@ -0,0 +1,74 @@
+package factory
+
+import (
+	"fmt"
+	stdmath "math"
+	"testing"
+)
+
+func TestIntFactoryStaysWithinBounds(t *testing.T) {
+	builder := Builder(&IntFactory{Min: -5, Max: 5})
+
+	seen := make(map[int]bool)
+	for _, value := range builder.BuildListWith(500, 1, nil) {
+		if value < -5 || value > 5 {
+			t.Fatalf("value %d out of range", value)
+		}
+		seen[value] = true
+	}
+
+	if len(seen) != 11 {
+		t.Fatalf("expected every value in [-5, 5], got %d distinct", len(seen))
+	}
+}
+
+func TestIntFactoryDefaults(t *testing.T) {
+	for _, value := range Builder(&IntFactory{}).BuildList(100, nil) {
+		if value < 0 || value > defaultIntMax {
+			t.Fatalf("value %d outside default range", value)
+		}
+	}
+
+	if value := Builder(&IntFactory{Min: 7, Max: 3}).Build(nil); value != 7 {
+		t.Fatalf("expected Max below Min to collapse to Min, got %d", value)
+	}
+}
+
+func TestIntFactoryIsDeterministic(t *testing.T) {
+	factory := &IntFactory{Min: -1000, Max: 1000}
+
+	for seed := range int64(50) {
+		if Builder(factory).BuildWith(seed, nil) != factory.Generate(seed) {
+			t.Fatalf("expected Build and Generate to agree for seed %d", seed)
+		}
+	}
+}
+
+func TestIntFactoryOverrideWithZero(t *testing.T) {
+	builder := Builder(&IntFactory{Min: 10, Max: 20})
+
+	if value := builder.Build(Override[IntProperties](map[string]any{"value": 0})); value != 0 {
+		t.Fatalf("expected override to zero, got %d", value)
+	}
+}
+
+func TestIntFactoryBoundaries(t *testing.T) {
+	if got := fmt.Sprint(Boundary(&IntFactory{Min: -3, Max: 8})); got != "[-3 -2 7 8]" {
+		t.Fatalf("unexpected boundaries %s", got)
+	}
+}
+
+func TestIntFactoryAdversarial(t *testing.T) {
+	builder := Builder(&IntFactory{Min: 10, Max: 20}, AdversarialMode())
+
+	seen := make(map[int]bool)
+	for _, value := range builder.BuildListWith(500, 1, nil) {
+		seen[value] = true
+	}
+
+	for _, edge := range []int{0, -1, stdmath.MaxInt, stdmath.MinInt} {
+		if !seen[edge] {
+			t.Fatalf("expected edge value %d", edge)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package factory
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+// IPMode selects the address space used by IPFactory and CIDRFactory.
+type IPMode int
+
+// Address spaces supported by IPFactory and CIDRFactory.
+const (
+	// IPModeV4 draws from the whole IPv4 space.
+	IPModeV4 IPMode = iota
+	// IPModeV6 draws from the whole IPv6 space.
+	IPModeV6
+	// IPModePrivateV4 draws from the RFC 1918 ranges 10.0.0.0/8, 172.16.0.0/12 and 192.168.0.0/16.
+	IPModePrivateV4
+	// IPModePrivateV6 draws from the RFC 4193 unique local range fc00::/7.
+	IPModePrivateV6
+)
+
+const ipRangeSalt = 0x01B2_A7E5
+
+var (
+	ipPrivateV4Prefixes = []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+	}
+	ipPrivateV6Prefixes = []netip.Prefix{netip.MustParsePrefix("fc00::/7")}
+	ipV4Prefixes        = []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}
+	ipV6Prefixes        = []netip.Prefix{netip.MustParsePrefix("::/0")}
+)
+
+// prefix returns the range of mode to draw from for seed. It panics for unknown modes.
+func (mode IPMode) prefix(seed int64) netip.Prefix {
+	var prefixes []netip.Prefix
+	switch mode {
+	case IPModeV4:
+		prefixes = ipV4Prefixes
+	case IPModeV6:
+		prefixes = ipV6Prefixes
+	case IPModePrivateV4:
+		prefixes = ipPrivateV4Prefixes
+	case IPModePrivateV6:
+		prefixes = ipPrivateV6Prefixes
+	default:
+		panic(fmt.Sprintf("ip: unsupported mode %d", mode))
+	}
+
+	return prefixes[IntAt(seed^ipRangeSalt, 0, int64(len(prefixes)-1))]
+}
+
+// addrInPrefix keeps the network bits of prefix and fills the host bits from seed.
+func addrInPrefix(prefix netip.Prefix, seed int64) netip.Addr {
+	prefix = prefix.Masked()
+	address := prefix.Addr().AsSlice()
+	random := seededBytes(seed, len(address))
+
+	for index := range address {
+		kept := min(max(prefix.Bits()-index*8, 0), 8)
+		//nolint:gosec // G115: Truncation keeps the low byte holding the kept network bits
+		mask := byte(uint16(0xFF00) >> kept)
+		address[index] = address[index]&mask | random[index]&^mask
+	}
+
+	addr, _ := netip.AddrFromSlice(address)
+	return addr
+}
+
+// IPProperties carries the generated address for IPFactory.
+type IPProperties struct {
+	addr netip.Addr
+}
+
+// IPFactory generates netip.Addr values in the address space of Mode, or within the Within
+// prefix when it is valid. NetIP returns the same addresses as net.IP.
+type IPFactory struct {
+	Mode   IPMode
+	Within netip.Prefix
+}
+
+// NetIP returns a factory producing the same addresses as net.IP.
+func (f *IPFactory) NetIP() *NetIPFactory {
+	return &NetIPFactory{addrs: f}
+}
+
+// Instantiate returns the prepared address.
+func (f *IPFactory) Instantiate(properties IPProperties) netip.Addr {
+	return properties.addr
+}
+
+// Prepare generates an address unless a valid one is overridden. It panics for unknown modes.
+func (f *IPFactory) Prepare(overrides Partial[IPProperties], seed int64) IPProperties {
+	properties := IPProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if !properties.addr.IsValid() {
+		properties.addr = f.Generate(seed)
+	}
+
+	return properties
+}
+
+// Retrieve converts an address back into IPProperties.
+func (f *IPFactory) Retrieve(instance netip.Addr) IPProperties {
+	return IPProperties{
+		addr: instance,
+	}
+}
+
+// Generate returns the address for seed without building properties.
+func (f *IPFactory) Generate(seed int64) netip.Addr {
+	prefix := f.Within
+	if !prefix.IsValid() {
+		prefix = f.Mode.prefix(seed)
+	}
+	return addrInPrefix(prefix, seed)
+}
+
+// NetIPFactory produces the addresses of an IPFactory as net.IP; see IPFactory.NetIP.
+type NetIPFactory struct {
+	addrs *IPFactory
+}
+
+// Instantiate returns the address as a freshly allocated net.IP.
+func (f *NetIPFactory) Instantiate(properties IPProperties) net.IP {
+	return net.IP(properties.addr.AsSlice())
+}
+
+// Prepare delegates to the underlying IPFactory.
+func (f *NetIPFactory) Prepare(overrides Partial[IPProperties], seed int64) IPProperties {
+	return f.addrs.Prepare(overrides, seed)
+}
+
+// Retrieve converts a net.IP back into IPProperties; IPv4-mapped addresses become IPv4.
+func (f *NetIPFactory) Retrieve(instance net.IP) IPProperties {
+	addr, _ := netip.AddrFromSlice(instance)
+	return IPProperties{
+		addr: addr.Unmap(),
+	}
+}
+
+const (
+	defaultCIDRMinBitsV4 = 8
+	defaultCIDRMaxBitsV4 = 30
+	defaultCIDRMinBitsV6 = 32
+	defaultCIDRMaxBitsV6 = 64
+)
+
+// CIDRProperties carries the generated prefix for CIDRFactory.
+type CIDRProperties struct {
+	prefix netip.Prefix
+}
+
+// CIDRFactory generates masked netip.Prefix values such as 10.20.0.0/16. Networks lie in the
+// address space of Mode, or within the Within prefix when it is valid. Prefix lengths range from
+// MinBits to MaxBits (8 to 30 for IPv4 and 32 to 64 for IPv6 when zero) and never undercut the
+// enclosing range.
+type CIDRFactory struct {
+	Mode    IPMode
+	Within  netip.Prefix
+	MinBits int
+	MaxBits int
+}
+
+// Instantiate returns the prepared prefix.
+func (f *CIDRFactory) Instantiate(properties CIDRProperties) netip.Prefix {
+	return properties.prefix
+}
+
+// Prepare generates a prefix unless a valid one is overridden. It panics for unknown modes.
+func (f *CIDRFactory) Prepare(overrides Partial[CIDRProperties], seed int64) CIDRProperties {
+	properties := CIDRProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if !properties.prefix.IsValid() {
+		properties.prefix = f.Generate(seed)
+	}
+
+	return properties
+}
+
+// Retrieve converts a prefix back into CIDRProperties.
+func (f *CIDRFactory) Retrieve(instance netip.Prefix) CIDRProperties {
+	return CIDRProperties{
+		prefix: instance,
+	}
+}
+
+// Generate returns the prefix for seed without building properties.
+func (f *CIDRFactory) Generate(seed int64) netip.Prefix {
+	within := f.Within
+	if !within.IsValid() {
+		within = f.Mode.prefix(seed)
+	}
+
+	minBits, maxBits := f.MinBits, f.MaxBits
+	if minBits == 0 && maxBits == 0 {
+		minBits, maxBits = defaultCIDRMinBitsV4, defaultCIDRMaxBitsV4
+		if within.Addr().Is6() {
+			minBits, maxBits = defaultCIDRMinBitsV6, defaultCIDRMaxBitsV6
+		}
+	}
+	maxBits = min(max(maxBits, within.Bits()), within.Addr().BitLen())
+	minBits = min(max(minBits, within.Bits()), maxBits)
+
+	bits := int(IntAt(seed, int64(minBits), int64(maxBits)))
+	return netip.PrefixFrom(addrInPrefix(within, seed), bits).Masked()
+}
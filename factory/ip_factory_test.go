@@ -0,0 +1,86 @@
+package factory
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIPFactoryModes(t *testing.T) {
+	cases := []struct {
+		mode  IPMode
+		check func(netip.Addr) bool
+	}{
+		{IPModeV4, netip.Addr.Is4},
+		{IPModeV6, netip.Addr.Is6},
+		{IPModePrivateV4, func(addr netip.Addr) bool { return addr.Is4() && addr.IsPrivate() }},
+		{IPModePrivateV6, func(addr netip.Addr) bool { return addr.Is6() && addr.IsPrivate() }},
+	}
+
+	for _, c := range cases {
+		for _, addr := range Builder(&IPFactory{Mode: c.mode}).BuildList(200, nil) {
+			if !addr.IsValid() || !c.check(addr) {
+				t.Fatalf("mode %d produced unexpected address %s", c.mode, addr)
+			}
+		}
+	}
+}
+
+func TestIPFactoryPrivateV4CoversEveryRange(t *testing.T) {
+	seen := make(map[netip.Prefix]bool)
+	for _, addr := range Builder(&IPFactory{Mode: IPModePrivateV4}).BuildList(300, nil) {
+		for _, prefix := range ipPrivateV4Prefixes {
+			if prefix.Contains(addr) {
+				seen[prefix] = true
+			}
+		}
+	}
+
+	if len(seen) != len(ipPrivateV4Prefixes) {
+		t.Fatalf("expected every RFC 1918 range, got %v", seen)
+	}
+}
+
+func TestIPFactoryWithin(t *testing.T) {
+	within := netip.MustParsePrefix("198.51.100.64/27")
+	factory := &IPFactory{Within: within}
+
+	for seed := range int64(100) {
+		addr := Builder(factory).BuildWith(seed, nil)
+		if !within.Contains(addr) {
+			t.Fatalf("address %s outside %s", addr, within)
+		}
+
+		ip := Builder(factory.NetIP()).BuildWith(seed, nil)
+		if ip.String() != addr.String() || factory.NetIP().Retrieve(ip).addr != addr {
+			t.Fatalf("expected net.IP %s to match %s", ip, addr)
+		}
+	}
+}
+
+func TestIPFactoryOverride(t *testing.T) {
+	fixed := netip.MustParseAddr("192.0.2.1")
+	if addr := Builder(&IPFactory{}).Build(Override[IPProperties](map[string]any{"addr": fixed})); addr != fixed {
+		t.Fatalf("expected overridden address, got %s", addr)
+	}
+}
+
+func TestCIDRFactory(t *testing.T) {
+	for _, prefix := range Builder(&CIDRFactory{}).BuildList(200, nil) {
+		if !prefix.Addr().Is4() || prefix.Bits() < defaultCIDRMinBitsV4 || prefix.Bits() > defaultCIDRMaxBitsV4 || prefix != prefix.Masked() {
+			t.Fatalf("unexpected default prefix %s", prefix)
+		}
+	}
+
+	for _, prefix := range Builder(&CIDRFactory{Mode: IPModeV6}).BuildList(200, nil) {
+		if !prefix.Addr().Is6() || prefix.Bits() < defaultCIDRMinBitsV6 || prefix.Bits() > defaultCIDRMaxBitsV6 {
+			t.Fatalf("unexpected IPv6 prefix %s", prefix)
+		}
+	}
+
+	within := netip.MustParsePrefix("10.0.0.0/16")
+	for _, prefix := range Builder(&CIDRFactory{Within: within, MinBits: 8, MaxBits: 24}).BuildList(200, nil) {
+		if !within.Contains(prefix.Addr()) || prefix.Bits() < 16 || prefix.Bits() > 24 {
+			t.Fatalf("prefix %s not within %s", prefix, within)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package factory
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// JSONKind identifies the type of a JSON value generated by JSONFactory.
+type JSONKind string
+
+// JSON value kinds supported by JSONFactory.
+const (
+	JSONNull   JSONKind = "null"
+	JSONBool   JSONKind = "bool"
+	JSONNumber JSONKind = "number"
+	JSONString JSONKind = "string"
+	JSONArray  JSONKind = "array"
+	JSONObject JSONKind = "object"
+)
+
+const (
+	defaultJSONMaxDepth = 3
+	defaultJSONMinKeys  = 1
+	defaultJSONMaxKeys  = 5
+	jsonKindSalt        = 0x0150_4B1D
+	jsonCountSalt       = 0x0150_C047
+	jsonKeySalt         = 0x0150_4E75
+)
+
+// DefaultJSONWeights favours scalars so documents stay moderately sized.
+var DefaultJSONWeights = map[JSONKind]float64{
+	JSONNull:   1,
+	JSONBool:   2,
+	JSONNumber: 3,
+	JSONString: 3,
+	JSONArray:  1,
+	JSONObject: 1,
+}
+
+// JSONProperties carries the generated document for JSONFactory and JSONMapFactory.
+type JSONProperties struct {
+	document map[string]any
+}
+
+// JSONFactory generates arbitrary JSON documents for payload, webhook and schema-less store tests.
+// The root is always an object with MinKeys to MaxKeys keys (1 to 5 when zero); arrays hold as
+// many elements. Values are picked in proportion to Weights (DefaultJSONWeights when nil), and
+// arrays and objects are only nested up to MaxDepth levels (3 when zero). Numbers are float64,
+// matching what encoding/json decodes into map[string]any. Map returns a factory producing the
+// decoded documents.
+type JSONFactory struct {
+	MaxDepth int
+	MinKeys  int
+	MaxKeys  int
+	Weights  map[JSONKind]float64
+}
+
+// Map returns a factory producing the documents as map[string]any from the same configuration.
+func (f *JSONFactory) Map() *JSONMapFactory {
+	return &JSONMapFactory{documents: f}
+}
+
+// Instantiate encodes the document.
+func (f *JSONFactory) Instantiate(properties JSONProperties) json.RawMessage {
+	document := properties.document
+	if document == nil {
+		document = map[string]any{}
+	}
+
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		panic(fmt.Sprintf("json: encode document: %v", err))
+	}
+	return encoded
+}
+
+// Prepare generates the document unless overridden. It panics when no kind has a positive
+// weight.
+func (f *JSONFactory) Prepare(overrides Partial[JSONProperties], seed int64) JSONProperties {
+	properties := JSONProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.document == nil {
+		properties.document = f.object(1, seed)
+	}
+
+	return properties
+}
+
+// Retrieve decodes an encoded document into JSONProperties. Documents that are not JSON objects
+// yield empty properties.
+func (f *JSONFactory) Retrieve(instance json.RawMessage) JSONProperties {
+	var document map[string]any
+	if err := json.Unmarshal(instance, &document); err != nil {
+		return JSONProperties{}
+	}
+	return JSONProperties{document: document}
+}
+
+func (f *JSONFactory) bounds() (maxDepth, minimum, maximum int) {
+	maxDepth, minimum, maximum = f.MaxDepth, f.MinKeys, f.MaxKeys
+	if maxDepth <= 0 {
+		maxDepth = defaultJSONMaxDepth
+	}
+	if minimum <= 0 {
+		minimum = defaultJSONMinKeys
+	}
+	if maximum <= 0 {
+		maximum = max(minimum, defaultJSONMaxKeys)
+	}
+	return maxDepth, minimum, max(minimum, maximum)
+}
+
+func (f *JSONFactory) count(seed int64) int {
+	_, minimum, maximum := f.bounds()
+	return int(IntAt(seed^jsonCountSalt, int64(minimum), int64(maximum)))
+}
+
+func (f *JSONFactory) object(depth int, seed int64) map[string]any {
+	size := f.count(seed)
+	object := make(map[string]any, size)
+	for index := range size {
+		key := slugWords[IntAt(seed^jsonKeySalt+int64(index), 0, int64(len(slugWords)-1))]
+		if _, taken := object[key]; taken {
+			key += "_" + strconv.Itoa(index)
+		}
+		object[key] = f.value(depth+1, childSeed(seed, index))
+	}
+	return object
+}
+
+func (f *JSONFactory) value(depth int, seed int64) any {
+	switch f.kind(depth, seed^jsonKindSalt) {
+	case JSONBool:
+		return BoolAt(seed, 0.5)
+	case JSONNumber:
+		if BoolAt(seed+1, 0.5) {
+			return float64(IntAt(seed, -1000, 1000))
+		}
+		return float64(IntAt(seed, -100000, 100000)) / 100
+	case JSONString:
+		return generateString(seed, 1, 12, Characters.Alphanumeric)
+	case JSONArray:
+		array := make([]any, f.count(seed))
+		for index := range array {
+			array[index] = f.value(depth+1, childSeed(seed, index))
+		}
+		return array
+	case JSONObject:
+		return f.object(depth, seed)
+	default:
+		return nil
+	}
+}
+
+// kind picks the kind of a value at depth, the number of containers it would make including
+// itself; arrays and objects are left out past MaxDepth, falling back to null when only they have
+// weight.
+func (f *JSONFactory) kind(depth int, seed int64) JSONKind {
+	weights := f.Weights
+	if weights == nil {
+		weights = DefaultJSONWeights
+	}
+	maxDepth, _, _ := f.bounds()
+
+	kinds := make([]JSONKind, 0, len(weights))
+	positive := false
+	for kind, weight := range weights {
+		if weight < 0 {
+			panic(fmt.Sprintf("json: negative weight %v for %q", weight, kind))
+		}
+		if weight == 0 {
+			continue
+		}
+		positive = true
+		if depth > maxDepth && (kind == JSONArray || kind == JSONObject) {
+			continue
+		}
+		kinds = append(kinds, kind)
+	}
+	if !positive {
+		panic("json: no kind has a positive weight")
+	}
+	if len(kinds) == 0 {
+		return JSONNull
+	}
+	slices.Sort(kinds)
+
+	values := make([]float64, len(kinds))
+	for index, kind := range kinds {
+		values[index] = weights[kind]
+	}
+	return kinds[pickWeighted(values, seed)]
+}
+
+// JSONMapFactory produces the documents of a JSONFactory as map[string]any; see JSONFactory.Map.
+type JSONMapFactory struct {
+	documents *JSONFactory
+}
+
+// Instantiate returns the document.
+func (f *JSONMapFactory) Instantiate(properties JSONProperties) map[string]any {
+	return properties.document
+}
+
+// Prepare delegates to the underlying JSONFactory.
+func (f *JSONMapFactory) Prepare(overrides Partial[JSONProperties], seed int64) JSONProperties {
+	return f.documents.Prepare(overrides, seed)
+}
+
+// Retrieve wraps an existing document into JSONProperties.
+func (f *JSONMapFactory) Retrieve(instance map[string]any) JSONProperties {
+	return JSONProperties{document: instance}
+}
@@ -0,0 +1,96 @@
+package factory
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func jsonDepth(value any) int {
+	deepest := 0
+	switch typed := value.(type) {
+	case map[string]any:
+		for _, element := range typed {
+			deepest = max(deepest, jsonDepth(element))
+		}
+	case []any:
+		for _, element := range typed {
+			deepest = max(deepest, jsonDepth(element))
+		}
+	default:
+		return 0
+	}
+	return deepest + 1
+}
+
+func TestJSONFactoryProducesValidDocuments(t *testing.T) {
+	factory := &JSONFactory{MaxDepth: 2, MinKeys: 2, MaxKeys: 4}
+
+	for index, document := range Builder(factory).BuildListWith(100, 1, nil) {
+		var decoded map[string]any
+		if err := json.Unmarshal(document, &decoded); err != nil {
+			t.Fatalf("document %d is not a JSON object: %v", index, err)
+		}
+		if len(decoded) < 2 || len(decoded) > 4 {
+			t.Fatalf("document %d has %d keys", index, len(decoded))
+		}
+		if depth := jsonDepth(decoded); depth > 2 {
+			t.Fatalf("document %d nests %d levels", index, depth)
+		}
+	}
+}
+
+func TestJSONFactoryWeights(t *testing.T) {
+	factory := &JSONFactory{Weights: map[JSONKind]float64{JSONNumber: 1}}
+
+	for _, document := range Builder(factory.Map()).BuildListWith(50, 1, nil) {
+		for key, value := range document {
+			if _, ok := value.(float64); !ok {
+				t.Fatalf("expected only numbers, got %q: %T", key, value)
+			}
+		}
+	}
+
+	nested := &JSONFactory{MaxDepth: 2, Weights: map[JSONKind]float64{JSONObject: 1}}
+	document := Builder(nested.Map()).BuildWith(3, nil)
+	if jsonDepth(document) != 2 {
+		t.Fatalf("expected containers to stop at MaxDepth, got %v", document)
+	}
+}
+
+func TestJSONFactoryPanicsWithoutPositiveWeight(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic when no kind has a positive weight")
+		}
+	}()
+
+	Builder(&JSONFactory{Weights: map[JSONKind]float64{JSONNull: 0}}).Build(nil)
+}
+
+func TestJSONFactoryMapMatchesRawMessage(t *testing.T) {
+	factory := &JSONFactory{MaxDepth: 4}
+
+	for seed := range int64(20) {
+		var decoded map[string]any
+		if err := json.Unmarshal(Builder(factory).BuildWith(seed, nil), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if document := Builder(factory.Map()).BuildWith(seed, nil); !reflect.DeepEqual(decoded, document) {
+			t.Fatalf("seed %d: raw %v and map %v differ", seed, decoded, document)
+		}
+	}
+}
+
+func TestJSONFactoryRetrieve(t *testing.T) {
+	factory := &JSONFactory{}
+
+	copied := Builder(factory).Duplicate(json.RawMessage(`{"a":[1,true,null]}`), nil)
+	if string(copied) != `{"a":[1,true,null]}` {
+		t.Fatalf("unexpected duplicate %s", copied)
+	}
+
+	if got := factory.Retrieve(json.RawMessage(`[1]`)); got.document != nil {
+		t.Fatalf("expected empty properties for a non-object, got %v", got.document)
+	}
+}
@@ -0,0 +1,143 @@
+package factory
+
+import (
+	"reflect"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// Lazy defers resolution of an associated value until it is first accessed.
+type Lazy[T any] struct {
+	mu       sync.Mutex
+	resolve  func() T
+	value    T
+	resolved bool
+	// resolution is the call stack of the running resolution, innermost frame first, set by the
+	// resolver before it calls resolve and nil otherwise. A Get whose own stack extends it was
+	// called from within resolve.
+	resolution []uintptr
+	done       chan struct{}
+}
+
+// NewLazy wraps resolve so it runs at most once, on the first call to Get.
+func NewLazy[T any](resolve func() T) *Lazy[T] {
+	return &Lazy[T]{resolve: resolve}
+}
+
+// LazyValue returns a Lazy that is already resolved to value.
+func LazyValue[T any](value T) *Lazy[T] {
+	return &Lazy[T]{value: value, resolved: true}
+}
+
+// Get resolves the value on first access and returns the cached value afterwards.
+// A nil Lazy yields the zero value. Concurrent callers wait for the resolution, while
+// re-entering Get from the resolution itself panics. When resolve panics, the Lazy stays
+// unresolved and a waiting caller retries.
+func (l *Lazy[T]) Get() T {
+	var zero T
+	if l == nil {
+		return zero
+	}
+
+	for {
+		l.mu.Lock()
+		if l.resolved {
+			defer l.mu.Unlock()
+			return l.value
+		}
+		if l.resolve == nil {
+			defer l.mu.Unlock()
+			return zero
+		}
+		if l.resolution == nil {
+			break
+		}
+		if extendsStack(callerStack(), l.resolution) {
+			l.mu.Unlock()
+			panic("lazy: circular resolution detected")
+		}
+		done := l.done
+		l.mu.Unlock()
+		<-done
+	}
+	l.done = make(chan struct{})
+
+	resolved := false
+	defer func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.resolved = resolved
+		l.resolution = nil
+		close(l.done)
+	}()
+
+	l.value = l.run()
+	resolved = true
+
+	return l.value
+}
+
+// run marks the resolution with the resolver's stack and calls resolve. It must not be inlined,
+// so that a re-entrant Get finds the frame of this call below its own.
+//
+//go:noinline
+func (l *Lazy[T]) run() T {
+	l.resolution = callerStack()
+	l.mu.Unlock()
+
+	return l.resolve()
+}
+
+// callerStack returns the program counters of its caller's callers, innermost first.
+func callerStack() []uintptr {
+	stack := make([]uintptr, 32)
+	for {
+		if count := runtime.Callers(3, stack); count < len(stack) {
+			return stack[:count]
+		}
+		stack = make([]uintptr, 2*len(stack))
+	}
+}
+
+// extendsStack reports whether stack was captured below the frames of resolution, i.e. by a call
+// made from within it on the same goroutine.
+func extendsStack(stack, resolution []uintptr) bool {
+	return len(stack) > len(resolution) && slices.Equal(stack[len(stack)-len(resolution):], resolution)
+}
+
+// Resolved reports whether the value has already been materialized.
+func (l *Lazy[T]) Resolved() bool {
+	if l == nil {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.resolved
+}
+
+type lazyTarget interface {
+	wrapLazy(value reflect.Value) (reflect.Value, bool)
+}
+
+// wrapLazy lets Override assign plain values to Lazy fields.
+func (l *Lazy[T]) wrapLazy(value reflect.Value) (reflect.Value, bool) {
+	elemType := reflect.TypeFor[T]()
+
+	switch {
+	case value.Type().AssignableTo(elemType):
+	case value.Type().ConvertibleTo(elemType):
+		value = value.Convert(elemType)
+	default:
+		return reflect.Value{}, false
+	}
+
+	resolved, ok := value.Interface().(T)
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	return reflect.ValueOf(LazyValue(resolved)), true
+}
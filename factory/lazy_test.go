@@ -0,0 +1,163 @@
+package factory
+
+import "testing"
+
+type lazyUser struct {
+	Name         string
+	Organization *lazyOrganization
+}
+
+type lazyOrganization struct {
+	Name  string
+	owner *Lazy[*lazyUser]
+}
+
+type lazyUserProps struct {
+	Name         string
+	Organization *Lazy[*lazyOrganization]
+}
+
+type lazyUserFactory struct{}
+
+func (f *lazyUserFactory) Instantiate(props lazyUserProps) *lazyUser {
+	return &lazyUser{Name: props.Name, Organization: props.Organization.Get()}
+}
+
+func (f *lazyUserFactory) Prepare(overrides Partial[lazyUserProps], seed int64) lazyUserProps {
+	props := lazyUserProps{
+		Name: "user",
+		Organization: NewLazy(func() *lazyOrganization {
+			return &lazyOrganization{
+				Name: "org",
+				owner: NewLazy(func() *lazyUser {
+					return Builder(f).BuildWith(seed, nil)
+				}),
+			}
+		}),
+	}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *lazyUserFactory) Retrieve(instance *lazyUser) lazyUserProps {
+	return lazyUserProps{Name: instance.Name, Organization: LazyValue(instance.Organization)}
+}
+
+func TestLazyResolvesOnce(t *testing.T) {
+	calls := 0
+	lazy := NewLazy(func() int {
+		calls++
+		return 42
+	})
+
+	if lazy.Resolved() {
+		t.Fatal("expected lazy to be unresolved before Get")
+	}
+	if lazy.Get() != 42 || lazy.Get() != 42 {
+		t.Fatal("expected resolved value 42")
+	}
+	if calls != 1 {
+		t.Fatalf("expected one resolution, got %d", calls)
+	}
+	if !lazy.Resolved() {
+		t.Fatal("expected lazy to be resolved after Get")
+	}
+}
+
+func TestLazyNilReturnsZero(t *testing.T) {
+	var lazy *Lazy[string]
+	if lazy.Get() != "" {
+		t.Fatal("expected zero value from nil lazy")
+	}
+}
+
+func TestLazyCircularAssociationResolvedOnDemand(t *testing.T) {
+	builder := Builder(&lazyUserFactory{})
+
+	user := builder.BuildWith(1, nil)
+	if user.Organization == nil || user.Organization.Name != "org" {
+		t.Fatalf("expected organization to be resolved, got %+v", user.Organization)
+	}
+	if user.Organization.owner.Resolved() {
+		t.Fatal("expected owner to stay unresolved until accessed")
+	}
+
+	owner := user.Organization.owner.Get()
+	if owner.Name != "user" {
+		t.Fatalf("expected owner to be built on demand, got %+v", owner)
+	}
+}
+
+func TestLazyDetectsReentrantResolution(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic for re-entrant resolution")
+		}
+	}()
+
+	var lazy *Lazy[int]
+	lazy = NewLazy(func() int {
+		return lazy.Get() + 1
+	})
+	lazy.Get()
+}
+
+func TestOverrideAssignsPlainValueToLazyField(t *testing.T) {
+	builder := Builder(&lazyUserFactory{})
+	organization := &lazyOrganization{Name: "custom"}
+
+	user := builder.Build(Override[lazyUserProps](map[string]any{
+		"Organization": organization,
+	}))
+
+	if user.Organization != organization {
+		t.Fatalf("expected overridden organization, got %+v", user.Organization)
+	}
+}
+
+func TestLazyConcurrentGetWaitsForResolution(t *testing.T) {
+	release := make(chan struct{})
+	calls := 0
+	lazy := NewLazy(func() int {
+		calls++
+		<-release
+		return 7
+	})
+
+	results := make(chan int, 8)
+	for range 8 {
+		go func() { results <- lazy.Get() }()
+	}
+	close(release)
+
+	for range 8 {
+		if value := <-results; value != 7 {
+			t.Fatalf("expected every caller to see 7, got %d", value)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single resolution, got %d", calls)
+	}
+}
+
+func TestLazyRetriesAfterPanickingResolution(t *testing.T) {
+	attempts := 0
+	lazy := NewLazy(func() int {
+		attempts++
+		if attempts == 1 {
+			panic("transient")
+		}
+		return attempts
+	})
+
+	func() {
+		defer func() { _ = recover() }()
+		lazy.Get()
+	}()
+
+	if value := lazy.Get(); value != 2 {
+		t.Fatalf("expected the second resolution to succeed, got %d", value)
+	}
+}
@@ -0,0 +1,122 @@
+package factory
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// lifecycleStages lists the recognised lifecycle stages in chronological order, with the field
+// names detected when no forge tag is present.
+var lifecycleStages = []struct {
+	tag   string
+	names []string
+}{
+	{tag: "created", names: []string{"createdat", "created"}},
+	{tag: "updated", names: []string{"updatedat", "updated", "modifiedat"}},
+	{tag: "deleted", names: []string{"deletedat", "deleted"}},
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// Lifecycle returns a Constraint keeping lifecycle timestamps ordered as
+// CreatedAt <= UpdatedAt <= DeletedAt. Fields are detected by name (CreatedAt, UpdatedAt or
+// ModifiedAt, DeletedAt, case-insensitively) or by a `forge:"created"`, `forge:"updated"` or
+// `forge:"deleted"` tag; they may be time.Time or *time.Time, and nil or zero timestamps are
+// ignored. When an override breaks the order, the timestamps it did not touch follow it:
+//
+//	Constrain(overrides, Lifecycle[UserProperties]())(&properties)
+func Lifecycle[P any]() Constraint[P] {
+	indexes := lifecycleFields(reflect.TypeFor[P]())
+
+	return func(before, after *P) {
+		beforeValue := reflect.ValueOf(before).Elem()
+		afterValue := reflect.ValueOf(after).Elem()
+
+		var fields []reflect.Value
+		var stamps []time.Time
+		var changed []bool
+		for _, index := range indexes {
+			field := exposeField(afterValue.Field(index))
+			stamp, ok := lifecycleTime(field)
+			if !ok || stamp.IsZero() {
+				continue
+			}
+
+			previous, ok := lifecycleTime(exposeField(beforeValue.Field(index)))
+			fields = append(fields, field)
+			stamps = append(stamps, stamp)
+			changed = append(changed, !ok || !previous.Equal(stamp))
+		}
+
+		for index := 1; index < len(stamps); index++ {
+			if stamps[index].Before(stamps[index-1]) && (!changed[index] || changed[index-1]) {
+				stamps[index] = stamps[index-1]
+				setLifecycleTime(fields[index], stamps[index])
+			}
+		}
+		for index := len(stamps) - 2; index >= 0; index-- {
+			if stamps[index].After(stamps[index+1]) && !changed[index] {
+				stamps[index] = stamps[index+1]
+				setLifecycleTime(fields[index], stamps[index])
+			}
+		}
+	}
+}
+
+// lifecycleFields returns the indexes of the lifecycle fields of structType in stage order.
+func lifecycleFields(structType reflect.Type) []int {
+	if structType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var indexes []int
+	for _, stage := range lifecycleStages {
+		for index := range structType.NumField() {
+			field := structType.Field(index)
+			if field.Type != timeType && field.Type != reflect.PointerTo(timeType) {
+				continue
+			}
+
+			tag, tagged := field.Tag.Lookup("forge")
+			if (tagged && tag == stage.tag) || (!tagged && containsName(stage.names, field.Name)) {
+				indexes = append(indexes, index)
+				break
+			}
+		}
+	}
+
+	return indexes
+}
+
+func containsName(names []string, name string) bool {
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// lifecycleTime returns the timestamp held by field; ok is false for a nil pointer.
+func lifecycleTime(field reflect.Value) (stamp time.Time, ok bool) {
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return time.Time{}, false
+		}
+		field = field.Elem()
+	}
+
+	stamp, ok = field.Interface().(time.Time)
+	return stamp, ok
+}
+
+// setLifecycleTime stores stamp in field, allocating a new pointer for *time.Time fields so
+// timestamps shared with other values are never mutated.
+func setLifecycleTime(field reflect.Value, stamp time.Time) {
+	if field.Kind() == reflect.Pointer {
+		field.Set(reflect.ValueOf(&stamp))
+		return
+	}
+	field.Set(reflect.ValueOf(stamp))
+}
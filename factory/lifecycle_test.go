@@ -0,0 +1,95 @@
+package factory
+
+import (
+	"testing"
+	"time"
+)
+
+type lifecycleProps struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type taggedLifecycleProps struct {
+	Opened time.Time `forge:"created"`
+	Closed time.Time `forge:"deleted"`
+}
+
+var lifecycleBase = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+type lifecycleFactory struct{}
+
+func (f *lifecycleFactory) Instantiate(props lifecycleProps) lifecycleProps {
+	return props
+}
+
+func (f *lifecycleFactory) Prepare(overrides Partial[lifecycleProps], _ int64) lifecycleProps {
+	deleted := lifecycleBase.Add(48 * time.Hour)
+	props := lifecycleProps{
+		CreatedAt: lifecycleBase,
+		UpdatedAt: lifecycleBase.Add(24 * time.Hour),
+		DeletedAt: &deleted,
+	}
+
+	Constrain(overrides, Lifecycle[lifecycleProps]())(&props)
+
+	return props
+}
+
+func (f *lifecycleFactory) Retrieve(instance lifecycleProps) lifecycleProps {
+	return instance
+}
+
+func TestLifecycleMovesLaterStampsAfterOverriddenCreation(t *testing.T) {
+	created := lifecycleBase.AddDate(0, 1, 0)
+
+	props := Builder(&lifecycleFactory{}).Build(Override[lifecycleProps](map[string]any{"CreatedAt": created}))
+
+	if !props.UpdatedAt.Equal(created) || !props.DeletedAt.Equal(created) {
+		t.Fatalf("expected later stamps to follow creation, got %+v / %s", props, props.DeletedAt)
+	}
+}
+
+func TestLifecycleMovesEarlierStampsBeforeOverriddenDeletion(t *testing.T) {
+	deleted := lifecycleBase.AddDate(0, -1, 0)
+
+	props := Builder(&lifecycleFactory{}).Build(Override[lifecycleProps](map[string]any{"DeletedAt": &deleted}))
+
+	if !props.CreatedAt.Equal(deleted) || !props.UpdatedAt.Equal(deleted) {
+		t.Fatalf("expected earlier stamps to precede deletion, got %+v", props)
+	}
+}
+
+func TestLifecycleIgnoresNilDeletion(t *testing.T) {
+	updated := lifecycleBase.Add(-time.Hour)
+
+	props := Builder(&lifecycleFactory{}).Build(Override[lifecycleProps](map[string]any{
+		"UpdatedAt": updated,
+		"DeletedAt": nil,
+	}))
+
+	if props.DeletedAt != nil || !props.CreatedAt.Equal(updated) {
+		t.Fatalf("expected creation to move before the update, got %+v", props)
+	}
+}
+
+func TestLifecycleKeepsOrderedStamps(t *testing.T) {
+	props := Builder(&lifecycleFactory{}).Build(nil)
+
+	if !props.CreatedAt.Equal(lifecycleBase) || !props.UpdatedAt.Equal(lifecycleBase.Add(24*time.Hour)) {
+		t.Fatalf("expected untouched stamps, got %+v", props)
+	}
+}
+
+func TestLifecycleDetectsTags(t *testing.T) {
+	before := taggedLifecycleProps{Opened: lifecycleBase, Closed: lifecycleBase.Add(time.Hour)}
+	after := before
+	after.Opened = lifecycleBase.Add(2 * time.Hour)
+
+	Lifecycle[taggedLifecycleProps]()(&before, &after)
+
+	if !after.Closed.Equal(after.Opened) {
+		t.Fatalf("expected tagged closing stamp to follow opening, got %+v", after)
+	}
+}
@@ -0,0 +1,48 @@
+package factory
+
+import "sync/atomic"
+
+// Locale identifies the language/region used by locale-aware factories.
+type Locale string
+
+// Supported locales for locale-aware factories.
+const (
+	LocaleEnglish  Locale = "en"
+	LocaleJapanese Locale = "ja"
+	LocaleGerman   Locale = "de"
+)
+
+// DefaultLocale is used until SetLocale is called.
+const DefaultLocale = LocaleEnglish
+
+var globalLocale atomic.Value
+
+// SetLocale switches the locale used by every locale-aware factory that has no explicit locale.
+// An empty locale restores DefaultLocale.
+func SetLocale(locale Locale) {
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	globalLocale.Store(locale)
+}
+
+// CurrentLocale returns the globally configured locale.
+func CurrentLocale() Locale {
+	if locale, ok := globalLocale.Load().(Locale); ok {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// Localizable is implemented by factories whose output depends on a Locale.
+// Localized must return a copy of the factory bound to locale, leaving the receiver untouched.
+type Localizable[T any, P any] interface {
+	Localized(locale Locale) Factory[T, P]
+}
+
+func resolveLocale(locale Locale) Locale {
+	if locale == "" {
+		return CurrentLocale()
+	}
+	return locale
+}
@@ -0,0 +1,76 @@
+package factory
+
+import (
+	"slices"
+	"strings"
+)
+
+const localeTagSalt = 0x0BC_9047
+
+// localeTags are common BCP 47 language tags with a region or script subtag.
+var localeTags = []string{
+	"ar-EG", "ar-SA", "bn-BD", "cs-CZ", "da-DK", "de-AT", "de-CH", "de-DE", "el-GR", "en-AU",
+	"en-CA", "en-GB", "en-IE", "en-IN", "en-NZ", "en-US", "en-ZA", "es-AR", "es-ES", "es-MX",
+	"fa-IR", "fi-FI", "fr-BE", "fr-CA", "fr-CH", "fr-FR", "he-IL", "hi-IN", "hu-HU", "id-ID",
+	"it-IT", "ja-JP", "ko-KR", "ms-MY", "nb-NO", "nl-BE", "nl-NL", "pl-PL", "pt-BR", "pt-PT",
+	"ro-RO", "ru-RU", "sk-SK", "sr-Latn-RS", "sv-SE", "th-TH", "tr-TR", "uk-UA", "vi-VN",
+	"zh-Hans-CN", "zh-Hant-HK", "zh-Hant-TW",
+}
+
+// LocaleTagProperties carries the chosen tag and exclusions for LocaleFactory.
+type LocaleTagProperties struct {
+	tag        string
+	exclusions []string
+}
+
+// LocaleFactory picks BCP 47 language tags such as "en-US", "pt-BR" or "zh-Hant-TW" from a
+// bundled list of common locales. Tags replaces the candidates when set; Exclude removes a tag,
+// or every tag of a language when given a bare language subtag such as "en", and is the default
+// of the "exclusions" property, which overrides replace. Matching is case-insensitive.
+type LocaleFactory struct {
+	Tags    []string
+	Exclude []string
+}
+
+// Instantiate returns the chosen tag.
+func (f *LocaleFactory) Instantiate(properties LocaleTagProperties) string {
+	return properties.tag
+}
+
+// Prepare applies overrides and exclusions before choosing a tag.
+func (f *LocaleFactory) Prepare(overrides Partial[LocaleTagProperties], seed int64) LocaleTagProperties {
+	properties := LocaleTagProperties{
+		exclusions: slices.Clone(f.Exclude),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.tag == "" {
+		tags := f.Tags
+		if len(tags) == 0 {
+			tags = localeTags
+		}
+
+		candidates := slices.DeleteFunc(slices.Clone(tags), func(tag string) bool {
+			return slices.ContainsFunc(properties.exclusions, func(excluded string) bool {
+				return strings.EqualFold(tag, excluded) || strings.EqualFold(strings.SplitN(tag, "-", 2)[0], excluded)
+			})
+		})
+		if len(candidates) == 0 {
+			panic("locale: no candidates available after exclusions")
+		}
+		properties.tag = candidates[IntAt(seed^localeTagSalt, 0, int64(len(candidates)-1))]
+	}
+
+	return properties
+}
+
+// Retrieve wraps an existing tag into LocaleTagProperties.
+func (f *LocaleFactory) Retrieve(instance string) LocaleTagProperties {
+	return LocaleTagProperties{
+		tag:        instance,
+		exclusions: []string{},
+	}
+}
@@ -0,0 +1,34 @@
+package factory
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestLocaleFactoryTags(t *testing.T) {
+	tag := regexp.MustCompile(`^[a-z]{2}(-[A-Z][a-z]{3})?-[A-Z]{2}$`)
+
+	for _, value := range Builder(&LocaleFactory{}).BuildList(200, nil) {
+		if !tag.MatchString(value) {
+			t.Fatalf("unexpected tag %q", value)
+		}
+	}
+}
+
+func TestLocaleFactoryExcludesLanguages(t *testing.T) {
+	builder := Builder(&LocaleFactory{Exclude: []string{"en", "ja-JP"}})
+
+	for _, value := range builder.BuildList(300, nil) {
+		if strings.HasPrefix(value, "en-") || value == "ja-JP" {
+			t.Fatalf("expected %q to be excluded", value)
+		}
+	}
+
+	only := Builder(&LocaleFactory{Tags: []string{"en-US", "fr-FR"}})
+	for _, value := range only.BuildList(20, Override[LocaleTagProperties](map[string]any{"exclusions": []string{"en-us"}})) {
+		if value != "fr-FR" {
+			t.Fatalf("expected fr-FR, got %q", value)
+		}
+	}
+}
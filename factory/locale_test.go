@@ -0,0 +1,87 @@
+package factory
+
+import "testing"
+
+type greetingFactory struct {
+	Locale Locale
+}
+
+func (f *greetingFactory) Instantiate(props stubProps) string {
+	return props.Value
+}
+
+func (f *greetingFactory) Prepare(overrides Partial[stubProps], seed int64) stubProps {
+	props := stubProps{Value: "hello", Seed: seed}
+	if resolveLocale(f.Locale) == LocaleJapanese {
+		props.Value = "こんにちは"
+	}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *greetingFactory) Retrieve(instance string) stubProps {
+	return stubProps{Value: instance}
+}
+
+func (f *greetingFactory) Localized(locale Locale) Factory[string, stubProps] {
+	localized := *f
+	localized.Locale = locale
+	return &localized
+}
+
+func restoreLocale(t *testing.T) {
+	t.Helper()
+	previous := CurrentLocale()
+	t.Cleanup(func() {
+		SetLocale(previous)
+	})
+}
+
+func TestCurrentLocaleDefaultsToEnglish(t *testing.T) {
+	restoreLocale(t)
+	SetLocale("")
+
+	if CurrentLocale() != DefaultLocale {
+		t.Fatalf("expected default locale, got %s", CurrentLocale())
+	}
+}
+
+func TestSetLocaleAffectsFactories(t *testing.T) {
+	restoreLocale(t)
+	builder := Builder(&greetingFactory{})
+
+	if value := builder.Build(nil); value != "hello" {
+		t.Fatalf("expected english greeting, got %s", value)
+	}
+
+	SetLocale("ja")
+
+	if value := builder.Build(nil); value != "こんにちは" {
+		t.Fatalf("expected japanese greeting, got %s", value)
+	}
+}
+
+func TestWithLocaleOverridesGlobalLocale(t *testing.T) {
+	restoreLocale(t)
+	SetLocale(LocaleEnglish)
+
+	base := &greetingFactory{}
+	builder := Builder(base, WithLocale(LocaleJapanese))
+
+	if value := builder.Build(nil); value != "こんにちは" {
+		t.Fatalf("expected japanese greeting, got %s", value)
+	}
+	if base.Locale != "" {
+		t.Fatalf("expected original factory to remain unbound, got %s", base.Locale)
+	}
+}
+
+func TestWithLocaleIgnoredForNonLocalizableFactory(t *testing.T) {
+	builder := Builder(&stubFactory{}, WithLocale(LocaleJapanese))
+
+	if result := builder.BuildWith(1, nil); result.Value != "seed-1" {
+		t.Fatalf("expected unchanged output, got %s", result.Value)
+	}
+}
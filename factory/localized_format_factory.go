@@ -0,0 +1,219 @@
+package factory
+
+import (
+	stdmath "math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultLocalizedNumberMax = 1_000_000
+
+var (
+	defaultLocalizedDateStart = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	defaultLocalizedDateEnd   = time.Date(2030, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// numberSeparators returns the grouping and decimal separators used by locale.
+func numberSeparators(locale Locale) (group, decimal string) {
+	switch locale {
+	case LocaleGerman:
+		return ".", ","
+	default:
+		return ",", "."
+	}
+}
+
+// formatLocalizedNumber formats value with decimals fraction digits and locale separators.
+func formatLocalizedNumber(value float64, decimals int, locale Locale) string {
+	group, decimal := numberSeparators(locale)
+
+	formatted := strconv.FormatFloat(stdmath.Abs(value), 'f', decimals, 64)
+	integer, fraction, _ := strings.Cut(formatted, ".")
+
+	var builder strings.Builder
+	if value < 0 && strings.Trim(formatted, "0.") != "" {
+		builder.WriteByte('-')
+	}
+	for index, digit := range integer {
+		if index > 0 && (len(integer)-index)%3 == 0 {
+			builder.WriteString(group)
+		}
+		builder.WriteRune(digit)
+	}
+	if fraction != "" {
+		builder.WriteString(decimal)
+		builder.WriteString(fraction)
+	}
+
+	return builder.String()
+}
+
+// formatLocalizedDate formats date the way locale writes calendar dates.
+func formatLocalizedDate(date time.Time, locale Locale) string {
+	switch locale {
+	case LocaleJapanese:
+		return date.Format("2006年1月2日")
+	case LocaleGerman:
+		return date.Format("02.01.2006")
+	default:
+		return date.Format("January 2, 2006")
+	}
+}
+
+// LocalizedNumberProperties carries configuration and the generated number for LocalizedNumberFactory.
+type LocalizedNumberProperties struct {
+	value    float64
+	min      float64
+	max      float64
+	decimals int
+	locale   Locale
+	text     string
+}
+
+// LocalizedNumberFactory generates numbers formatted for a locale, such as "1,234.56" or
+// "1.234,56", for testing parsers and renderers of localized input. Max defaults to 1,000,000
+// and Decimals is the number of fraction digits.
+type LocalizedNumberFactory struct {
+	Min      float64
+	Max      float64
+	Decimals int
+
+	locale Locale
+}
+
+// Localized returns a copy of the factory bound to locale.
+func (f *LocalizedNumberFactory) Localized(locale Locale) Factory[string, LocalizedNumberProperties] {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Instantiate returns the formatted number.
+func (f *LocalizedNumberFactory) Instantiate(properties LocalizedNumberProperties) string {
+	return properties.text
+}
+
+// Prepare generates a number within range and formats it; a non-zero value or text from overrides is kept.
+func (f *LocalizedNumberFactory) Prepare(overrides Partial[LocalizedNumberProperties], seed int64) LocalizedNumberProperties {
+	properties := LocalizedNumberProperties{
+		min:      f.Min,
+		max:      f.Max,
+		decimals: f.Decimals,
+		locale:   f.locale,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.max == 0 {
+		properties.max = defaultLocalizedNumberMax
+	}
+	if properties.decimals < 0 {
+		properties.decimals = 0
+	}
+	properties.locale = resolveLocale(properties.locale)
+
+	if properties.value == 0 {
+		properties.value = FloatAt(seed, properties.min, properties.max)
+	}
+	if properties.text == "" {
+		properties.text = formatLocalizedNumber(properties.value, properties.decimals, properties.locale)
+	}
+
+	return properties
+}
+
+// Retrieve converts a formatted number back into LocalizedNumberProperties.
+func (f *LocalizedNumberFactory) Retrieve(instance string) LocalizedNumberProperties {
+	return LocalizedNumberProperties{
+		text:   instance,
+		locale: f.locale,
+	}
+}
+
+// LocalizedDateProperties carries configuration and the generated date for LocalizedDateFactory.
+type LocalizedDateProperties struct {
+	date   time.Time
+	start  time.Time
+	end    time.Time
+	locale Locale
+	text   string
+}
+
+// LocalizedDateFactory generates calendar dates formatted for a locale, such as "January 2, 2024"
+// or "2024年1月2日". Dates fall between Start and End, which default to 2000-01-01 and 2030-12-31.
+type LocalizedDateFactory struct {
+	Start time.Time
+	End   time.Time
+
+	locale Locale
+	anchor time.Time
+}
+
+// Anchored returns a copy of the factory that picks dates within 30 days before anchor unless
+// Start or End is configured or overridden.
+func (f *LocalizedDateFactory) Anchored(anchor time.Time) Factory[string, LocalizedDateProperties] {
+	anchored := *f
+	anchored.anchor = anchor
+	return &anchored
+}
+
+// Localized returns a copy of the factory bound to locale.
+func (f *LocalizedDateFactory) Localized(locale Locale) Factory[string, LocalizedDateProperties] {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Instantiate returns the formatted date.
+func (f *LocalizedDateFactory) Instantiate(properties LocalizedDateProperties) string {
+	return properties.text
+}
+
+// Prepare picks a day within range and formats it; a non-zero date or text from overrides is kept.
+func (f *LocalizedDateFactory) Prepare(overrides Partial[LocalizedDateProperties], seed int64) LocalizedDateProperties {
+	properties := LocalizedDateProperties{
+		start:  f.Start,
+		end:    f.End,
+		locale: f.locale,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.start.IsZero() && properties.end.IsZero() && !f.anchor.IsZero() {
+		properties.start = f.anchor.Add(-defaultAnchorWindow)
+		properties.end = f.anchor
+	}
+	if properties.start.IsZero() {
+		properties.start = defaultLocalizedDateStart
+	}
+	if properties.end.IsZero() {
+		properties.end = defaultLocalizedDateEnd
+	}
+	if properties.end.Before(properties.start) {
+		properties.end = properties.start
+	}
+	properties.locale = resolveLocale(properties.locale)
+
+	if properties.date.IsZero() {
+		days := int64(properties.end.Sub(properties.start) / (24 * time.Hour))
+		properties.date = properties.start.AddDate(0, 0, int(IntAt(seed, 0, days)))
+	}
+	if properties.text == "" {
+		properties.text = formatLocalizedDate(properties.date, properties.locale)
+	}
+
+	return properties
+}
+
+// Retrieve converts a formatted date back into LocalizedDateProperties.
+func (f *LocalizedDateFactory) Retrieve(instance string) LocalizedDateProperties {
+	return LocalizedDateProperties{
+		text:   instance,
+		locale: f.locale,
+	}
+}
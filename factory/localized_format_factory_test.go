@@ -0,0 +1,91 @@
+package factory
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestFormatLocalizedNumber(t *testing.T) {
+	cases := []struct {
+		value    float64
+		decimals int
+		locale   Locale
+		expected string
+	}{
+		{1234.56, 2, LocaleEnglish, "1,234.56"},
+		{1234.56, 2, LocaleGerman, "1.234,56"},
+		{1234.56, 2, LocaleJapanese, "1,234.56"},
+		{1234567, 0, LocaleEnglish, "1,234,567"},
+		{-987654.321, 1, LocaleGerman, "-987.654,3"},
+		{999, 0, LocaleEnglish, "999"},
+		{-0.001, 2, LocaleEnglish, "0.00"},
+	}
+
+	for _, test := range cases {
+		if got := formatLocalizedNumber(test.value, test.decimals, test.locale); got != test.expected {
+			t.Errorf("formatLocalizedNumber(%v, %d, %s) = %q, expected %q", test.value, test.decimals, test.locale, got, test.expected)
+		}
+	}
+}
+
+func TestFormatLocalizedDate(t *testing.T) {
+	date := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	cases := map[Locale]string{
+		LocaleEnglish:  "January 2, 2024",
+		LocaleJapanese: "2024年1月2日",
+		LocaleGerman:   "02.01.2024",
+	}
+
+	for locale, expected := range cases {
+		if got := formatLocalizedDate(date, locale); got != expected {
+			t.Errorf("formatLocalizedDate(%s) = %q, expected %q", locale, got, expected)
+		}
+	}
+}
+
+func TestLocalizedNumberFactoryUsesBuilderLocale(t *testing.T) {
+	builder := Builder(&LocalizedNumberFactory{Min: 1000, Max: 9999, Decimals: 2}, WithLocale(LocaleGerman))
+	pattern := regexp.MustCompile(`^\d\.\d{3},\d{2}$`)
+
+	for _, value := range builder.BuildList(20, nil) {
+		if !pattern.MatchString(value) {
+			t.Fatalf("expected German formatting, got %q", value)
+		}
+	}
+}
+
+func TestLocalizedNumberFactoryFormatsOverriddenValue(t *testing.T) {
+	builder := Builder(&LocalizedNumberFactory{Decimals: 2}, WithLocale(LocaleEnglish))
+
+	value := builder.Build(Override[LocalizedNumberProperties](map[string]any{"value": 1234.5}))
+
+	if value != "1,234.50" {
+		t.Fatalf("expected formatted override, got %q", value)
+	}
+}
+
+func TestLocalizedDateFactoryFollowsGlobalLocale(t *testing.T) {
+	SetLocale(LocaleJapanese)
+	t.Cleanup(func() { SetLocale("") })
+
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	builder := Builder(&LocalizedDateFactory{Start: start, End: start.AddDate(0, 0, 10)})
+	pattern := regexp.MustCompile(`^2024年3月(\d|1[01])日$`)
+
+	for _, value := range builder.BuildList(20, nil) {
+		if !pattern.MatchString(value) {
+			t.Fatalf("expected Japanese date in range, got %q", value)
+		}
+	}
+}
+
+func TestLocalizedFactoriesLeaveReceiverUntouched(t *testing.T) {
+	factory := &LocalizedDateFactory{}
+	factory.Localized(LocaleGerman)
+
+	if factory.locale != "" {
+		t.Fatal("expected Localized to return a copy")
+	}
+}
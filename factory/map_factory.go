@@ -1,5 +1,7 @@
 package factory
 
+import "slices"
+
 // MapEntry represents a single key/value pair in MapFactory output.
 type MapEntry[K comparable, V any] struct {
 	Key   K
@@ -15,6 +17,7 @@ type MapProperties[K comparable, V any] struct {
 type MapFactory[K comparable, KP any, V any, VP any] struct {
 	keyFactory   Factory[K, KP]
 	valueFactory Factory[V, VP]
+	compareKeys  func(a, b K) int
 }
 
 // NewMapFactory wires key and value factories into a MapFactory.
@@ -28,6 +31,17 @@ func NewMapFactory[K comparable, KP any, V any, VP any](
 	}
 }
 
+// WithKeyOrder returns a copy of the factory that keeps prepared and retrieved entries sorted by
+// compare (e.g. cmp.Compare[string]), so properties snapshots and fixture dumps diff cleanly. The
+// order only affects properties and snapshots; instantiated maps are unordered as ever. Entries
+// with equal keys keep their relative order, so the last one still wins on Instantiate. Entries
+// set through overrides are sorted as a copy, leaving the caller's slice untouched.
+func (f *MapFactory[K, KP, V, VP]) WithKeyOrder(compare func(a, b K) int) *MapFactory[K, KP, V, VP] {
+	ordered := *f
+	ordered.compareKeys = compare
+	return &ordered
+}
+
 // Instantiate converts prepared map properties into a concrete map.
 func (f *MapFactory[K, KP, V, VP]) Instantiate(properties MapProperties[K, V]) map[K]V {
 	result := make(map[K]V, len(properties.entries))
@@ -45,8 +59,8 @@ func (f *MapFactory[K, KP, V, VP]) Prepare(overrides Partial[MapProperties[K, V]
 	entries := make([]MapEntry[K, V], count)
 
 	for index := range count {
-		keyInstance := create(f.keyFactory, nil, seed+int64(index))
-		valueInstance := create(f.valueFactory, nil, seed+int64(index))
+		keyInstance := create(f.keyFactory, Overrider[KP]{}, seed+int64(index))
+		valueInstance := create(f.valueFactory, Overrider[VP]{}, seed+int64(index))
 
 		entries[index] = MapEntry[K, V]{
 			Key:   keyInstance,
@@ -62,6 +76,8 @@ func (f *MapFactory[K, KP, V, VP]) Prepare(overrides Partial[MapProperties[K, V]
 		overrides(&properties)
 	}
 
+	properties.entries = f.sortedEntries(properties.entries)
+
 	return properties
 }
 
@@ -76,6 +92,20 @@ func (f *MapFactory[K, KP, V, VP]) Retrieve(instance map[K]V) MapProperties[K, V
 	}
 
 	return MapProperties[K, V]{
-		entries: entries,
+		entries: f.sortedEntries(entries),
 	}
 }
+
+// sortedEntries returns entries sorted by key order, sorting a clone so slices owned by callers are
+// never reordered.
+func (f *MapFactory[K, KP, V, VP]) sortedEntries(entries []MapEntry[K, V]) []MapEntry[K, V] {
+	if f.compareKeys == nil {
+		return entries
+	}
+
+	sorted := slices.Clone(entries)
+	slices.SortStableFunc(sorted, func(a, b MapEntry[K, V]) int {
+		return f.compareKeys(a.Key, b.Key)
+	})
+	return sorted
+}
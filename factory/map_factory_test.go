@@ -1,21 +1,23 @@
 package factory
 
 import (
+	"cmp"
+	"slices"
 	"testing"
 )
 
-type IntProperties struct {
+type seedIntProperties struct {
 	Value int
 }
 
-type IntFactory struct{}
+type seedIntFactory struct{}
 
-func (f *IntFactory) Instantiate(properties IntProperties) int {
+func (f *seedIntFactory) Instantiate(properties seedIntProperties) int {
 	return properties.Value
 }
 
-func (f *IntFactory) Prepare(overrides Partial[IntProperties], seed int64) IntProperties {
-	properties := IntProperties{
+func (f *seedIntFactory) Prepare(overrides Partial[seedIntProperties], seed int64) seedIntProperties {
+	properties := seedIntProperties{
 		Value: int(seed),
 	}
 
@@ -26,14 +28,14 @@ func (f *IntFactory) Prepare(overrides Partial[IntProperties], seed int64) IntPr
 	return properties
 }
 
-func (f *IntFactory) Retrieve(instance int) IntProperties {
-	return IntProperties{
+func (f *seedIntFactory) Retrieve(instance int) seedIntProperties {
+	return seedIntProperties{
 		Value: instance,
 	}
 }
 
 func TestNewMapFactory(t *testing.T) {
-	keyFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
 	valueFactory := &StringFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
@@ -52,8 +54,8 @@ func TestNewMapFactory(t *testing.T) {
 }
 
 func TestMapFactoryInstantiate(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -85,8 +87,8 @@ func TestMapFactoryInstantiate(t *testing.T) {
 }
 
 func TestMapFactoryInstantiateEmpty(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -100,8 +102,8 @@ func TestMapFactoryInstantiateEmpty(t *testing.T) {
 }
 
 func TestMapFactoryPrepare(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -113,8 +115,8 @@ func TestMapFactoryPrepare(t *testing.T) {
 }
 
 func TestMapFactoryPrepareDeterministic(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -137,8 +139,8 @@ func TestMapFactoryPrepareDeterministic(t *testing.T) {
 }
 
 func TestMapFactoryPrepareVariousSizes(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -161,8 +163,8 @@ func TestMapFactoryPrepareVariousSizes(t *testing.T) {
 }
 
 func TestMapFactoryPrepareWithOverrides(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -188,8 +190,8 @@ func TestMapFactoryPrepareWithOverrides(t *testing.T) {
 }
 
 func TestMapFactoryRetrieve(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -224,8 +226,8 @@ func TestMapFactoryRetrieve(t *testing.T) {
 }
 
 func TestMapFactoryRetrieveEmpty(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -239,8 +241,8 @@ func TestMapFactoryRetrieveEmpty(t *testing.T) {
 }
 
 func TestMapFactoryWithBuilder(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -254,8 +256,8 @@ func TestMapFactoryWithBuilder(t *testing.T) {
 }
 
 func TestMapFactoryBuildWith(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -277,8 +279,8 @@ func TestMapFactoryBuildWith(t *testing.T) {
 }
 
 func TestMapFactoryBuildList(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -301,7 +303,7 @@ func TestMapFactoryWithStringKeys(t *testing.T) {
 	minimum := 5
 	maximum := 10
 	keyFactory := &StringFactory{Min: minimum, Max: maximum}
-	valueFactory := &IntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -323,7 +325,7 @@ func TestMapFactoryWithStringKeys(t *testing.T) {
 func TestMapFactoryWithStringValues(t *testing.T) {
 	minimum := 3
 	maximum := 8
-	keyFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
 	valueFactory := &StringFactory{Min: minimum, Max: maximum}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
@@ -344,8 +346,8 @@ func TestMapFactoryWithStringValues(t *testing.T) {
 }
 
 func TestMapFactoryPrepareEntryCount(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 
@@ -371,8 +373,8 @@ func TestMapFactoryPrepareEntryCount(t *testing.T) {
 }
 
 func TestMapFactoryWithOverrideLiteral(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 	builder := Builder(mapFactory)
@@ -400,8 +402,8 @@ func TestMapFactoryWithOverrideLiteral(t *testing.T) {
 }
 
 func TestMapFactoryWithLiteralAndFuncOverrides(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 	builder := Builder(mapFactory)
@@ -427,8 +429,8 @@ func TestMapFactoryWithLiteralAndFuncOverrides(t *testing.T) {
 }
 
 func TestMapFactoryWithInlineOverride(t *testing.T) {
-	keyFactory := &IntFactory{}
-	valueFactory := &IntFactory{}
+	keyFactory := &seedIntFactory{}
+	valueFactory := &seedIntFactory{}
 
 	mapFactory := NewMapFactory(keyFactory, valueFactory)
 	builder := Builder(mapFactory)
@@ -457,3 +459,37 @@ func TestMapFactoryWithInlineOverride(t *testing.T) {
 		}
 	}
 }
+
+func TestMapFactoryWithKeyOrderSortsEntries(t *testing.T) {
+	base := NewMapFactory(&seedIntFactory{}, &seedIntFactory{})
+	ordered := base.WithKeyOrder(cmp.Compare[int])
+
+	if base.compareKeys != nil {
+		t.Fatal("expected WithKeyOrder to leave the receiver untouched")
+	}
+
+	prepared := ordered.Prepare(Override[MapProperties[int, int]](map[string]any{
+		"entries": []MapEntry[int, int]{{Key: 3, Value: 1}, {Key: 1, Value: 2}, {Key: 2, Value: 3}},
+	}).Func(), 9)
+	if !slices.IsSortedFunc(prepared.entries, func(a, b MapEntry[int, int]) int { return cmp.Compare(a.Key, b.Key) }) {
+		t.Fatalf("expected prepared entries to be sorted, got %v", prepared.entries)
+	}
+
+	retrieved := ordered.Retrieve(map[int]int{5: 0, 1: 0, 4: 0, 2: 0, 3: 0})
+	for index, entry := range retrieved.entries {
+		if entry.Key != index+1 {
+			t.Fatalf("expected retrieved entries in key order, got %v", retrieved.entries)
+		}
+	}
+}
+
+func TestMapFactoryWithKeyOrderLeavesOverrideEntriesUntouched(t *testing.T) {
+	ordered := NewMapFactory(&seedIntFactory{}, &seedIntFactory{}).WithKeyOrder(cmp.Compare[int])
+	entries := []MapEntry[int, int]{{Key: 3, Value: 1}, {Key: 1, Value: 2}}
+
+	ordered.Prepare(Override[MapProperties[int, int]](map[string]any{"entries": entries}).Func(), 9)
+
+	if entries[0].Key != 3 || entries[1].Key != 1 {
+		t.Fatalf("expected the caller's entries to keep their order, got %v", entries)
+	}
+}
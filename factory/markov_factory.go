@@ -0,0 +1,125 @@
+package factory
+
+import (
+	_ "embed"
+	"strings"
+
+	"github.com/lihs-ie/forge/internal/math"
+)
+
+//go:embed data/markov_corpus.txt
+var defaultMarkovCorpus string
+
+const (
+	defaultMarkovMaxWords     = 30
+	defaultMarkovMaxSentences = 3
+)
+
+// MarkovProperties carries configuration and the generated text for MarkovFactory.
+type MarkovProperties struct {
+	value     string
+	sentences int
+	maxWords  int
+}
+
+// MarkovFactory generates sentences from a first-order Markov chain trained on a corpus.
+type MarkovFactory struct {
+	starts      []string
+	transitions map[string][]string
+}
+
+// NewMarkovFactory trains a MarkovFactory on corpus; an empty corpus uses the embedded default.
+func NewMarkovFactory(corpus string) *MarkovFactory {
+	if strings.TrimSpace(corpus) == "" {
+		corpus = defaultMarkovCorpus
+	}
+
+	words := strings.Fields(corpus)
+	factory := &MarkovFactory{
+		transitions: make(map[string][]string),
+	}
+
+	for index, word := range words {
+		if index == 0 || endsSentence(words[index-1]) {
+			factory.starts = append(factory.starts, word)
+		}
+		if index+1 < len(words) {
+			factory.transitions[word] = append(factory.transitions[word], words[index+1])
+		}
+	}
+
+	if len(factory.starts) == 0 {
+		panic("markov: corpus must contain at least one word")
+	}
+
+	return factory
+}
+
+// Instantiate returns the generated text.
+func (f *MarkovFactory) Instantiate(properties MarkovProperties) string {
+	return properties.value
+}
+
+// Prepare walks the chain with the seed to produce the requested number of sentences.
+func (f *MarkovFactory) Prepare(overrides Partial[MarkovProperties], seed int64) MarkovProperties {
+	properties := MarkovProperties{
+		sentences: int(seed%defaultMarkovMaxSentences) + 1,
+		maxWords:  defaultMarkovMaxWords,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.sentences <= 0 {
+		properties.sentences = 1
+	}
+	if properties.maxWords <= 0 {
+		properties.maxWords = defaultMarkovMaxWords
+	}
+
+	if properties.value == "" {
+		sentences := make([]string, properties.sentences)
+		step := seed
+		for index := range properties.sentences {
+			sentences[index], step = f.sentence(step, properties.maxWords)
+		}
+		properties.value = strings.Join(sentences, " ")
+	}
+
+	return properties
+}
+
+// Retrieve converts generated text back into MarkovProperties.
+func (f *MarkovFactory) Retrieve(instance string) MarkovProperties {
+	return MarkovProperties{
+		value: instance,
+	}
+}
+
+func (f *MarkovFactory) sentence(step int64, maxWords int) (string, int64) {
+	word := f.starts[scrambledIndex(step, len(f.starts))]
+	words := []string{word}
+	step++
+
+	for len(words) < maxWords && !endsSentence(word) {
+		next, ok := f.transitions[word]
+		if !ok {
+			break
+		}
+		word = next[scrambledIndex(step, len(next))]
+		words = append(words, word)
+		step++
+	}
+
+	return strings.Join(words, " "), step
+}
+
+func scrambledIndex(step int64, size int) int {
+	//nolint:gosec // G115: Controlled conversion for hash scrambling within expected range
+	return int(math.Scramble(uint32(step)) % uint32(size))
+}
+
+func endsSentence(word string) bool {
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?")
+}
@@ -0,0 +1,80 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkovFactoryDefaultCorpus(t *testing.T) {
+	builder := Builder(NewMarkovFactory(""))
+
+	for seed := range int64(20) {
+		text := builder.BuildWith(seed, nil)
+		if text == "" {
+			t.Fatalf("expected text for seed %d", seed)
+		}
+		first := []rune(text)[0]
+		if strings.ToUpper(string(first)) != string(first) {
+			t.Errorf("expected sentence to start capitalized, got %q", text)
+		}
+	}
+}
+
+func TestMarkovFactoryIsDeterministic(t *testing.T) {
+	builder := Builder(NewMarkovFactory(""))
+
+	if builder.BuildWith(9, nil) != builder.BuildWith(9, nil) {
+		t.Fatal("expected identical text for the same seed")
+	}
+}
+
+func TestMarkovFactoryUsesOnlyCorpusTransitions(t *testing.T) {
+	corpus := "Cats chase mice. Dogs chase cats. Mice eat cheese."
+	builder := Builder(NewMarkovFactory(corpus))
+	allowed := map[string]bool{}
+	words := strings.Fields(corpus)
+	for index := 0; index+1 < len(words); index++ {
+		allowed[words[index]+" "+words[index+1]] = true
+	}
+
+	for seed := range int64(30) {
+		generated := strings.Fields(builder.BuildWith(seed, nil))
+		for index := 0; index+1 < len(generated); index++ {
+			pair := generated[index] + " " + generated[index+1]
+			if !allowed[pair] && !endsSentence(generated[index]) {
+				t.Fatalf("unexpected transition %q", pair)
+			}
+		}
+	}
+}
+
+func TestMarkovFactorySentenceOverride(t *testing.T) {
+	builder := Builder(NewMarkovFactory("One two. Three four. Five six."))
+
+	text := builder.Build(Override[MarkovProperties](map[string]any{
+		"sentences": 4,
+	}))
+
+	count := 0
+	for _, word := range strings.Fields(text) {
+		if endsSentence(word) {
+			count++
+		}
+	}
+	if count != 4 {
+		t.Fatalf("expected 4 sentences, got %d in %q", count, text)
+	}
+}
+
+func TestMarkovFactoryMaxWords(t *testing.T) {
+	builder := Builder(NewMarkovFactory("a b c d e f g h i j k l m n o p"))
+
+	text := builder.Build(Override[MarkovProperties](map[string]any{
+		"sentences": 1,
+		"maxWords":  5,
+	}))
+
+	if words := strings.Fields(text); len(words) > 5 {
+		t.Fatalf("expected at most 5 words, got %d", len(words))
+	}
+}
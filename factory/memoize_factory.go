@@ -0,0 +1,76 @@
+package factory
+
+import "sync"
+
+// MemoizeFactory caches instances produced by an inner factory keyed by seed.
+type MemoizeFactory[T any, P any] struct {
+	inner     Factory[T, P]
+	mu        sync.Mutex
+	instances map[int64]T
+}
+
+var _ Factory[any, any] = (*MemoizeFactory[any, any])(nil)
+
+// seedMemoizer is implemented by factories that cache whole builds per seed. create consults it
+// for builds without overrides, so the cache never depends on state carried from Prepare to
+// Instantiate.
+type seedMemoizer[T any] interface {
+	memoized(seed int64, build func() T) T
+}
+
+// Memoize wraps inner so that building with the same seed returns the identical instance.
+// Builds that carry overrides bypass the cache, as do duplicated instances and direct calls to
+// Prepare or Instantiate.
+func Memoize[T any, P any](inner Factory[T, P]) *MemoizeFactory[T, P] {
+	return &MemoizeFactory[T, P]{
+		inner:     inner,
+		instances: make(map[int64]T),
+	}
+}
+
+// Instantiate delegates to the inner factory.
+func (f *MemoizeFactory[T, P]) Instantiate(properties P) T {
+	return f.inner.Instantiate(properties)
+}
+
+// Prepare delegates to the inner factory.
+func (f *MemoizeFactory[T, P]) Prepare(overrides Partial[P], seed int64) P {
+	return f.inner.Prepare(overrides, seed)
+}
+
+// Retrieve delegates to the inner factory.
+func (f *MemoizeFactory[T, P]) Retrieve(instance T) P {
+	return f.inner.Retrieve(instance)
+}
+
+// Reset discards every cached instance.
+func (f *MemoizeFactory[T, P]) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.instances = make(map[int64]T)
+}
+
+// memoized returns the cached instance for seed, building it outside the lock on first use so
+// nested builds of the same factory cannot deadlock. Concurrent first builds keep the instance
+// stored first.
+func (f *MemoizeFactory[T, P]) memoized(seed int64, build func() T) T {
+	f.mu.Lock()
+	instance, ok := f.instances[seed]
+	f.mu.Unlock()
+	if ok {
+		return instance
+	}
+
+	built := build()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if instance, ok := f.instances[seed]; ok {
+		return instance
+	}
+	f.instances[seed] = built
+
+	return built
+}
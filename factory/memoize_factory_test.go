@@ -0,0 +1,154 @@
+package factory
+
+import (
+	"sync"
+	"testing"
+)
+
+type memoEntity struct {
+	Name string
+}
+
+type memoEntityProps struct {
+	Name string
+}
+
+type memoEntityFactory struct {
+	prepareCalls int
+}
+
+func (f *memoEntityFactory) Instantiate(props memoEntityProps) *memoEntity {
+	return &memoEntity{Name: props.Name}
+}
+
+func (f *memoEntityFactory) Prepare(overrides Partial[memoEntityProps], seed int64) memoEntityProps {
+	f.prepareCalls++
+	props := memoEntityProps{Name: Builder(&StringFactory{}).BuildWith(seed, nil)}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *memoEntityFactory) Retrieve(instance *memoEntity) memoEntityProps {
+	return memoEntityProps{Name: instance.Name}
+}
+
+func TestMemoizeReturnsIdenticalPointerForSeed(t *testing.T) {
+	inner := &memoEntityFactory{}
+	builder := Builder(Memoize(inner))
+
+	first := builder.BuildWith(5, nil)
+	second := builder.BuildWith(5, nil)
+
+	if first != second {
+		t.Fatal("expected identical pointer for repeated seed")
+	}
+	if inner.prepareCalls != 1 {
+		t.Fatalf("expected one prepare call, got %d", inner.prepareCalls)
+	}
+}
+
+func TestMemoizeSeparatesSeeds(t *testing.T) {
+	builder := Builder(Memoize(&memoEntityFactory{}))
+
+	first := builder.BuildWith(1, nil)
+	second := builder.BuildWith(2, nil)
+
+	if first == second {
+		t.Fatal("expected different instances for different seeds")
+	}
+}
+
+func TestMemoizeBypassesCacheWithOverrides(t *testing.T) {
+	builder := Builder(Memoize(&memoEntityFactory{}))
+
+	cached := builder.BuildWith(3, nil)
+	overridden := builder.BuildWith(3, Override[memoEntityProps](map[string]any{
+		"Name": "custom",
+	}))
+
+	if overridden == cached {
+		t.Fatal("expected overridden build to bypass cache")
+	}
+	if overridden.Name != "custom" {
+		t.Fatalf("expected overridden name, got %s", overridden.Name)
+	}
+	if again := builder.BuildWith(3, nil); again != cached {
+		t.Fatal("expected cache to remain intact after overridden build")
+	}
+}
+
+func TestMemoizeDuplicateCreatesNewInstance(t *testing.T) {
+	builder := Builder(Memoize(&memoEntityFactory{}))
+
+	original := builder.BuildWith(4, nil)
+	duplicated := builder.Duplicate(original, nil)
+
+	if duplicated == original {
+		t.Fatal("expected duplicate to produce a new instance")
+	}
+	if duplicated.Name != original.Name {
+		t.Fatalf("expected duplicated name %s, got %s", original.Name, duplicated.Name)
+	}
+}
+
+func TestMemoizeReset(t *testing.T) {
+	memoized := Memoize(&memoEntityFactory{})
+	builder := Builder(memoized)
+
+	first := builder.BuildWith(6, nil)
+	memoized.Reset()
+	second := builder.BuildWith(6, nil)
+
+	if first == second {
+		t.Fatal("expected reset to discard cached instance")
+	}
+	if first.Name != second.Name {
+		t.Fatalf("expected deterministic name after reset, got %s and %s", first.Name, second.Name)
+	}
+}
+
+func TestMemoizeConcurrentBuildsKeepSeedsApart(t *testing.T) {
+	memoized := Memoize[string, StringProperties](&StringFactory{Min: 8, Max: 8})
+	builder := Builder(memoized)
+
+	var wg sync.WaitGroup
+	for worker := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for seed := range int64(200) {
+				seed += int64(worker % 2)
+				if got, want := builder.BuildWith(seed, nil), (&StringFactory{Min: 8, Max: 8}).Generate(seed); got != want {
+					t.Errorf("seed %d: cached %q, expected %q", seed, got, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMemoizeIgnoresPrepareOnlyCalls(t *testing.T) {
+	memoized := Memoize(&memoEntityFactory{})
+	builder := Builder(memoized)
+
+	cached := builder.BuildWith(1, nil)
+	memoized.Prepare(nil, 1)
+
+	if duplicated := builder.Duplicate(&memoEntity{Name: "other"}, nil); duplicated == cached || duplicated.Name != "other" {
+		t.Fatalf("expected a Prepare-only call not to leak the cached instance, got %+v", duplicated)
+	}
+	if instance := memoized.Instantiate(memoEntityProps{Name: "direct"}); instance.Name != "direct" {
+		t.Fatalf("expected Instantiate to delegate, got %+v", instance)
+	}
+}
+
+func TestMemoizeThroughNamespacedBuilder(t *testing.T) {
+	builder := Builder(Memoize(&memoEntityFactory{}), WithNamespace("pkg"))
+
+	if builder.BuildWith(9, nil) != builder.BuildWith(9, nil) {
+		t.Fatal("expected the cache to survive builder wrappers")
+	}
+}
@@ -0,0 +1,217 @@
+package factory
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/lihs-ie/forge/internal/math"
+)
+
+const defaultMoneyMax = 100_000
+
+// Currency describes an ISO 4217 currency and how many minor units it uses.
+type Currency struct {
+	Code       string
+	MinorUnits int
+}
+
+// Currencies provides common ISO 4217 currencies, with minor units taken from the same table as
+// LookupCurrency.
+var Currencies = struct {
+	USD Currency
+	EUR Currency
+	GBP Currency
+	JPY Currency
+	KRW Currency
+	CHF Currency
+	KWD Currency
+	BHD Currency
+}{
+	USD: mustLookupCurrency("USD"),
+	EUR: mustLookupCurrency("EUR"),
+	GBP: mustLookupCurrency("GBP"),
+	JPY: mustLookupCurrency("JPY"),
+	KRW: mustLookupCurrency("KRW"),
+	CHF: mustLookupCurrency("CHF"),
+	KWD: mustLookupCurrency("KWD"),
+	BHD: mustLookupCurrency("BHD"),
+}
+
+// Money is an amount expressed in minor units of its currency, so it never carries invalid precision.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// Decimal formats the amount with exactly the currency's minor-unit digits.
+func (m Money) Decimal() string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := strconv.FormatInt(amount, 10)
+	if m.Currency.MinorUnits <= 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= m.Currency.MinorUnits {
+		digits = strings.Repeat("0", m.Currency.MinorUnits-len(digits)+1) + digits
+	}
+
+	split := len(digits) - m.Currency.MinorUnits
+	return sign + digits[:split] + "." + digits[split:]
+}
+
+// String formats the money as "<decimal> <code>".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency.Code
+}
+
+// Sign controls the sign of generated amounts.
+type Sign int
+
+// Supported signs for generated amounts.
+const (
+	SignPositive Sign = iota
+	SignNegative
+	SignAny
+)
+
+// MoneyProperties carries configuration and the generated value for MoneyFactory.
+type MoneyProperties struct {
+	amount     int64
+	currency   Currency
+	currencies []Currency
+	min        int64
+	max        int64
+	sign       Sign
+}
+
+// MoneyFactory generates Money values; Min and Max are absolute amounts in minor units.
+type MoneyFactory struct {
+	Currencies []Currency
+	Min        int64
+	Max        int64
+	Sign       Sign
+
+	adversarial bool
+}
+
+// Adversarial returns a copy of the factory that favours zero, boundary and negative amounts,
+// regardless of Sign.
+func (f *MoneyFactory) Adversarial() Factory[Money, MoneyProperties] {
+	adversarial := *f
+	adversarial.adversarial = true
+	return &adversarial
+}
+
+// Boundaries returns the boundary amounts of Min and Max in the first configured currency,
+// negated when Sign is SignNegative.
+func (f *MoneyFactory) Boundaries() []Money {
+	properties := f.Prepare(nil, 0)
+
+	amounts := boundaryPoints(properties.min, properties.max)
+	values := make([]Money, len(amounts))
+	for index, amount := range amounts {
+		if f.Sign == SignNegative {
+			amount = -amount
+		}
+		values[index] = Money{Amount: amount, Currency: properties.currencies[0]}
+	}
+
+	return values
+}
+
+// Instantiate returns the prepared Money value.
+func (f *MoneyFactory) Instantiate(properties MoneyProperties) Money {
+	return Money{
+		Amount:   properties.amount,
+		Currency: properties.currency,
+	}
+}
+
+// Prepare picks a currency and an amount within range; a non-zero amount or currency from overrides is kept.
+func (f *MoneyFactory) Prepare(overrides Partial[MoneyProperties], seed int64) MoneyProperties {
+	properties := MoneyProperties{
+		currencies: f.Currencies,
+		min:        f.Min,
+		max:        f.Max,
+		sign:       f.Sign,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if len(properties.currencies) == 0 {
+		properties.currencies = []Currency{Currencies.USD}
+	}
+	if properties.min < 0 {
+		properties.min = 0
+	}
+	if properties.max <= 0 {
+		properties.max = defaultMoneyMax
+	}
+	if properties.max < properties.min {
+		properties.max = properties.min
+	}
+
+	if properties.currency.Code == "" {
+		properties.currency = properties.currencies[scrambledIndex(seed, len(properties.currencies))]
+	}
+
+	if properties.amount == 0 {
+		if edge, ok := f.edgeCase(seed, properties.min, properties.max); ok {
+			properties.amount = edge
+			return properties
+		}
+
+		//nolint:gosec // G115: Controlled conversion for hash scrambling within expected range
+		scrambled := int64(math.Scramble(uint32(seed + 1)))
+		properties.amount = properties.min + scrambled%(properties.max-properties.min+1)
+
+		negative := properties.sign == SignNegative || (properties.sign == SignAny && scrambled%2 == 1)
+		if negative {
+			properties.amount = -properties.amount
+		}
+	}
+
+	return properties
+}
+
+// Retrieve converts Money back into MoneyProperties.
+func (f *MoneyFactory) Retrieve(instance Money) MoneyProperties {
+	return MoneyProperties{
+		amount:   instance.Amount,
+		currency: instance.Currency,
+	}
+}
+
+const moneyEdgeCases = 5
+
+func (f *MoneyFactory) edgeCase(seed, minimum, maximum int64) (int64, bool) {
+	if !f.adversarial {
+		return 0, false
+	}
+
+	index, ok := adversarialCase(seed, moneyEdgeCases)
+	if !ok {
+		return 0, false
+	}
+
+	switch index {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	case 2:
+		return minimum, true
+	case 3:
+		return maximum, true
+	default:
+		return -maximum, true
+	}
+}
@@ -0,0 +1,81 @@
+package factory
+
+import "testing"
+
+func TestMoneyDecimalRespectsMinorUnits(t *testing.T) {
+	cases := []struct {
+		money    Money
+		expected string
+	}{
+		{Money{Amount: 1234, Currency: Currencies.USD}, "12.34 USD"},
+		{Money{Amount: 5, Currency: Currencies.EUR}, "0.05 EUR"},
+		{Money{Amount: -1234, Currency: Currencies.USD}, "-12.34 USD"},
+		{Money{Amount: 1234, Currency: Currencies.JPY}, "1234 JPY"},
+		{Money{Amount: 1234, Currency: Currencies.KWD}, "1.234 KWD"},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.money.String(); actual != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, actual)
+		}
+	}
+}
+
+func TestMoneyFactoryRangeAndCurrency(t *testing.T) {
+	builder := Builder(&MoneyFactory{
+		Currencies: []Currency{Currencies.USD, Currencies.JPY},
+		Min:        100,
+		Max:        200,
+	})
+
+	for seed := range int64(50) {
+		money := builder.BuildWith(seed, nil)
+		if money.Amount < 100 || money.Amount > 200 {
+			t.Fatalf("amount %d out of range", money.Amount)
+		}
+		if money.Currency != Currencies.USD && money.Currency != Currencies.JPY {
+			t.Fatalf("unexpected currency %v", money.Currency)
+		}
+	}
+}
+
+func TestMoneyFactorySign(t *testing.T) {
+	negative := Builder(&MoneyFactory{Min: 1, Sign: SignNegative})
+	mixed := Builder(&MoneyFactory{Min: 1, Sign: SignAny})
+
+	sawPositive, sawNegative := false, false
+	for seed := range int64(50) {
+		if amount := negative.BuildWith(seed, nil).Amount; amount >= 0 {
+			t.Fatalf("expected negative amount, got %d", amount)
+		}
+		if amount := mixed.BuildWith(seed, nil).Amount; amount > 0 {
+			sawPositive = true
+		} else {
+			sawNegative = true
+		}
+	}
+
+	if !sawPositive || !sawNegative {
+		t.Fatal("expected SignAny to produce both signs")
+	}
+}
+
+func TestMoneyFactoryOverrides(t *testing.T) {
+	builder := Builder(&MoneyFactory{})
+
+	money := builder.Build(Override[MoneyProperties](map[string]any{
+		"amount":   int64(999),
+		"currency": Currencies.GBP,
+	}))
+
+	if money.String() != "9.99 GBP" {
+		t.Fatalf("expected 9.99 GBP, got %s", money)
+	}
+
+	duplicated := builder.Duplicate(money, Override[MoneyProperties](map[string]any{
+		"currency": Currencies.EUR,
+	}))
+	if duplicated.String() != "9.99 EUR" {
+		t.Fatalf("expected 9.99 EUR, got %s", duplicated)
+	}
+}
@@ -0,0 +1,76 @@
+package factory
+
+import (
+	"hash/fnv"
+	"runtime"
+	"strings"
+)
+
+// WithNamespace scrambles every seed the builder uses with a hash of namespace, so builders in
+// different namespaces generate different values from the same seeds. Give each package its own
+// namespace (see CallerNamespace) when parallel test packages share a database, so unique keys
+// derived from seeds do not collide even in stable mode. Seeds stay distinct within a namespace.
+func WithNamespace(namespace string) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.namespace = namespace
+	}
+}
+
+// CallerNamespace returns the import path of the calling package, for use with WithNamespace:
+//
+//	var users = factory.Builder(&UserFactory{}, factory.WithNamespace(factory.CallerNamespace()))
+func CallerNamespace() string {
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		return ""
+	}
+
+	function := runtime.FuncForPC(pc)
+	if function == nil {
+		return ""
+	}
+
+	return packagePath(function.Name())
+}
+
+// packagePath extracts the import path from a fully qualified function name such as
+// "example.com/app/users.TestCreate.func1".
+func packagePath(function string) string {
+	slash := strings.LastIndex(function, "/")
+	if dot := strings.Index(function[slash+1:], "."); dot >= 0 {
+		return function[:slash+1+dot]
+	}
+	return function
+}
+
+func namespaceMask(namespace string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(namespace))
+	//nolint:gosec // G115: Masked to 53 bits, so the conversion cannot overflow
+	return int64(hash.Sum64() & maxSafeInteger)
+}
+
+// namespacedFactory scrambles seeds with a namespace mask before delegating to inner.
+type namespacedFactory[T any, P any] struct {
+	inner Factory[T, P]
+	mask  int64
+}
+
+func (f *namespacedFactory[T, P]) Instantiate(properties P) T {
+	return f.inner.Instantiate(properties)
+}
+
+func (f *namespacedFactory[T, P]) Prepare(overrides Partial[P], seed int64) P {
+	return f.inner.Prepare(overrides, seed^f.mask)
+}
+
+func (f *namespacedFactory[T, P]) Retrieve(instance T) P {
+	return f.inner.Retrieve(instance)
+}
+
+func (f *namespacedFactory[T, P]) memoized(seed int64, build func() T) T {
+	if memoizer, ok := f.inner.(seedMemoizer[T]); ok {
+		return memoizer.memoized(seed^f.mask, build)
+	}
+	return build()
+}
@@ -0,0 +1,60 @@
+package factory
+
+import "testing"
+
+func TestWithNamespaceSeparatesBuilders(t *testing.T) {
+	first := Builder(&StringFactory{}, WithNamespace("example.com/app/users"))
+	second := Builder(&StringFactory{}, WithNamespace("example.com/app/orders"))
+	plain := Builder(&StringFactory{})
+
+	collisions := 0
+	for seed := range int64(100) {
+		if first.BuildWith(seed, nil) == second.BuildWith(seed, nil) {
+			collisions++
+		}
+		if first.BuildWith(seed, nil) != Builder(&StringFactory{}, WithNamespace("example.com/app/users")).BuildWith(seed, nil) {
+			t.Fatalf("expected the same namespace to reproduce seed %d", seed)
+		}
+	}
+
+	if collisions > 0 {
+		t.Fatalf("expected namespaces to diverge, got %d collisions", collisions)
+	}
+	if first.BuildWith(1, nil) == plain.BuildWith(1, nil) {
+		t.Fatal("expected namespaced builder to differ from the default one")
+	}
+}
+
+func TestWithNamespaceKeepsStableMode(t *testing.T) {
+	build := func() []string {
+		return Builder(&StringFactory{}, StableMode(GeneratorVersion), WithNamespace("pkg")).BuildList(5, nil)
+	}
+
+	first, second := build(), build()
+	for index := range first {
+		if first[index] != second[index] {
+			t.Fatal("expected namespaced stable builds to be reproducible")
+		}
+	}
+}
+
+func TestCallerNamespace(t *testing.T) {
+	if namespace := CallerNamespace(); namespace != "github.com/lihs-ie/forge/factory" {
+		t.Fatalf("unexpected namespace %q", namespace)
+	}
+}
+
+func TestPackagePath(t *testing.T) {
+	cases := map[string]string{
+		"example.com/app/users.TestCreate.func1": "example.com/app/users",
+		"example.com/app/users.(*Repo).Save":     "example.com/app/users",
+		"main.main":                              "main",
+		"example.com/app/v2.0/users.TestCreate":  "example.com/app/v2.0/users",
+	}
+
+	for function, expected := range cases {
+		if got := packagePath(function); got != expected {
+			t.Errorf("packagePath(%q) = %q, expected %q", function, got, expected)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package factory
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// oneOfSalt decorrelates the branch choice from values branches derive from the same seed.
+const oneOfSalt = 0x0AE0_F5A1
+
+// OneOfBranch is a named, weighted generation strategy for OneOfFactory.
+type OneOfBranch[T any] struct {
+	name   string
+	weight float64
+	build  func(seed int64) T
+}
+
+// Branch wraps factory as a OneOfFactory branch. Branches are picked in proportion to weight;
+// a non-positive weight counts as 1.
+func Branch[T any, P any](name string, factory Factory[T, P], weight float64) OneOfBranch[T] {
+	if weight <= 0 {
+		weight = 1
+	}
+
+	return OneOfBranch[T]{
+		name:   name,
+		weight: weight,
+		build: func(seed int64) T {
+			return create(factory, Overrider[P]{}, seed)
+		},
+	}
+}
+
+// BranchOf wraps factory as an unweighted OneOfFactory branch named after the factory's type,
+// e.g. "*factory.UUIDFactory", for unions that need neither names nor weights.
+func BranchOf[T any, P any](factory Factory[T, P]) OneOfBranch[T] {
+	return Branch(reflect.TypeOf(factory).String(), factory, 1)
+}
+
+// OneOfProperties captures the chosen branch, its value and the excluded branches for OneOfFactory.
+type OneOfProperties[T any] struct {
+	value      T
+	branch     string
+	exclusions []string
+}
+
+// OneOfFactory builds values of T by picking one of several factories per build.
+type OneOfFactory[T any] struct {
+	branches []OneOfBranch[T]
+	fallback *OneOfBranch[T]
+	quotas   quotas[string]
+}
+
+// NewOneOfFactory constructs a OneOfFactory from branches created with Branch.
+func NewOneOfFactory[T any](branches ...OneOfBranch[T]) *OneOfFactory[T] {
+	return &OneOfFactory[T]{
+		branches: branches,
+	}
+}
+
+// WithFallback returns a copy of the factory that uses fallback when every branch is excluded.
+// The fallback is never picked otherwise.
+func (f *OneOfFactory[T]) WithFallback(fallback OneOfBranch[T]) *OneOfFactory[T] {
+	withFallback := *f
+	withFallback.fallback = &fallback
+	return &withFallback
+}
+
+// WithQuota returns a copy of the factory that picks the named branch for at most limit of every
+// window consecutive seeds, with the same guarantees as EnumFactory.WithQuota. Branches named
+// through overrides are not limited, and the fallback is used once quotas rule out every branch.
+func (f *OneOfFactory[T]) WithQuota(branch string, limit, window int) *OneOfFactory[T] {
+	withQuota := *f
+	withQuota.quotas = f.quotas.with(branch, limit, window)
+	return &withQuota
+}
+
+// Instantiate returns the value produced by the chosen branch.
+func (f *OneOfFactory[T]) Instantiate(properties OneOfProperties[T]) T {
+	return properties.value
+}
+
+// Prepare applies overrides and exclusions, then builds the value with a branch chosen by weight.
+// A branch named through overrides is used as is; a non-zero value from overrides is kept.
+func (f *OneOfFactory[T]) Prepare(overrides Partial[OneOfProperties[T]], seed int64) OneOfProperties[T] {
+	properties := OneOfProperties[T]{
+		exclusions: []string{},
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if !reflect.ValueOf(&properties.value).Elem().IsZero() {
+		return properties
+	}
+
+	branch := f.choose(properties.branch, properties.exclusions, seed)
+	properties.branch = branch.name
+	properties.value = branch.build(seed)
+
+	return properties
+}
+
+// Retrieve wraps an existing instance into OneOfProperties.
+func (f *OneOfFactory[T]) Retrieve(instance T) OneOfProperties[T] {
+	return OneOfProperties[T]{
+		value:      instance,
+		exclusions: []string{},
+	}
+}
+
+func (f *OneOfFactory[T]) choose(name string, exclusions []string, seed int64) OneOfBranch[T] {
+	if name != "" {
+		for _, branch := range f.branches {
+			if branch.name == name {
+				return branch
+			}
+		}
+		if f.fallback != nil && f.fallback.name == name {
+			return *f.fallback
+		}
+		panic(fmt.Sprintf("one of: unknown branch %q", name))
+	}
+
+	candidates := make([]OneOfBranch[T], 0, len(f.branches))
+	for _, branch := range f.branches {
+		if !slices.Contains(exclusions, branch.name) && f.quotas.allows(branch.name, seed) {
+			candidates = append(candidates, branch)
+		}
+	}
+
+	if len(candidates) == 0 {
+		if f.fallback == nil {
+			panic("no candidates available after exclusions")
+		}
+		return *f.fallback
+	}
+
+	return candidates[weightedIndex(candidates, seed)]
+}
+
+// weightedIndex picks an index of branches in proportion to their weights.
+func weightedIndex[T any](branches []OneOfBranch[T], seed int64) int {
+	total := 0.0
+	for _, branch := range branches {
+		total += branch.weight
+	}
+
+	point := FloatAt(seed^oneOfSalt, 0, total)
+	for index, branch := range branches {
+		point -= branch.weight
+		if point < 0 {
+			return index
+		}
+	}
+
+	return len(branches) - 1
+}
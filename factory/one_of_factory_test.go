@@ -0,0 +1,135 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+)
+
+func newContactFactory() *OneOfFactory[string] {
+	return NewOneOfFactory(
+		Branch("digits", &StringFactory{Min: 5, Max: 5, Characters: Characters.Numeric}, 3),
+		Branch("letters", &StringFactory{Min: 5, Max: 5, Characters: Characters.Alpha}, 1),
+	)
+}
+
+func isDigits(value string) bool {
+	return strings.Trim(value, "0123456789") == ""
+}
+
+func TestOneOfFactoryRespectsWeights(t *testing.T) {
+	builder := Builder(newContactFactory())
+
+	digits := 0
+	for _, value := range builder.BuildListWith(2000, 1, nil) {
+		if isDigits(value) {
+			digits++
+		}
+	}
+
+	if digits < 1350 || digits > 1650 {
+		t.Fatalf("expected about 75%% digit values, got %d of 2000", digits)
+	}
+}
+
+func TestOneOfFactoryExclusions(t *testing.T) {
+	builder := Builder(newContactFactory())
+	override := Override[OneOfProperties[string]](map[string]any{"exclusions": []string{"digits"}})
+
+	for _, value := range builder.BuildList(50, override) {
+		if isDigits(value) {
+			t.Fatalf("expected excluded branch to be skipped, got %q", value)
+		}
+	}
+}
+
+func TestOneOfFactoryFallback(t *testing.T) {
+	factory := newContactFactory().WithFallback(Branch("fixed", &StringFactory{Min: 8, Max: 8}, 0))
+	builder := Builder(factory)
+
+	for _, value := range builder.BuildList(50, nil) {
+		if len(value) != 5 {
+			t.Fatalf("expected fallback to be unused while branches remain, got %q", value)
+		}
+	}
+
+	excluded := Override[OneOfProperties[string]](map[string]any{"exclusions": []string{"digits", "letters"}})
+	if value := builder.Build(excluded); len(value) != 8 {
+		t.Fatalf("expected fallback when every branch is excluded, got %q", value)
+	}
+}
+
+func TestOneOfFactoryPanicsWithoutFallback(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic when every branch is excluded")
+		}
+	}()
+
+	Builder(newContactFactory()).Build(Override[OneOfProperties[string]](map[string]any{
+		"exclusions": []string{"digits", "letters"},
+	}))
+}
+
+func TestOneOfFactoryWithQuota(t *testing.T) {
+	base := newContactFactory()
+	builder := Builder(base.WithQuota("digits", 1, 4))
+
+	values := builder.BuildListWith(40, 3, nil)
+	for start := 0; start+4 <= len(values); start++ {
+		digits := 0
+		for _, value := range values[start : start+4] {
+			if isDigits(value) {
+				digits++
+			}
+		}
+		if digits > 1 {
+			t.Fatalf("expected at most 1 digit value in every 4, got %d from index %d", digits, start)
+		}
+	}
+
+	if base.quotas != nil {
+		t.Fatal("expected WithQuota to leave the receiver untouched")
+	}
+}
+
+func TestOneOfFactoryNamedBranchAndValue(t *testing.T) {
+	builder := Builder(newContactFactory())
+
+	for _, value := range builder.BuildList(20, Override[OneOfProperties[string]](map[string]any{"branch": "letters"})) {
+		if isDigits(value) {
+			t.Fatalf("expected named branch, got %q", value)
+		}
+	}
+
+	if value := builder.Build(Override[OneOfProperties[string]](map[string]any{"value": "fixed"})); value != "fixed" {
+		t.Fatalf("expected overridden value, got %q", value)
+	}
+}
+
+func TestOneOfFactoryUnionsHeterogeneousFactories(t *testing.T) {
+	builder := Builder(NewOneOfFactory(
+		BranchOf[string](&UUIDFactory{}),
+		BranchOf[string](&StringFactory{Min: 3, Max: 3, Characters: Characters.Numeric}),
+	))
+
+	uuids, digits := 0, 0
+	for _, value := range builder.BuildListWith(200, 1, nil) {
+		switch {
+		case len(value) == 36:
+			uuids++
+		case len(value) == 3 && isDigits(value):
+			digits++
+		default:
+			t.Fatalf("unexpected value %q", value)
+		}
+	}
+
+	if uuids == 0 || digits == 0 {
+		t.Fatalf("expected both strategies, got %d uuids and %d digit strings", uuids, digits)
+	}
+
+	forced := Override[OneOfProperties[string]](map[string]any{"branch": "*factory.UUIDFactory"})
+	if value := builder.Build(forced); len(value) != 36 {
+		t.Fatalf("expected the branch named after its factory type, got %q", value)
+	}
+}
@@ -0,0 +1,80 @@
+package factory
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// OverrideKey describes one key accepted by Override for a properties type.
+type OverrideKey struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Exported bool   `json:"exported"`
+	Lazy     bool   `json:"lazy,omitempty"`
+	Promoted string `json:"promoted,omitempty"`
+}
+
+// OverrideSchema lists the overridable keys of a properties type in field order.
+type OverrideSchema struct {
+	Type string        `json:"type"`
+	Keys []OverrideKey `json:"keys"`
+}
+
+// DescribeOverrides returns the keys Override accepts for P, including fields promoted from
+// embedded structs, so editors and code generators can complete and validate override literals.
+// Keys are matched case-insensitively by default; unexported keys are rejected by DisallowUnexported.
+func DescribeOverrides[P any]() OverrideSchema {
+	propertiesType := reflect.TypeFor[P]()
+	if propertiesType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("override: properties must be a struct, got %s", propertiesType.Kind()))
+	}
+
+	schema := OverrideSchema{Type: snapshotTypeName(propertiesType)}
+	seen := make(map[string]bool)
+	collectOverrideKeys(propertiesType, "", seen, &schema.Keys)
+
+	return schema
+}
+
+// MarshalOverrideSchemas encodes schemas as an indented JSON array.
+func MarshalOverrideSchemas(schemas ...OverrideSchema) ([]byte, error) {
+	return json.MarshalIndent(schemas, "", "  ")
+}
+
+func collectOverrideKeys(structType reflect.Type, promoted string, seen map[string]bool, keys *[]OverrideKey) {
+	lazyType := reflect.TypeFor[lazyTarget]()
+
+	var embedded []reflect.StructField
+	for index := range structType.NumField() {
+		field := structType.Field(index)
+
+		canonical := canonicalName(field.Name, true)
+		if seen[canonical] {
+			continue
+		}
+		seen[canonical] = true
+
+		*keys = append(*keys, OverrideKey{
+			Name:     field.Name,
+			Type:     field.Type.String(),
+			Exported: field.IsExported(),
+			Lazy:     field.Type.Implements(lazyType),
+			Promoted: promoted,
+		})
+
+		if field.Anonymous {
+			embedded = append(embedded, field)
+		}
+	}
+
+	for _, field := range embedded {
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct {
+			collectOverrideKeys(fieldType, field.Name, seen, keys)
+		}
+	}
+}
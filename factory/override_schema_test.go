@@ -0,0 +1,77 @@
+package factory
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type schemaAudit struct {
+	CreatedBy string
+}
+
+type schemaProps struct {
+	schemaAudit
+	Name  string
+	Owner *Lazy[string]
+	age   int
+}
+
+func TestDescribeOverridesListsKeys(t *testing.T) {
+	schema := DescribeOverrides[schemaProps]()
+
+	if schema.Type != "github.com/lihs-ie/forge/factory.schemaProps" {
+		t.Fatalf("unexpected type name %q", schema.Type)
+	}
+
+	expected := []OverrideKey{
+		{Name: "schemaAudit", Type: "factory.schemaAudit", Exported: false},
+		{Name: "Name", Type: "string", Exported: true},
+		{Name: "Owner", Type: "*factory.Lazy[string]", Exported: true, Lazy: true},
+		{Name: "age", Type: "int", Exported: false},
+		{Name: "CreatedBy", Type: "string", Exported: true, Promoted: "schemaAudit"},
+	}
+	if len(schema.Keys) != len(expected) {
+		t.Fatalf("expected %d keys, got %+v", len(expected), schema.Keys)
+	}
+	for index, key := range expected {
+		if schema.Keys[index] != key {
+			t.Fatalf("key %d: expected %+v, got %+v", index, key, schema.Keys[index])
+		}
+	}
+}
+
+func TestDescribeOverridesMatchesOverride(t *testing.T) {
+	for _, key := range DescribeOverrides[schemaProps]().Keys {
+		if key.Lazy || key.Name == "schemaAudit" {
+			continue
+		}
+		var properties schemaProps
+		value := map[string]any{"string": "x", "int": 1}[key.Type]
+		Override[schemaProps](map[string]any{key.Name: value}).Apply(&properties)
+	}
+}
+
+func TestMarshalOverrideSchemas(t *testing.T) {
+	data, err := MarshalOverrideSchemas(DescribeOverrides[StringProperties]())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded []OverrideSchema
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(decoded) != 1 || len(decoded[0].Keys) != 4 || decoded[0].Keys[0].Name != "value" {
+		t.Fatalf("unexpected decoded schema: %+v", decoded)
+	}
+}
+
+func TestDescribeOverridesPanicsForNonStruct(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for non-struct properties")
+		}
+	}()
+
+	DescribeOverrides[int]()
+}
@@ -5,33 +5,72 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
 	"unsafe"
 )
 
 // Overrider stores a prepared Partial that mutates properties of type P.
+//
+// An Overrider is immutable once created: the literal is copied when Override is called and
+// the per-type application plan is resolved once, under a sync.Once, on first Apply. A single
+// Overrider may therefore be shared across goroutines and parallel subtests.
 type Overrider[P any] struct {
-	fn Partial[P]
+	fn    Partial[P]
+	at    func(seed int64) Partial[P]
+	after func(seed int64) Partial[P]
 }
 
 // Apply runs the stored override against the provided properties pointer.
 func (o Overrider[P]) Apply(properties *P) {
-	if o.fn != nil {
-		o.fn(properties)
+	if partial := o.Func(); partial != nil {
+		partial(properties)
 	}
 }
 
-// Func returns the partial function backing this Overrider.
+// Func returns the partial function backing this Overrider. Column overrides (see Each) run after
+// the other entries.
 func (o Overrider[P]) Func() Partial[P] {
+	after := o.afterPrepare(0)
+	if after == nil {
+		return o.fn
+	}
+
+	return func(properties *P) {
+		if o.fn != nil {
+			o.fn(properties)
+		}
+		after(properties)
+	}
+}
+
+// forSeed returns the partial to use when building with seed.
+func (o Overrider[P]) forSeed(seed int64) Partial[P] {
+	if o.at != nil {
+		return o.at(seed)
+	}
 	return o.fn
 }
 
+// afterPrepare returns the partial to apply to the prepared properties when building with seed,
+// so it also reaches values the factory generated.
+func (o Overrider[P]) afterPrepare(seed int64) Partial[P] {
+	if o.after == nil {
+		return nil
+	}
+	return o.after(seed)
+}
+
 type overrideOptions struct {
 	caseInsensitive bool
 	allowUnexported bool
 }
 
+func defaultOverrideOptions() overrideOptions {
+	return overrideOptions{caseInsensitive: true, allowUnexported: true}
+}
+
 // OverrideOption configures how Override applies entries to targets.
 type OverrideOption func(*overrideOptions)
 
@@ -51,7 +90,7 @@ func DisallowUnexported() OverrideOption {
 
 // Override normalizes a literal (map or struct) into an Overrider for properties P.
 func Override[P any](literal any, opts ...OverrideOption) Overrider[P] {
-	config := overrideOptions{caseInsensitive: true, allowUnexported: true}
+	config := defaultOverrideOptions()
 	for _, opt := range opts {
 		opt(&config)
 	}
@@ -61,13 +100,62 @@ func Override[P any](literal any, opts ...OverrideOption) Overrider[P] {
 		panic(err)
 	}
 
-	return Overrider[P]{
+	entries, columns, err := splitColumnEntries(entries, config)
+	if err != nil {
+		panic(err)
+	}
+
+	program := &overrideProgram[P]{
+		entries: entries,
+		config:  config,
+	}
+
+	overrider := Overrider[P]{
 		fn: func(properties *P) {
-			if err := applyOverrideEntries(properties, entries, config); err != nil {
+			if err := program.apply(properties); err != nil {
 				panic(err)
 			}
 		},
 	}
+
+	if len(columns) > 0 {
+		applyColumns := func(properties *P) {
+			if err := applyColumnEntries(reflect.ValueOf(properties), columns, config); err != nil {
+				panic(err)
+			}
+		}
+		overrider.after = func(int64) Partial[P] {
+			return applyColumns
+		}
+	}
+
+	return overrider
+}
+
+// overrideProgram holds the parsed entries of an Overrider together with their plans,
+// which are resolved at most once.
+type overrideProgram[P any] struct {
+	entries []literalEntry
+	config  overrideOptions
+	once    sync.Once
+	plans   []*overridePlan
+}
+
+func (p *overrideProgram[P]) apply(properties *P) error {
+	p.once.Do(func() {
+		targetType := reflect.TypeFor[*P]()
+		if targetType.Elem().Kind() != reflect.Struct {
+			return
+		}
+
+		plans := make([]*overridePlan, len(p.entries))
+		for index, entry := range p.entries {
+			plans[index] = lookupOverridePlan(targetType, entry, p.config.caseInsensitive)
+		}
+		p.plans = plans
+	})
+
+	return applyOverrideEntries(properties, p.entries, p.plans, p.config)
 }
 
 type literalEntry struct {
@@ -109,11 +197,10 @@ func parseOverrideLiteral(literal any, caseInsensitive bool) ([]literalEntry, er
 
 	case reflect.Struct:
 		entries := make([]literalEntry, 0, value.NumField())
-		if !value.CanAddr() {
-			addr := reflect.New(value.Type())
-			addr.Elem().Set(value)
-			value = addr.Elem()
-		}
+		// Always copy so later changes to the caller's literal cannot leak into the Overrider.
+		addr := reflect.New(value.Type())
+		addr.Elem().Set(value)
+		value = addr.Elem()
 		typ := value.Type()
 		for i := 0; i < value.NumField(); i++ {
 			field := typ.Field(i)
@@ -140,10 +227,15 @@ func canonicalName(name string, caseInsensitive bool) string {
 	return strings.ToLower(name)
 }
 
-func applyOverrideEntries[P any](properties *P, entries []literalEntry, config overrideOptions) error {
-	target := reflect.ValueOf(properties)
+// applyOverrideEntries applies entries in order; plans, when non-nil, holds the precomputed plan per entry.
+func applyOverrideEntries[P any](properties *P, entries []literalEntry, plans []*overridePlan, config overrideOptions) error {
+	return applyOverrideEntriesTo(reflect.ValueOf(properties), entries, plans, config)
+}
+
+// applyOverrideEntriesTo applies entries to the struct target points to.
+func applyOverrideEntriesTo(target reflect.Value, entries []literalEntry, plans []*overridePlan, config overrideOptions) error {
 	if target.Kind() != reflect.Pointer || target.IsNil() {
-		return fmt.Errorf("override: target must be a non-nil pointer, got %T", properties)
+		return fmt.Errorf("override: target must be a non-nil pointer, got %s", target.Type())
 	}
 
 	elem := target.Elem()
@@ -151,31 +243,51 @@ func applyOverrideEntries[P any](properties *P, entries []literalEntry, config o
 		return fmt.Errorf("override: target must point to a struct, got %s", elem.Kind())
 	}
 
-	for _, entry := range entries {
-		if err := applyOverrideEntry(target, elem, entry, config); err != nil {
+	if hook, ok := target.Interface().(BeforeOverrideHook); ok {
+		if err := hook.BeforeOverride(); err != nil {
+			return fmt.Errorf("override: before hook failed: %w", err)
+		}
+	}
+
+	for index, entry := range entries {
+		var plan *overridePlan
+		if plans != nil {
+			plan = plans[index]
+		} else {
+			plan = lookupOverridePlan(target.Type(), entry, config.caseInsensitive)
+		}
+		if err := applyOverrideEntry(target, elem, entry, plan, config); err != nil {
 			return err
 		}
 	}
 
+	if hook, ok := target.Interface().(AfterOverrideHook); ok {
+		if err := hook.AfterOverride(); err != nil {
+			return fmt.Errorf("override: after hook failed: %w", err)
+		}
+	}
+
 	return nil
 }
 
-func applyOverrideEntry(targetPtr, targetValue reflect.Value, entry literalEntry, config overrideOptions) error {
-	setterName := buildSetterName(entry.originalName)
-	if setterName != "" {
-		method := targetPtr.MethodByName(setterName)
-		if method.IsValid() && method.Type().NumIn() == 1 {
-			arg, err := prepareOverrideValue(entry.value, method.Type().In(0))
-			if err != nil {
-				return fmt.Errorf("override: cannot assign %q via setter: %w", entry.originalName, err)
-			}
-			method.Call([]reflect.Value{arg})
-			notifyOverride(targetPtr, entry.originalName)
-			return nil
+func applyOverrideEntry(targetPtr, targetValue reflect.Value, entry literalEntry, plan *overridePlan, config overrideOptions) error {
+	if plan.setterIndex >= 0 {
+		method := targetPtr.Method(plan.setterIndex)
+		arg, err := prepareOverrideValue(entry.value, method.Type().In(0))
+		if err != nil {
+			return fmt.Errorf("override: cannot assign %q via setter: %w", entry.originalName, err)
 		}
+		method.Call([]reflect.Value{arg})
+		notifyOverride(targetPtr, entry.originalName)
+		return nil
 	}
 
-	fieldValue, fieldInfo, ok := lookupField(targetValue, entry.key, config.caseInsensitive)
+	fieldValue, fieldInfo, ok := reflect.Value{}, plan.field, plan.found
+	if plan.dynamic {
+		fieldValue, fieldInfo, ok = lookupField(targetValue, entry.key, config.caseInsensitive)
+	} else if ok {
+		fieldValue = targetValue.FieldByIndex(plan.index)
+	}
 	if !ok {
 		return fmt.Errorf("override: unknown field %q on %s", entry.originalName, targetValue.Type())
 	}
@@ -207,6 +319,85 @@ func applyOverrideEntry(targetPtr, targetValue reflect.Value, entry literalEntry
 	return fmt.Errorf("override: field %q cannot be set", fieldInfo.Name)
 }
 
+type overridePlanKey struct {
+	target          reflect.Type
+	name            string
+	key             string
+	caseInsensitive bool
+}
+
+// overridePlan records how an entry is applied to a properties type: through a setter,
+// a fixed field index, or (dynamic) a per-Apply lookup when the field lives behind an
+// embedded pointer that may be nil.
+type overridePlan struct {
+	setterIndex int
+	index       []int
+	field       reflect.StructField
+	found       bool
+	dynamic     bool
+}
+
+var overridePlanCache sync.Map
+
+func lookupOverridePlan(targetPtrType reflect.Type, entry literalEntry, caseInsensitive bool) *overridePlan {
+	key := overridePlanKey{
+		target:          targetPtrType,
+		name:            entry.originalName,
+		key:             entry.key,
+		caseInsensitive: caseInsensitive,
+	}
+	if cached, ok := overridePlanCache.Load(key); ok {
+		if plan, ok := cached.(*overridePlan); ok {
+			return plan
+		}
+	}
+
+	plan := &overridePlan{setterIndex: -1}
+	if setterName := buildSetterName(entry.originalName); setterName != "" {
+		if method, ok := targetPtrType.MethodByName(setterName); ok && method.Type.NumIn() == 2 {
+			plan.setterIndex = method.Index
+		}
+	}
+
+	if plan.setterIndex < 0 {
+		canonical := canonicalName(entry.key, caseInsensitive)
+		plan.index, plan.field, plan.dynamic, plan.found = resolveFieldIndex(targetPtrType.Elem(), canonical, caseInsensitive)
+	}
+
+	actual, _ := overridePlanCache.LoadOrStore(key, plan)
+	if stored, ok := actual.(*overridePlan); ok {
+		return stored
+	}
+	return plan
+}
+
+func resolveFieldIndex(structType reflect.Type, canonical string, caseInsensitive bool) (index []int, field reflect.StructField, dynamic, found bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		candidate := structType.Field(i)
+		if canonicalName(candidate.Name, caseInsensitive) == canonical {
+			return []int{i}, candidate, false, true
+		}
+		if !candidate.Anonymous {
+			continue
+		}
+
+		embedded := candidate.Type
+		throughPointer := embedded.Kind() == reflect.Pointer
+		if throughPointer {
+			embedded = embedded.Elem()
+		}
+		if embedded.Kind() != reflect.Struct {
+			continue
+		}
+
+		if nestedIndex, nestedField, nestedDynamic, ok := resolveFieldIndex(embedded, canonical, caseInsensitive); ok {
+			return append([]int{i}, nestedIndex...), nestedField, throughPointer || nestedDynamic, true
+		}
+	}
+
+	return nil, reflect.StructField{}, false, false
+}
+
 func lookupField(targetValue reflect.Value, key string, caseInsensitive bool) (reflect.Value, reflect.StructField, bool) {
 	canonical := canonicalName(key, caseInsensitive)
 	return lookupFieldRecursive(targetValue, canonical, caseInsensitive)
@@ -257,17 +448,56 @@ func prepareOverrideValue(value reflect.Value, targetType reflect.Type) (reflect
 		value = value.Elem()
 	}
 
-	if value.Type().AssignableTo(targetType) {
+	switch lookupConversion(value.Type(), targetType) {
+	case conversionAssign:
 		return value, nil
-	}
-
-	if value.Type().ConvertibleTo(targetType) {
+	case conversionConvert:
 		return value.Convert(targetType), nil
+	case conversionLazy:
+		if target, ok := reflect.Zero(targetType).Interface().(lazyTarget); ok {
+			if wrapped, ok := target.wrapLazy(value); ok {
+				return wrapped, nil
+			}
+		}
+	case conversionNone:
 	}
 
 	return reflect.Value{}, fmt.Errorf("cannot convert %s to %s", value.Type(), targetType)
 }
 
+type conversion uint8
+
+const (
+	conversionNone conversion = iota
+	conversionAssign
+	conversionConvert
+	conversionLazy
+)
+
+var overrideConversionCache sync.Map
+
+func lookupConversion(source, target reflect.Type) conversion {
+	key := [2]reflect.Type{source, target}
+	if cached, ok := overrideConversionCache.Load(key); ok {
+		if kind, ok := cached.(conversion); ok {
+			return kind
+		}
+	}
+
+	kind := conversionNone
+	switch {
+	case source.AssignableTo(target):
+		kind = conversionAssign
+	case source.ConvertibleTo(target):
+		kind = conversionConvert
+	case target.Implements(reflect.TypeFor[lazyTarget]()):
+		kind = conversionLazy
+	}
+
+	overrideConversionCache.Store(key, kind)
+	return kind
+}
+
 func canBeNil(targetType reflect.Type) bool {
 	switch targetType.Kind() {
 	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
@@ -277,6 +507,16 @@ func canBeNil(targetType reflect.Type) bool {
 	}
 }
 
+// BeforeOverrideHook is implemented by properties that need to run logic before an Overrider applies entries.
+type BeforeOverrideHook interface {
+	BeforeOverride() error
+}
+
+// AfterOverrideHook is implemented by properties that normalize or validate themselves after an Overrider applies entries.
+type AfterOverrideHook interface {
+	AfterOverride() error
+}
+
 type overrideTracker interface {
 	noteOverride(field string)
 }
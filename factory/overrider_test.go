@@ -1,6 +1,12 @@
 package factory
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestOverrideWithMap(t *testing.T) {
 	factory := &StringFactory{}
@@ -710,3 +716,158 @@ func TestOverrideWithSetterMethodInvalidArgType(t *testing.T) {
 	})
 	overrider.Apply(props)
 }
+
+type propsWithHooksForTest struct {
+	value string
+	calls []string
+}
+
+func (p *propsWithHooksForTest) BeforeOverride() error {
+	p.calls = append(p.calls, "before:"+p.value)
+	return nil
+}
+
+func (p *propsWithHooksForTest) AfterOverride() error {
+	p.calls = append(p.calls, "after:"+p.value)
+	p.value = strings.TrimSpace(p.value)
+	if p.value == "" {
+		return errors.New("value must not be blank")
+	}
+	return nil
+}
+
+func TestOverrideInvokesBeforeAndAfterHooks(t *testing.T) {
+	props := &propsWithHooksForTest{value: "initial"}
+
+	overrider := Override[propsWithHooksForTest](map[string]any{
+		"value": "  padded  ",
+	})
+	overrider.Apply(props)
+
+	if props.value != "padded" {
+		t.Errorf("Expected normalized 'padded', got '%s'", props.value)
+	}
+	if len(props.calls) != 2 || props.calls[0] != "before:initial" || props.calls[1] != "after:  padded  " {
+		t.Errorf("Unexpected hook calls %v", props.calls)
+	}
+}
+
+func TestOverridePanicsWhenAfterHookFails(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic when after hook returns an error")
+		}
+	}()
+
+	props := &propsWithHooksForTest{}
+
+	overrider := Override[propsWithHooksForTest](map[string]any{
+		"value": "   ",
+	})
+	overrider.Apply(props)
+}
+
+type cachedEmbeddedForTest struct {
+	Inner string
+}
+
+type propsWithEmbeddedPointerForTest struct {
+	*cachedEmbeddedForTest
+	Outer string
+}
+
+func TestOverridePlanIsCachedPerType(t *testing.T) {
+	overrider := Override[propsWithEmbeddedPointerForTest](map[string]any{
+		"outer": "value",
+	})
+
+	for range 3 {
+		props := &propsWithEmbeddedPointerForTest{}
+		overrider.Apply(props)
+		if props.Outer != "value" {
+			t.Fatalf("Expected 'value', got '%s'", props.Outer)
+		}
+	}
+
+	key := overridePlanKey{
+		target:          reflect.TypeFor[*propsWithEmbeddedPointerForTest](),
+		name:            "outer",
+		key:             "outer",
+		caseInsensitive: true,
+	}
+	if _, ok := overridePlanCache.Load(key); !ok {
+		t.Fatal("Expected override plan to be cached")
+	}
+}
+
+func TestOverridePlanResolvesEmbeddedPointerPerApply(t *testing.T) {
+	overrider := Override[propsWithEmbeddedPointerForTest](map[string]any{
+		"Inner": "nested",
+	})
+
+	props := &propsWithEmbeddedPointerForTest{cachedEmbeddedForTest: &cachedEmbeddedForTest{}}
+	overrider.Apply(props)
+	if props.Inner != "nested" {
+		t.Fatalf("Expected 'nested', got '%s'", props.Inner)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic when embedded pointer is nil")
+		}
+	}()
+	overrider.Apply(&propsWithEmbeddedPointerForTest{})
+}
+
+func TestOverrideConversionIsCached(t *testing.T) {
+	props := &StringProperties{}
+	Override[StringProperties](map[string]any{"min": int32(3)}).Apply(props)
+
+	if props.min != 3 {
+		t.Fatalf("Expected 3, got %d", props.min)
+	}
+	if kind := lookupConversion(reflect.TypeFor[int32](), reflect.TypeFor[int]()); kind != conversionConvert {
+		t.Fatalf("Expected cached convert decision, got %d", kind)
+	}
+}
+
+func TestOverriderIsSafeForConcurrentUse(t *testing.T) {
+	overrider := Override[StringProperties](map[string]any{
+		"value": "shared",
+		"min":   3,
+	})
+	builder := Builder(&StringFactory{})
+
+	for index := range 8 {
+		t.Run(fmt.Sprintf("parallel-%d", index), func(t *testing.T) {
+			t.Parallel()
+			for range 100 {
+				props := &StringProperties{}
+				overrider.Apply(props)
+				if props.value != "shared" || props.min != 3 {
+					t.Errorf("Unexpected properties %+v", props)
+				}
+				if result := builder.BuildWith(1, overrider); result != "shared" {
+					t.Errorf("Expected 'shared', got '%s'", result)
+				}
+			}
+		})
+	}
+}
+
+func TestOverrideCopiesStructLiteral(t *testing.T) {
+	type literal struct {
+		Value string
+	}
+
+	source := &literal{Value: "before"}
+	overrider := Override[StringProperties](source)
+	source.Value = "after"
+
+	props := &StringProperties{}
+	overrider.Apply(props)
+
+	if props.value != "before" {
+		t.Errorf("Expected 'before', got '%s'", props.value)
+	}
+}
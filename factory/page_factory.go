@@ -0,0 +1,153 @@
+package factory
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	defaultPageSize  = 20
+	defaultPageTotal = 100
+)
+
+// Page is one page of a generated item sequence. NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T
+	Cursor     string
+	NextCursor string
+	Offset     int
+	Total      int
+}
+
+// PageProperties carries the position of a page for PageFactory.
+type PageProperties struct {
+	sequence int64
+	offset   int
+	size     int
+	cursor   string
+}
+
+// PageFactory builds pages of a reproducible item sequence with opaque cursors, for testing
+// cursor-based pagination end to end. Each cursor encodes the sequence and offset of the page it
+// points to, so building with an overridden "cursor" returns the continuation of the same
+// sequence, and DecodeCursor recovers the offset. The sequence holds Total items (100 when zero)
+// served PageSize at a time (20 when zero).
+type PageFactory[T any, P any] struct {
+	items    Factory[T, P]
+	PageSize int
+	Total    int
+}
+
+type pageCursor struct {
+	Sequence int64 `json:"s"`
+	Offset   int   `json:"o"`
+}
+
+// NewPageFactory creates a PageFactory whose items are built by items.
+func NewPageFactory[T any, P any](items Factory[T, P]) *PageFactory[T, P] {
+	return &PageFactory[T, P]{
+		items: items,
+	}
+}
+
+// Instantiate builds the items of the page; item i of the sequence is always built with the same
+// seed, whichever page it appears on.
+func (f *PageFactory[T, P]) Instantiate(properties PageProperties) Page[T] {
+	total := f.total()
+	end := min(properties.offset+properties.size, total)
+
+	items := make([]T, 0, max(end-properties.offset, 0))
+	for index := properties.offset; index < end; index++ {
+		items = append(items, create(f.items, Overrider[P]{}, properties.sequence+int64(index)))
+	}
+
+	page := Page[T]{
+		Items:  items,
+		Cursor: encodeCursor(properties.sequence, properties.offset),
+		Offset: properties.offset,
+		Total:  total,
+	}
+	if end < total {
+		page.NextCursor = encodeCursor(properties.sequence, end)
+	}
+
+	return page
+}
+
+// Prepare starts a new sequence at offset 0, or resumes the one encoded in an overridden cursor.
+// It panics when the cursor was not produced by a PageFactory.
+func (f *PageFactory[T, P]) Prepare(overrides Partial[PageProperties], seed int64) PageProperties {
+	properties := PageProperties{
+		sequence: seed,
+		size:     f.PageSize,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.cursor != "" {
+		cursor, err := decodeCursor(properties.cursor)
+		if err != nil {
+			panic(err.Error())
+		}
+		properties.sequence, properties.offset = cursor.Sequence, cursor.Offset
+	}
+	if properties.size <= 0 {
+		properties.size = defaultPageSize
+	}
+
+	return properties
+}
+
+// Retrieve converts a page back into PageProperties.
+func (f *PageFactory[T, P]) Retrieve(instance Page[T]) PageProperties {
+	cursor, _ := decodeCursor(instance.Cursor)
+
+	return PageProperties{
+		sequence: cursor.Sequence,
+		offset:   instance.Offset,
+		size:     len(instance.Items),
+		cursor:   instance.Cursor,
+	}
+}
+
+func (f *PageFactory[T, P]) total() int {
+	if f.Total <= 0 {
+		return defaultPageTotal
+	}
+	return f.Total
+}
+
+// DecodeCursor returns the item offset encoded in a cursor produced by PageFactory.
+func DecodeCursor(cursor string) (int, error) {
+	decoded, err := decodeCursor(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return decoded.Offset, nil
+}
+
+func encodeCursor(sequence int64, offset int) string {
+	data, _ := json.Marshal(pageCursor{Sequence: sequence, Offset: offset})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) (pageCursor, error) {
+	var decoded pageCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return decoded, fmt.Errorf("page: malformed cursor: %w", err)
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return decoded, fmt.Errorf("page: malformed cursor: %w", err)
+	}
+	if decoded.Offset < 0 {
+		return decoded, errors.New("page: negative cursor offset")
+	}
+
+	return decoded, nil
+}
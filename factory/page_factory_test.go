@@ -0,0 +1,61 @@
+package factory
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPageFactoryWalksSequence(t *testing.T) {
+	pages := NewPageFactory(&IntFactory{})
+	pages.PageSize = 3
+	pages.Total = 10
+	builder := Builder(pages)
+
+	page := builder.BuildWith(42, nil)
+	var walked []int
+	for {
+		offset, err := DecodeCursor(page.Cursor)
+		if err != nil || offset != len(walked) {
+			t.Fatalf("expected cursor offset %d, got %d (%v)", len(walked), offset, err)
+		}
+		walked = append(walked, page.Items...)
+
+		if page.NextCursor == "" {
+			break
+		}
+		page = builder.Build(Override[PageProperties](map[string]any{"cursor": page.NextCursor}))
+	}
+
+	expected := Builder(&IntFactory{}).BuildListWith(10, 42, nil)
+	if !slices.Equal(walked, expected) {
+		t.Fatalf("expected the pages to cover the sequence %v, got %v", expected, walked)
+	}
+}
+
+func TestPageFactoryDefaults(t *testing.T) {
+	page := Builder(NewPageFactory(&IntFactory{})).Build(nil)
+
+	if len(page.Items) != defaultPageSize || page.Total != defaultPageTotal || page.Offset != 0 {
+		t.Fatalf("unexpected default page %+v", page)
+	}
+	if offset, _ := DecodeCursor(page.NextCursor); offset != defaultPageSize {
+		t.Fatalf("expected next cursor at %d, got %d", defaultPageSize, offset)
+	}
+}
+
+func TestPageFactoryRetrieve(t *testing.T) {
+	factory := NewPageFactory(&IntFactory{})
+	properties := factory.Prepare(nil, 7)
+
+	if retrieved := factory.Retrieve(factory.Instantiate(properties)); retrieved.sequence != 7 || retrieved.size != defaultPageSize {
+		t.Fatalf("expected round-tripped position, got %+v", retrieved)
+	}
+}
+
+func TestDecodeCursorRejectsGarbage(t *testing.T) {
+	for _, cursor := range []string{"%%%", "bm90LWpzb24"} {
+		if _, err := DecodeCursor(cursor); err == nil {
+			t.Fatalf("expected error for %q", cursor)
+		}
+	}
+}
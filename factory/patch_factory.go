@@ -0,0 +1,151 @@
+package factory
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+const (
+	patchSalt      = 0x0FA7_C4ED
+	defaultDensity = 0.5
+)
+
+// PatchProperties carries the generated patch and the fields it sets for PatchFactory.
+type PatchProperties[Patch any] struct {
+	patch   Patch
+	fields  []string
+	density float64
+}
+
+// PatchFactory builds partially-populated patch DTOs, such as PATCH request bodies, from a factory
+// of complete values. Every pointer field of Patch whose name matches a field of T (of the pointed
+// type) is a candidate; each build sets a seed-determined subset of them and leaves the rest nil.
+type PatchFactory[T any, P any, Patch any] struct {
+	source Factory[T, P]
+	// Density is the probability that a candidate field is set (0.5 when zero). At least one
+	// field is always set.
+	Density float64
+
+	mapping []patchField
+}
+
+type patchField struct {
+	name   string
+	source int
+	target int
+}
+
+// NewPatchFactory creates a PatchFactory that draws field values from source.
+// It panics when T or Patch is not a struct or no Patch field matches a field of T.
+func NewPatchFactory[Patch any, T any, P any](source Factory[T, P]) *PatchFactory[T, P, Patch] {
+	sourceType, patchType := reflect.TypeFor[T](), reflect.TypeFor[Patch]()
+	if sourceType.Kind() != reflect.Struct || patchType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("patch: %s and %s must be structs", sourceType, patchType))
+	}
+
+	var mapping []patchField
+	for index := range patchType.NumField() {
+		field := patchType.Field(index)
+		if field.Type.Kind() != reflect.Pointer || !field.IsExported() {
+			continue
+		}
+
+		sourceField, ok := sourceType.FieldByName(field.Name)
+		if !ok || len(sourceField.Index) != 1 || sourceField.Type != field.Type.Elem() {
+			continue
+		}
+
+		mapping = append(mapping, patchField{name: field.Name, source: sourceField.Index[0], target: index})
+	}
+
+	if len(mapping) == 0 {
+		panic(fmt.Sprintf("patch: no pointer field of %s matches a field of %s", patchType, sourceType))
+	}
+
+	return &PatchFactory[T, P, Patch]{
+		source:  source,
+		mapping: mapping,
+	}
+}
+
+// Instantiate returns the prepared patch.
+func (f *PatchFactory[T, P, Patch]) Instantiate(properties PatchProperties[Patch]) Patch {
+	return properties.patch
+}
+
+// Prepare chooses the fields to set, unless listed through overrides, and copies their values from
+// a source instance built with the same seed.
+func (f *PatchFactory[T, P, Patch]) Prepare(overrides Partial[PatchProperties[Patch]], seed int64) PatchProperties[Patch] {
+	properties := PatchProperties[Patch]{
+		density: f.Density,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.density <= 0 {
+		properties.density = defaultDensity
+	}
+	if properties.fields == nil {
+		properties.fields = f.chooseFields(seed, properties.density)
+	}
+
+	if reflect.ValueOf(&properties.patch).Elem().IsZero() {
+		source := create(f.source, Overrider[P]{}, seed)
+		properties.patch = f.fill(source, properties.fields)
+	}
+
+	return properties
+}
+
+// Retrieve converts a patch back into PatchProperties, listing its non-nil candidate fields.
+func (f *PatchFactory[T, P, Patch]) Retrieve(instance Patch) PatchProperties[Patch] {
+	value := reflect.ValueOf(instance)
+
+	fields := []string{}
+	for _, field := range f.mapping {
+		if !value.Field(field.target).IsNil() {
+			fields = append(fields, field.name)
+		}
+	}
+
+	return PatchProperties[Patch]{
+		patch:  instance,
+		fields: fields,
+	}
+}
+
+func (f *PatchFactory[T, P, Patch]) chooseFields(seed int64, density float64) []string {
+	fields := []string{}
+	for index, field := range f.mapping {
+		if BoolAt((seed+int64(index))^patchSalt, density) {
+			fields = append(fields, field.name)
+		}
+	}
+
+	if len(fields) == 0 {
+		fields = append(fields, f.mapping[IntAt(seed, 0, int64(len(f.mapping)-1))].name)
+	}
+
+	return fields
+}
+
+func (f *PatchFactory[T, P, Patch]) fill(source T, fields []string) Patch {
+	var patch Patch
+
+	sourceValue := reflect.ValueOf(source)
+	patchValue := reflect.ValueOf(&patch).Elem()
+	for _, field := range f.mapping {
+		if !slices.Contains(fields, field.name) {
+			continue
+		}
+
+		pointer := reflect.New(sourceValue.Field(field.source).Type())
+		pointer.Elem().Set(sourceValue.Field(field.source))
+		patchValue.Field(field.target).Set(pointer)
+	}
+
+	return patch
+}
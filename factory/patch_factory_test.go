@@ -0,0 +1,114 @@
+package factory
+
+import (
+	"slices"
+	"testing"
+)
+
+type patchUser struct {
+	Name  string
+	Email string
+	Age   int
+}
+
+type patchUserFactory struct{}
+
+func (f *patchUserFactory) Instantiate(props patchUser) patchUser {
+	return props
+}
+
+func (f *patchUserFactory) Prepare(overrides Partial[patchUser], seed int64) patchUser {
+	props := patchUser{Name: "name", Email: "user@example.com", Age: int(seed%50) + 1}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *patchUserFactory) Retrieve(instance patchUser) patchUser {
+	return instance
+}
+
+type userPatch struct {
+	Name    *string
+	Email   *string
+	Age     *int
+	Comment *string
+}
+
+func TestPatchFactorySetsSubsetOfFields(t *testing.T) {
+	builder := Builder(NewPatchFactory[userPatch](&patchUserFactory{}))
+
+	counts := map[string]int{}
+	for _, patch := range builder.BuildListWith(300, 1, nil) {
+		set := 0
+		if patch.Name != nil {
+			counts["Name"]++
+			set++
+			if *patch.Name != "name" {
+				t.Fatalf("expected source value, got %q", *patch.Name)
+			}
+		}
+		if patch.Email != nil {
+			counts["Email"]++
+			set++
+		}
+		if patch.Age != nil {
+			counts["Age"]++
+			set++
+		}
+		if patch.Comment != nil {
+			t.Fatal("expected fields without a source counterpart to stay nil")
+		}
+		if set == 0 {
+			t.Fatal("expected at least one field to be set")
+		}
+	}
+
+	for _, field := range []string{"Name", "Email", "Age"} {
+		if counts[field] < 100 || counts[field] > 250 {
+			t.Fatalf("expected %s to be set about half the time, got %d of 300", field, counts[field])
+		}
+	}
+}
+
+func TestPatchFactoryWithExplicitFields(t *testing.T) {
+	builder := Builder(NewPatchFactory[userPatch](&patchUserFactory{}))
+
+	patch := builder.Build(Override[PatchProperties[userPatch]](map[string]any{"fields": []string{"Email"}}))
+
+	if patch.Email == nil || patch.Name != nil || patch.Age != nil {
+		t.Fatalf("expected only Email to be set, got %+v", patch)
+	}
+}
+
+func TestPatchFactoryDensity(t *testing.T) {
+	factory := NewPatchFactory[userPatch](&patchUserFactory{})
+	factory.Density = 1
+	builder := Builder(factory)
+
+	if patch := builder.Build(nil); patch.Name == nil || patch.Email == nil || patch.Age == nil {
+		t.Fatalf("expected density 1 to set every field, got %+v", patch)
+	}
+}
+
+func TestPatchFactoryRetrieve(t *testing.T) {
+	factory := NewPatchFactory[userPatch](&patchUserFactory{})
+	age := 3
+
+	properties := factory.Retrieve(userPatch{Age: &age})
+
+	if !slices.Equal(properties.fields, []string{"Age"}) {
+		t.Fatalf("expected Age to be listed, got %v", properties.fields)
+	}
+}
+
+func TestNewPatchFactoryPanicsWithoutMatchingFields(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic without matching fields")
+		}
+	}()
+
+	NewPatchFactory[struct{ Other *string }](&patchUserFactory{})
+}
@@ -0,0 +1,165 @@
+package factory
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PhoneFormat selects how PhoneFactory renders numbers.
+type PhoneFormat int
+
+// Phone number formats supported by PhoneFactory.
+const (
+	// PhoneE164 renders numbers as +<country code><number>, e.g. +14155552671.
+	PhoneE164 PhoneFormat = iota
+	// PhoneNational renders numbers the way the country dials them, e.g. (415) 555-2671.
+	PhoneNational
+)
+
+const phoneCountrySalt = 0x0F0E_C0DE
+
+// phoneCountry describes the numbering plan of a country. In template, '#' is any digit, 'N' is
+// a digit from 2 to 9 and every other rune is copied; trunk is dialled before national numbers.
+type phoneCountry struct {
+	callingCode string
+	trunk       string
+	template    string
+}
+
+var phoneCountries = map[string]phoneCountry{
+	"US": {callingCode: "1", template: "(N##) N##-####"},
+	"CA": {callingCode: "1", template: "(N##) N##-####"},
+	"GB": {callingCode: "44", trunk: "0", template: "7### ######"},
+	"DE": {callingCode: "49", trunk: "0", template: "15# ########"},
+	"FR": {callingCode: "33", trunk: "0", template: "6 ## ## ## ##"},
+	"JP": {callingCode: "81", trunk: "0", template: "N0-####-####"},
+	"AU": {callingCode: "61", trunk: "0", template: "4## ### ###"},
+}
+
+// phoneLocaleCountries is the country a locale dials by default; other locales use US.
+var phoneLocaleCountries = map[Locale]string{
+	LocaleEnglish:  "US",
+	LocaleJapanese: "JP",
+	LocaleGerman:   "DE",
+}
+
+// PhoneProperties carries the locale, country, national significant number and rendering of a
+// number.
+type PhoneProperties struct {
+	locale  Locale
+	country string
+	digits  string
+	value   string
+}
+
+// PhoneFactory generates phone numbers for Countries (ISO 3166-1 alpha-2 codes: US, CA, GB, DE,
+// FR, JP and AU), rendered in Format. When Countries is empty the country follows the locale: JP
+// for Japanese, DE for German and US otherwise. Numbers are deterministic per seed and follow each
+// country's mobile or geographic numbering plan. Prepare panics for unsupported countries.
+type PhoneFactory struct {
+	Countries []string
+	Format    PhoneFormat
+
+	locale Locale
+}
+
+// Localized returns a copy of the factory bound to locale.
+func (f *PhoneFactory) Localized(locale Locale) Factory[string, PhoneProperties] {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Instantiate returns the rendered number.
+func (f *PhoneFactory) Instantiate(properties PhoneProperties) string {
+	return properties.value
+}
+
+// Prepare picks a country and generates its digits; a non-empty country, digits or value from
+// overrides is kept. Without a bound locale the current locale picks the default country.
+func (f *PhoneFactory) Prepare(overrides Partial[PhoneProperties], seed int64) PhoneProperties {
+	properties := PhoneProperties{
+		locale: f.locale,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	properties.locale = resolveLocale(properties.locale)
+
+	if properties.value != "" {
+		return properties
+	}
+
+	if properties.country == "" {
+		fallback, ok := phoneLocaleCountries[properties.locale]
+		if !ok {
+			fallback = "US"
+		}
+		properties.country = pickString(f.Countries, []string{fallback}, seed^phoneCountrySalt)
+	}
+	country, ok := phoneCountries[strings.ToUpper(properties.country)]
+	if !ok {
+		panic(fmt.Sprintf("phone: unsupported country %q", properties.country))
+	}
+
+	national := fillPhoneTemplate(country.template, properties.digits, seed)
+	properties.digits = phoneDigits(national)
+
+	switch f.Format {
+	case PhoneNational:
+		properties.value = country.trunk + national
+	default:
+		properties.value = "+" + country.callingCode + properties.digits
+	}
+
+	return properties
+}
+
+// Retrieve wraps a rendered number into PhoneProperties.
+func (f *PhoneFactory) Retrieve(instance string) PhoneProperties {
+	return PhoneProperties{
+		value: instance,
+	}
+}
+
+// fillPhoneTemplate renders template with digits, or with digits drawn from seed when digits is
+// empty.
+func fillPhoneTemplate(template, digits string, seed int64) string {
+	var builder strings.Builder
+	position := 0
+	for _, character := range template {
+		switch character {
+		case '#', 'N':
+			if position < len(digits) {
+				builder.WriteByte(digits[position])
+			} else {
+				lowest := int64(0)
+				if character == 'N' {
+					lowest = 2
+				}
+				//nolint:gosec // G115: The digit is within 0-9
+				builder.WriteByte(byte('0' + IntAt(seed+int64(position), lowest, 9)))
+			}
+			position++
+		default:
+			builder.WriteRune(character)
+			if character >= '0' && character <= '9' {
+				position++
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// phoneDigits strips everything but digits from value.
+func phoneDigits(value string) string {
+	return strings.Map(func(character rune) rune {
+		if character >= '0' && character <= '9' {
+			return character
+		}
+		return -1
+	}, value)
+}
@@ -0,0 +1,96 @@
+package factory
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+func TestPhoneFactoryE164(t *testing.T) {
+	builder := Builder(&PhoneFactory{Countries: []string{"US", "GB", "DE", "FR", "JP", "AU", "CA"}})
+
+	prefixes := make(map[string]bool)
+	for _, number := range builder.BuildList(500, nil) {
+		if !e164Pattern.MatchString(number) {
+			t.Fatalf("expected an E.164 number, got %q", number)
+		}
+		prefixes[number[:3]] = true
+	}
+
+	if len(prefixes) < 5 {
+		t.Fatalf("expected numbers from several countries, got prefixes %v", prefixes)
+	}
+}
+
+func TestPhoneFactoryNationalFormats(t *testing.T) {
+	cases := map[string]*regexp.Regexp{
+		"US": regexp.MustCompile(`^\([2-9][0-9]{2}\) [2-9][0-9]{2}-[0-9]{4}$`),
+		"GB": regexp.MustCompile(`^07[0-9]{3} [0-9]{6}$`),
+		"JP": regexp.MustCompile(`^0[2-9]0-[0-9]{4}-[0-9]{4}$`),
+		"FR": regexp.MustCompile(`^06( [0-9]{2}){4}$`),
+	}
+
+	for country, pattern := range cases {
+		factory := &PhoneFactory{Countries: []string{country}, Format: PhoneNational}
+		for _, number := range Builder(factory).BuildList(100, nil) {
+			if !pattern.MatchString(number) {
+				t.Fatalf("unexpected %s number %q", country, number)
+			}
+		}
+	}
+}
+
+func TestPhoneFactoryFormatsAgreePerSeed(t *testing.T) {
+	e164 := &PhoneFactory{Countries: []string{"GB"}}
+	national := &PhoneFactory{Countries: []string{"GB"}, Format: PhoneNational}
+
+	for seed := range int64(50) {
+		international := Builder(e164).BuildWith(seed, nil)
+		local := Builder(national).BuildWith(seed, nil)
+		if international != "+44"+strings.TrimPrefix(phoneDigits(local), "0") {
+			t.Fatalf("expected %q and %q to be the same number", international, local)
+		}
+	}
+}
+
+func TestPhoneFactoryOverridesAndUnsupportedCountries(t *testing.T) {
+	builder := Builder(&PhoneFactory{})
+	number := builder.Build(Override[PhoneProperties](map[string]any{"country": "JP", "digits": "9012345678"}))
+	if number != "+819012345678" {
+		t.Fatalf("expected overridden digits, got %q", number)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for an unsupported country")
+		}
+	}()
+	builder.Build(Override[PhoneProperties](map[string]any{"country": "ZZ"}))
+}
+
+func TestPhoneFactoryFollowsLocale(t *testing.T) {
+	for locale, prefix := range map[Locale]string{LocaleJapanese: "+81", LocaleGerman: "+49", LocaleEnglish: "+1", "fr": "+1"} {
+		for _, number := range Builder(&PhoneFactory{}, WithLocale(locale)).BuildList(20, nil) {
+			if !strings.HasPrefix(number, prefix) {
+				t.Fatalf("expected %s numbers to start with %s, got %q", locale, prefix, number)
+			}
+		}
+	}
+
+	localized := Builder(&PhoneFactory{Countries: []string{"GB"}}, WithLocale(LocaleJapanese)).Build(nil)
+	if !strings.HasPrefix(localized, "+44") {
+		t.Fatalf("expected Countries to take precedence over the locale, got %q", localized)
+	}
+}
+
+func TestPhoneFactoryFollowsGlobalLocale(t *testing.T) {
+	SetLocale(LocaleJapanese)
+	t.Cleanup(func() { SetLocale("") })
+
+	number := Builder(&PhoneFactory{Format: PhoneNational}).Build(nil)
+	if !regexp.MustCompile(`^0[2-9]0-[0-9]{4}-[0-9]{4}$`).MatchString(number) {
+		t.Fatalf("expected a Japanese national number, got %q", number)
+	}
+}
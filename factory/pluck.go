@@ -0,0 +1,31 @@
+package factory
+
+type propertiesPreparer[P any] interface {
+	prepareList(size int) []P
+}
+
+// Pluck prepares n property sets with builder's seeds and extracts one value from each, without
+// instantiating T. Use it when a test only needs realistic values of a single field:
+//
+//	emails := factory.Pluck(users, 10, func(p UserProperties) string { return p.Email })
+func Pluck[T any, P any, V any](builder BuilderHandle[T, P], n int, extract func(P) V) []V {
+	preparer, ok := builder.(propertiesPreparer[P])
+	if !ok {
+		panic("pluck: builder must be created via Builder()")
+	}
+
+	values := make([]V, 0, n)
+	for _, properties := range preparer.prepareList(n) {
+		values = append(values, extract(properties))
+	}
+
+	return values
+}
+
+func (b *builderInstance[T, P]) prepareList(size int) []P {
+	results := make([]P, 0, size)
+	for _, seed := range b.nextSeeds(size) {
+		results = append(results, b.factory.Prepare(nil, seed))
+	}
+	return results
+}
@@ -0,0 +1,33 @@
+package factory
+
+import "testing"
+
+type pluckBuilder struct {
+	BuilderHandle[stubInstance, stubProps]
+}
+
+func TestPluckExtractsPreparedValues(t *testing.T) {
+	factory := &stubFactory{}
+	builder := Builder(factory)
+
+	seeds := Pluck(builder, 5, func(p stubProps) int64 { return p.Seed })
+
+	if len(seeds) != 5 {
+		t.Fatalf("expected 5 values, got %d", len(seeds))
+	}
+	for index, seed := range seeds {
+		if seed != factory.prepareSeeds[index] {
+			t.Fatalf("expected value from prepared seed %d, got %d", factory.prepareSeeds[index], seed)
+		}
+	}
+}
+
+func TestPluckPanicsForForeignBuilder(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for a builder not created via Builder")
+		}
+	}()
+
+	Pluck[stubInstance](pluckBuilder{}, 1, func(p stubProps) string { return p.Value })
+}
@@ -0,0 +1,69 @@
+package factory
+
+const pointerSalt = 0x0B7E_2A11
+
+// PointerProperties carries the wrapped value and whether the pointer is nil for PointerFactory.
+type PointerProperties[T any] struct {
+	value T
+	null  bool
+}
+
+// PointerFactory wraps another factory to produce *T, returning nil for a seed-determined
+// fraction of builds so optional fields get realistic nil coverage. NilProbability is that
+// fraction; NilWhen, when set, replaces it with a custom rule over the seed.
+type PointerFactory[T any, P any] struct {
+	inner          Factory[T, P]
+	NilProbability float64
+	NilWhen        func(seed int64) bool
+}
+
+// NewPointerFactory creates a PointerFactory around inner that never returns nil until
+// NilProbability or NilWhen is set.
+func NewPointerFactory[T any, P any](inner Factory[T, P]) *PointerFactory[T, P] {
+	return &PointerFactory[T, P]{
+		inner: inner,
+	}
+}
+
+// Instantiate returns nil or a pointer to a fresh copy of the value.
+func (f *PointerFactory[T, P]) Instantiate(properties PointerProperties[T]) *T {
+	if properties.null {
+		return nil
+	}
+
+	value := properties.value
+	return &value
+}
+
+// Prepare decides whether the pointer is nil and builds the value before applying overrides, so
+// overriding "null" with false is honored.
+func (f *PointerFactory[T, P]) Prepare(overrides Partial[PointerProperties[T]], seed int64) PointerProperties[T] {
+	properties := PointerProperties[T]{
+		value: create(f.inner, Overrider[P]{}, seed),
+		null:  f.isNil(seed),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return properties
+}
+
+// Retrieve converts a pointer back into PointerProperties.
+func (f *PointerFactory[T, P]) Retrieve(instance *T) PointerProperties[T] {
+	if instance == nil {
+		return PointerProperties[T]{null: true}
+	}
+
+	return PointerProperties[T]{
+		value: *instance,
+	}
+}
+
+func (f *PointerFactory[T, P]) isNil(seed int64) bool {
+	if f.NilWhen != nil {
+		return f.NilWhen(seed)
+	}
+	return BoolAt(seed^pointerSalt, f.NilProbability)
+}
@@ -0,0 +1,64 @@
+package factory
+
+import "testing"
+
+func TestPointerFactoryNilProbability(t *testing.T) {
+	pointers := NewPointerFactory(&IntFactory{Min: 1, Max: 10})
+	pointers.NilProbability = 0.3
+
+	nils := 0
+	for _, value := range Builder(pointers).BuildListWith(1000, 1, nil) {
+		if value == nil {
+			nils++
+			continue
+		}
+		if *value < 1 || *value > 10 {
+			t.Fatalf("value %d out of range", *value)
+		}
+	}
+
+	if nils < 200 || nils > 400 {
+		t.Fatalf("expected roughly 30%% nil, got %d of 1000", nils)
+	}
+}
+
+func TestPointerFactoryDefaultsToNonNil(t *testing.T) {
+	for _, value := range Builder(NewPointerFactory(&IntFactory{})).BuildList(100, nil) {
+		if value == nil {
+			t.Fatal("expected no nil without NilProbability")
+		}
+	}
+}
+
+func TestPointerFactoryNilWhen(t *testing.T) {
+	pointers := NewPointerFactory(&IntFactory{})
+	pointers.NilWhen = func(seed int64) bool { return seed%2 == 0 }
+	builder := Builder(pointers)
+
+	if builder.BuildWith(4, nil) != nil || builder.BuildWith(5, nil) == nil {
+		t.Fatal("expected nil exactly for even seeds")
+	}
+}
+
+func TestPointerFactoryOverrides(t *testing.T) {
+	pointers := NewPointerFactory(&IntFactory{})
+	pointers.NilProbability = 1
+	builder := Builder(pointers)
+
+	value := builder.Build(Override[PointerProperties[int]](map[string]any{"null": false, "value": 0}))
+	if value == nil || *value != 0 {
+		t.Fatalf("expected non-nil zero, got %v", value)
+	}
+}
+
+func TestPointerFactoryRetrieve(t *testing.T) {
+	pointers := NewPointerFactory(&IntFactory{})
+	value := 7
+
+	if properties := pointers.Retrieve(&value); properties.null || properties.value != 7 {
+		t.Fatalf("unexpected properties %+v", properties)
+	}
+	if properties := pointers.Retrieve(nil); !properties.null {
+		t.Fatal("expected nil pointer to retrieve as null")
+	}
+}
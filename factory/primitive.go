@@ -0,0 +1,181 @@
+package factory
+
+import (
+	stdmath "math"
+	"unicode/utf8"
+
+	"github.com/lihs-ie/forge/internal/math"
+)
+
+// IntAt returns a deterministic integer in [minimum, maximum] derived from seed without allocating.
+func IntAt(seed, minimum, maximum int64) int64 {
+	if maximum <= minimum {
+		return minimum
+	}
+
+	span := uint64(maximum) - uint64(minimum)
+	//nolint:gosec // G115: Two's complement reinterpretation keeps the seed bits intact
+	mixed := math.Mix64(uint64(seed))
+	if span == stdmath.MaxUint64 {
+		//nolint:gosec // G115: Full-range result intentionally wraps
+		return int64(mixed)
+	}
+
+	//nolint:gosec // G115: Offset is bounded by span, so the sum stays within [minimum, maximum]
+	return minimum + int64(mixed%(span+1))
+}
+
+// FloatAt returns a deterministic float in [minimum, maximum) derived from seed without allocating.
+func FloatAt(seed int64, minimum, maximum float64) float64 {
+	if maximum <= minimum {
+		return minimum
+	}
+
+	//nolint:gosec // G115: Two's complement reinterpretation keeps the seed bits intact
+	unit := float64(math.Mix64(uint64(seed))>>11) / (1 << 53)
+	return minimum + unit*(maximum-minimum)
+}
+
+// childSeed derives a non-negative seed for the index-th part of a value generated from seed,
+// uncorrelated with the seeds of neighbouring parts.
+func childSeed(seed int64, index int) int64 {
+	//nolint:gosec // G115: The shifted hash fits in int64
+	return int64(math.Mix64(uint64(seed)+uint64(index)+1) >> 1)
+}
+
+// BoolAt returns a deterministic bool derived from seed that is true with the given probability.
+func BoolAt(seed int64, probability float64) bool {
+	return FloatAt(seed, 0, 1) < probability
+}
+
+// Generate produces the same value as building with seed and no overrides, skipping the
+// Prepare/Override machinery. Only the returned string is allocated when Characters is ASCII.
+func (f *StringFactory) Generate(seed int64) string {
+	minLength, maxLength, characters := f.bounds()
+	if edge, ok := f.edgeCase(seed, minLength, maxLength, characters); ok {
+		return edge
+	}
+	return generateString(seed, minLength, maxLength, characters)
+}
+
+// AppendTo appends the value Generate would return for seed to dst without allocating
+// when dst has enough capacity and Characters is ASCII.
+func (f *StringFactory) AppendTo(dst []byte, seed int64) []byte {
+	minLength, maxLength, characters := f.bounds()
+	if edge, ok := f.edgeCase(seed, minLength, maxLength, characters); ok {
+		return append(dst, edge...)
+	}
+	return appendString(dst, seed, minLength, maxLength, characters)
+}
+
+func (f *StringFactory) bounds() (minLength, maxLength int, characters CharacterSet) {
+	minLength = f.Min
+	if minLength <= 0 {
+		minLength = 1
+	}
+
+	maxLength = f.Max
+	if maxLength <= 0 {
+		maxLength = 255
+	}
+	if maxLength < minLength {
+		maxLength = minLength
+	}
+
+	characters = f.Characters
+	if len(characters) == 0 {
+		characters = Characters.Alphanumeric
+	}
+
+	return minLength, maxLength, characters
+}
+
+func generateString(seed int64, minLength, maxLength int, characters CharacterSet) string {
+	length := stringLength(seed, minLength, maxLength)
+
+	if isASCII(characters) {
+		value := make([]byte, 0, length)
+		return string(appendString(value, seed, minLength, maxLength, characters))
+	}
+
+	value := make([]rune, length)
+	for index := range length {
+		value[index] = characterAt(seed, index, characters)
+	}
+
+	return string(value)
+}
+
+func appendString(dst []byte, seed int64, minLength, maxLength int, characters CharacterSet) []byte {
+	length := stringLength(seed, minLength, maxLength)
+
+	for index := range length {
+		dst = utf8.AppendRune(dst, characterAt(seed, index, characters))
+	}
+
+	return dst
+}
+
+func stringLength(seed int64, minLength, maxLength int) int {
+	offset := seed % int64(maxLength-minLength+1)
+	return minLength + int(offset)
+}
+
+func characterAt(seed int64, index int, characters CharacterSet) rune {
+	//nolint:gosec // G115: Controlled conversion for hash scrambling within expected range
+	scrambled := math.Scramble(uint32(seed + int64(index)))
+	return characters[int(scrambled)%len(characters)]
+}
+
+func isASCII(characters CharacterSet) bool {
+	for _, character := range characters {
+		if character >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// BoolProperties carries the generated value for BoolFactory.
+type BoolProperties struct {
+	value bool
+}
+
+// BoolFactory generates booleans that are true with Probability (0.5 when zero).
+type BoolFactory struct {
+	Probability float64
+}
+
+// Instantiate returns the prepared bool.
+func (f *BoolFactory) Instantiate(properties BoolProperties) bool {
+	return properties.value
+}
+
+// Prepare generates the value before applying overrides, so overriding with false is honored.
+func (f *BoolFactory) Prepare(overrides Partial[BoolProperties], seed int64) BoolProperties {
+	properties := BoolProperties{
+		value: f.Generate(seed),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return properties
+}
+
+// Retrieve converts a bool back into BoolProperties.
+func (f *BoolFactory) Retrieve(instance bool) BoolProperties {
+	return BoolProperties{
+		value: instance,
+	}
+}
+
+// Generate produces the same value as building with seed and no overrides, without allocating.
+func (f *BoolFactory) Generate(seed int64) bool {
+	probability := f.Probability
+	if probability <= 0 {
+		probability = 0.5
+	}
+	return BoolAt(seed, probability)
+}
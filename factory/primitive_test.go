@@ -0,0 +1,137 @@
+package factory
+
+import "testing"
+
+func TestStringFactoryGenerateMatchesBuild(t *testing.T) {
+	factories := []*StringFactory{
+		{},
+		{Min: 3, Max: 9, Characters: Characters.Symbol},
+		{Min: 2, Max: 4, Characters: CharacterSet{'あ', 'い', 'う'}},
+	}
+
+	for _, factory := range factories {
+		builder := Builder(factory)
+		for seed := range int64(20) {
+			if generated, built := factory.Generate(seed), builder.BuildWith(seed, nil); generated != built {
+				t.Fatalf("seed %d: Generate %q differs from Build %q", seed, generated, built)
+			}
+			if appended := string(factory.AppendTo(nil, seed)); appended != factory.Generate(seed) {
+				t.Fatalf("seed %d: AppendTo %q differs from Generate", seed, appended)
+			}
+		}
+	}
+}
+
+func TestIntAtRange(t *testing.T) {
+	for seed := range int64(200) {
+		if value := IntAt(seed, -5, 5); value < -5 || value > 5 {
+			t.Fatalf("value %d out of range", value)
+		}
+	}
+
+	if IntAt(3, 7, 7) != 7 {
+		t.Error("expected degenerate range to return minimum")
+	}
+	if IntAt(11, -100, 100) != IntAt(11, -100, 100) {
+		t.Error("expected deterministic value")
+	}
+}
+
+func TestFloatAtRange(t *testing.T) {
+	for seed := range int64(200) {
+		if value := FloatAt(seed, 1.5, 2.5); value < 1.5 || value >= 2.5 {
+			t.Fatalf("value %v out of range", value)
+		}
+	}
+}
+
+func TestBoolFactory(t *testing.T) {
+	builder := Builder(&BoolFactory{Probability: 0.2})
+
+	trues := 0
+	for _, value := range builder.BuildListWith(1000, 0, nil) {
+		if value {
+			trues++
+		}
+	}
+	if trues < 150 || trues > 250 {
+		t.Fatalf("expected about 20%% true, got %d/1000", trues)
+	}
+
+	forced := builder.Build(Override[BoolProperties](map[string]any{"value": false}))
+	if forced {
+		t.Fatal("expected override to force false")
+	}
+}
+
+func TestPrimitiveFastPathsDoNotAllocate(t *testing.T) {
+	factory := &StringFactory{Min: 8, Max: 16}
+	buffer := make([]byte, 0, 64)
+	boolFactory := &BoolFactory{}
+	seed := int64(0)
+
+	allocations := testing.AllocsPerRun(100, func() {
+		seed++
+		buffer = factory.AppendTo(buffer[:0], seed)
+		_ = IntAt(seed, 0, 1000)
+		_ = FloatAt(seed, 0, 1)
+		_ = boolFactory.Generate(seed)
+	})
+	if allocations != 0 {
+		t.Fatalf("expected no allocations, got %v", allocations)
+	}
+
+	if allocations := testing.AllocsPerRun(100, func() {
+		seed++
+		_ = factory.Generate(seed)
+	}); allocations > 1 {
+		t.Fatalf("expected at most one allocation for Generate, got %v", allocations)
+	}
+}
+
+func BenchmarkStringFactoryBuild(b *testing.B) {
+	builder := Builder(&StringFactory{Min: 8, Max: 16})
+	b.ReportAllocs()
+	for index := range b.N {
+		_ = builder.BuildWith(int64(index), nil)
+	}
+}
+
+func BenchmarkStringFactoryGenerate(b *testing.B) {
+	factory := &StringFactory{Min: 8, Max: 16}
+	b.ReportAllocs()
+	for index := range b.N {
+		_ = factory.Generate(int64(index))
+	}
+}
+
+func BenchmarkStringFactoryAppendTo(b *testing.B) {
+	factory := &StringFactory{Min: 8, Max: 16}
+	buffer := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for index := range b.N {
+		buffer = factory.AppendTo(buffer[:0], int64(index))
+	}
+}
+
+func BenchmarkIntAt(b *testing.B) {
+	b.ReportAllocs()
+	for index := range b.N {
+		_ = IntAt(int64(index), 0, 1_000_000)
+	}
+}
+
+func BenchmarkFloatAt(b *testing.B) {
+	b.ReportAllocs()
+	for index := range b.N {
+		_ = FloatAt(int64(index), 0, 1)
+	}
+}
+
+func BenchmarkBoolFactoryGenerate(b *testing.B) {
+	factory := &BoolFactory{}
+	b.ReportAllocs()
+	for index := range b.N {
+		_ = factory.Generate(int64(index))
+	}
+}
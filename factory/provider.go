@@ -0,0 +1,100 @@
+package factory
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Provider is a named, type-erased factory contributed by a third-party package through
+// RegisterProvider, so it can be discovered by name from scenario files.
+type Provider struct {
+	name      string
+	construct func() any
+	build     func(count int, seed int64, overrides map[string]any) []any
+}
+
+var (
+	providersMu sync.RWMutex
+	providers   = make(map[string]Provider)
+)
+
+// RegisterProvider makes the factories returned by constructor available under name. Packages
+// usually call it from init, in the style of database/sql drivers:
+//
+//	func init() {
+//		factory.RegisterProvider("vehicle.vin", func() factory.Factory[string, VINProperties] {
+//			return &VINFactory{}
+//		})
+//	}
+//
+// It panics when name is empty or already registered.
+func RegisterProvider[T any, P any](name string, constructor func() Factory[T, P]) {
+	if name == "" || constructor == nil {
+		panic("provider: name and constructor are required")
+	}
+
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	if _, exists := providers[name]; exists {
+		panic(fmt.Sprintf("provider: %q is already registered", name))
+	}
+
+	providers[name] = Provider{
+		name:      name,
+		construct: func() any { return constructor() },
+		build: func(count int, seed int64, overrides map[string]any) []any {
+			var override any
+			if len(overrides) > 0 {
+				override = Override[P](overrides)
+			}
+
+			instances := Builder(constructor()).BuildListWith(count, seed, override)
+			result := make([]any, len(instances))
+			for index, instance := range instances {
+				result[index] = instance
+			}
+			return result
+		},
+	}
+}
+
+// LookupProvider returns the provider registered under name.
+func LookupProvider(name string) (Provider, bool) {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+
+	provider, ok := providers[name]
+	return provider, ok
+}
+
+// Providers returns the names of every registered provider in sorted order.
+func Providers() []string {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Name returns the name the provider was registered under.
+func (p Provider) Name() string {
+	return p.name
+}
+
+// New returns a fresh factory; assert it to the Factory type the provider was registered with.
+func (p Provider) New() any {
+	return p.construct()
+}
+
+// BuildListWith builds count instances with consecutive seeds starting at seed, applying
+// overrides as an Override literal for the provider's properties type.
+func (p Provider) BuildListWith(count int, seed int64, overrides map[string]any) []any {
+	return p.build(count, seed, overrides)
+}
@@ -0,0 +1,51 @@
+package factory
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRegisterProvider(t *testing.T) {
+	RegisterProvider("test.code", func() Factory[string, StringProperties] {
+		return &StringFactory{Min: 4, Max: 4, Characters: Characters.Numeric}
+	})
+
+	provider, ok := LookupProvider("test.code")
+	if !ok {
+		t.Fatal("expected provider to be registered")
+	}
+	if provider.Name() != "test.code" || !slices.Contains(Providers(), "test.code") {
+		t.Fatalf("expected provider to be listed, got %v", Providers())
+	}
+
+	if _, ok := provider.New().(Factory[string, StringProperties]); !ok {
+		t.Fatalf("expected New to return the registered factory type, got %T", provider.New())
+	}
+
+	values := provider.BuildListWith(3, 1, map[string]any{"value": "0000"})
+	if len(values) != 3 || values[0] != "0000" {
+		t.Fatalf("expected overridden values, got %v", values)
+	}
+	if generated := provider.BuildListWith(2, 1, nil); len(generated[0].(string)) != 4 {
+		t.Fatalf("expected generated code of length 4, got %v", generated)
+	}
+}
+
+func TestRegisterProviderPanicsOnDuplicate(t *testing.T) {
+	constructor := func() Factory[bool, BoolProperties] { return &BoolFactory{} }
+	RegisterProvider("test.duplicate", constructor)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for duplicate provider")
+		}
+	}()
+
+	RegisterProvider("test.duplicate", constructor)
+}
+
+func TestLookupProviderMissing(t *testing.T) {
+	if _, ok := LookupProvider("test.missing"); ok {
+		t.Fatal("expected missing provider")
+	}
+}
@@ -0,0 +1,84 @@
+package factory
+
+import "fmt"
+
+// quota caps how often candidate may be chosen within each window of consecutive seeds.
+type quota[T comparable] struct {
+	candidate T
+	limit     int
+	window    int
+}
+
+// quotas decides from the seed alone whether a candidate may be chosen, so Prepare stays
+// deterministic and internal builds consume nothing. For every quota, exactly limit evenly
+// spaced residues of seed modulo window admit the candidate; any window consecutive seeds
+// therefore hold at most limit of it. Each quota's residues are shifted past those of the quotas
+// registered before it, so candidates sharing a window take turns instead of colliding.
+type quotas[T comparable] []quota[T]
+
+// with returns a copy holding the existing quotas plus the new one, replacing any earlier quota
+// for candidate.
+func (q quotas[T]) with(candidate T, limit, window int) quotas[T] {
+	if limit < 0 || window <= 0 {
+		panic(fmt.Sprintf("quota: invalid limit %d per window %d", limit, window))
+	}
+
+	next := make(quotas[T], 0, len(q)+1)
+	for _, existing := range q {
+		if existing.candidate != candidate {
+			next = append(next, existing)
+		}
+	}
+
+	return append(next, quota[T]{candidate: candidate, limit: limit, window: window})
+}
+
+// filter returns the candidates the quotas admit for seed. Without quotas it returns candidates
+// unchanged. It panics when no candidate is admitted.
+func (q quotas[T]) filter(candidates []T, seed int64) []T {
+	if len(q) == 0 {
+		return candidates
+	}
+
+	allowed := make([]T, 0, len(candidates))
+	for _, candidate := range candidates {
+		if q.allows(candidate, seed) {
+			allowed = append(allowed, candidate)
+		}
+	}
+	if len(allowed) == 0 {
+		panic(fmt.Sprintf("quota: every candidate has exhausted its quota for seed %d", seed))
+	}
+
+	return allowed
+}
+
+func (q quotas[T]) allows(candidate T, seed int64) bool {
+	offset := 0
+	for _, limit := range q {
+		if limit.candidate == candidate {
+			return admits(limit.limit, limit.window, offset, seed)
+		}
+		offset += limit.limit
+	}
+
+	return true
+}
+
+// admits reports whether seed falls on one of the limit residues modulo window, which sit at
+// floor(i*window/limit) shifted by offset.
+func admits(limit, window, offset int, seed int64) bool {
+	if limit >= window {
+		return true
+	}
+	if limit == 0 {
+		return false
+	}
+
+	span := int64(window)
+	residue := (seed%span + span) % span
+	residue = (residue - int64(offset)%span + span) % span
+	index := (residue*int64(limit) + span - 1) / span
+
+	return index < int64(limit) && index*span/int64(limit) == residue
+}
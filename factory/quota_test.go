@@ -0,0 +1,65 @@
+package factory
+
+import "testing"
+
+func TestQuotasLimitEveryWindowOfConsecutiveSeeds(t *testing.T) {
+	limits := quotas[string](nil).with("admin", 2, 10)
+
+	for start := int64(-25); start < 25; start++ {
+		admins := 0
+		for seed := start; seed < start+10; seed++ {
+			if limits.allows("admin", seed) {
+				admins++
+			}
+		}
+		if admins != 2 {
+			t.Fatalf("expected 2 admins in the window starting at %d, got %d", start, admins)
+		}
+	}
+}
+
+func TestQuotasShiftLaterQuotasPastEarlierOnes(t *testing.T) {
+	limits := quotas[string](nil).with("a", 1, 2).with("b", 1, 2)
+
+	for seed := range int64(10) {
+		if limits.allows("a", seed) == limits.allows("b", seed) {
+			t.Fatalf("expected exactly one of a and b to be allowed for seed %d", seed)
+		}
+	}
+}
+
+func TestQuotasWithoutQuotasKeepCandidates(t *testing.T) {
+	var limits quotas[string]
+
+	if allowed := limits.filter([]string{"a", "b"}, 3); len(allowed) != 2 {
+		t.Fatalf("expected every candidate to be kept, got %v", allowed)
+	}
+}
+
+func TestQuotasReplaceEarlierQuota(t *testing.T) {
+	limits := quotas[string](nil).with("admin", 0, 5).with("admin", 5, 5)
+
+	if len(limits) != 1 || !limits.allows("admin", 1) {
+		t.Fatalf("expected the later quota to replace the earlier one, got %v", limits)
+	}
+}
+
+func TestQuotasPanicWhenExhausted(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic when every candidate is exhausted")
+		}
+	}()
+
+	quotas[string](nil).with("only", 0, 5).filter([]string{"only"}, 0)
+}
+
+func TestQuotasRejectInvalidWindow(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for zero window")
+		}
+	}()
+
+	quotas[string](nil).with("admin", 1, 0)
+}
@@ -0,0 +1,145 @@
+package factory
+
+import "sync"
+
+// Ref carries a value (typically an ID) published by one factory and consumed by others.
+type Ref[K any] struct {
+	mu       sync.Mutex
+	value    K
+	set      bool
+	fallback func() K
+}
+
+// NewRef creates an empty Ref. When nothing has been published yet, Get resolves fallback
+// (if non-nil) and keeps its result.
+func NewRef[K any](fallback func() K) *Ref[K] {
+	return &Ref[K]{fallback: fallback}
+}
+
+// Set publishes value, replacing any previous one.
+func (r *Ref[K]) Set(value K) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.value = value
+	r.set = true
+}
+
+// Get returns the latest published value. It panics when nothing was published and no fallback exists.
+func (r *Ref[K]) Get() K {
+	r.mu.Lock()
+	if r.set {
+		defer r.mu.Unlock()
+		return r.value
+	}
+	fallback := r.fallback
+	r.mu.Unlock()
+
+	if fallback == nil {
+		panic("ref: no value has been published")
+	}
+
+	value := fallback()
+	r.Set(value)
+
+	return value
+}
+
+// IsSet reports whether a value has been published.
+func (r *Ref[K]) IsSet() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.set
+}
+
+// Lazy returns a Lazy that reads the Ref on first access.
+func (r *Ref[K]) Lazy() *Lazy[K] {
+	return NewLazy(r.Get)
+}
+
+// Reset forgets the published value.
+func (r *Ref[K]) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var zero K
+	r.value = zero
+	r.set = false
+}
+
+// PublishFactory publishes a key of every instance built by an inner factory into a Ref.
+type PublishFactory[T any, P any, K any] struct {
+	inner Factory[T, P]
+	ref   *Ref[K]
+	key   func(T) K
+}
+
+var _ Factory[any, any] = (*PublishFactory[any, any, any])(nil)
+
+// Publish wraps inner so that key(instance) is stored in ref each time an instance is built.
+func Publish[T any, P any, K any](inner Factory[T, P], ref *Ref[K], key func(T) K) *PublishFactory[T, P, K] {
+	return &PublishFactory[T, P, K]{
+		inner: inner,
+		ref:   ref,
+		key:   key,
+	}
+}
+
+// Instantiate delegates to the inner factory and publishes the instance key.
+func (f *PublishFactory[T, P, K]) Instantiate(properties P) T {
+	instance := f.inner.Instantiate(properties)
+	f.ref.Set(f.key(instance))
+	return instance
+}
+
+// Prepare delegates to the inner factory.
+func (f *PublishFactory[T, P, K]) Prepare(overrides Partial[P], seed int64) P {
+	return f.inner.Prepare(overrides, seed)
+}
+
+// Retrieve delegates to the inner factory.
+func (f *PublishFactory[T, P, K]) Retrieve(instance T) P {
+	return f.inner.Retrieve(instance)
+}
+
+// ConsumeFactory fills a property of an inner factory from a Ref.
+type ConsumeFactory[T any, P any, K any] struct {
+	inner Factory[T, P]
+	ref   *Ref[K]
+	field string
+}
+
+var _ Factory[any, any] = (*ConsumeFactory[any, any, any])(nil)
+
+// Consume wraps inner so that the property named field is set from ref before caller overrides run.
+// The field is matched like an Override key.
+func Consume[T any, P any, K any](inner Factory[T, P], ref *Ref[K], field string) *ConsumeFactory[T, P, K] {
+	return &ConsumeFactory[T, P, K]{
+		inner: inner,
+		ref:   ref,
+		field: field,
+	}
+}
+
+// Instantiate delegates to the inner factory.
+func (f *ConsumeFactory[T, P, K]) Instantiate(properties P) T {
+	return f.inner.Instantiate(properties)
+}
+
+// Prepare assigns the referenced value and then applies overrides.
+func (f *ConsumeFactory[T, P, K]) Prepare(overrides Partial[P], seed int64) P {
+	reference := Override[P](map[string]any{f.field: f.ref.Get()})
+
+	return f.inner.Prepare(func(properties *P) {
+		reference.Apply(properties)
+		if overrides != nil {
+			overrides(properties)
+		}
+	}, seed)
+}
+
+// Retrieve delegates to the inner factory.
+func (f *ConsumeFactory[T, P, K]) Retrieve(instance T) P {
+	return f.inner.Retrieve(instance)
+}
@@ -0,0 +1,82 @@
+package factory
+
+import "testing"
+
+func TestRefPublishAndConsume(t *testing.T) {
+	userID := NewRef[string](nil)
+	users := Builder(Publish(&stubFactory{}, userID, func(user stubInstance) string {
+		return user.Value
+	}))
+	orders := Builder(Consume(&stubFactory{}, userID, "Value"))
+
+	user := users.BuildWith(1, nil)
+	order := orders.BuildWith(2, nil)
+
+	if order.Value != user.Value {
+		t.Fatalf("expected order to reference %s, got %s", user.Value, order.Value)
+	}
+	if order.Seed != 2 {
+		t.Fatalf("expected consumer to keep its own seed, got %d", order.Seed)
+	}
+
+	latest := users.BuildWith(3, nil)
+	if next := orders.Build(nil); next.Value != latest.Value {
+		t.Fatalf("expected latest published value %s, got %s", latest.Value, next.Value)
+	}
+}
+
+func TestRefConsumeAllowsOverride(t *testing.T) {
+	ref := NewRef[string](nil)
+	ref.Set("user-1")
+	orders := Builder(Consume(&stubFactory{}, ref, "Value"))
+
+	order := orders.Build(Override[stubProps](map[string]any{"Value": "explicit"}))
+
+	if order.Value != "explicit" {
+		t.Fatalf("expected caller override to win, got %s", order.Value)
+	}
+}
+
+func TestRefFallback(t *testing.T) {
+	calls := 0
+	ref := NewRef(func() string {
+		calls++
+		return "fallback"
+	})
+
+	if ref.IsSet() {
+		t.Fatal("expected empty ref")
+	}
+	if ref.Get() != "fallback" || ref.Get() != "fallback" {
+		t.Fatal("expected fallback value")
+	}
+	if calls != 1 {
+		t.Fatalf("expected fallback to run once, got %d", calls)
+	}
+
+	ref.Reset()
+	if ref.IsSet() {
+		t.Fatal("expected reset ref to be empty")
+	}
+}
+
+func TestRefLazy(t *testing.T) {
+	ref := NewRef[int](nil)
+	lazy := ref.Lazy()
+
+	ref.Set(7)
+
+	if lazy.Get() != 7 {
+		t.Fatalf("expected lazy to read published value, got %d", lazy.Get())
+	}
+}
+
+func TestRefGetPanicsWithoutValue(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic for empty ref")
+		}
+	}()
+
+	NewRef[int](nil).Get()
+}
@@ -0,0 +1,172 @@
+package factory
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+const defaultRegexMaxRepeat = 8
+
+// RegexProperties carries the generated string for RegexFactory.
+type RegexProperties struct {
+	value string
+}
+
+// RegexFactory generates strings matching a regular expression, for formatted values such as
+// SKUs, order numbers and licence plates. Unbounded repetitions (*, + and {n,}) repeat at most
+// MaxRepeat times beyond their minimum (8 when zero). Character classes prefer printable ASCII
+// and fall back to their full ranges; anchors and word boundaries generate nothing, so patterns
+// relying on \b may produce strings that do not match.
+type RegexFactory struct {
+	MaxRepeat int
+
+	pattern string
+	program *syntax.Regexp
+}
+
+// NewRegexFactory parses pattern with Perl syntax, like regexp.MustCompile, and panics when it is
+// invalid.
+func NewRegexFactory(pattern string) *RegexFactory {
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		panic(fmt.Sprintf("regex: parse %q: %v", pattern, err))
+	}
+
+	return &RegexFactory{
+		pattern: pattern,
+		program: parsed.Simplify(),
+	}
+}
+
+// Pattern returns the regular expression the factory was created with.
+func (f *RegexFactory) Pattern() string {
+	return f.pattern
+}
+
+// Instantiate returns the generated string.
+func (f *RegexFactory) Instantiate(properties RegexProperties) string {
+	return properties.value
+}
+
+// Prepare generates a matching string unless overridden.
+func (f *RegexFactory) Prepare(overrides Partial[RegexProperties], seed int64) RegexProperties {
+	properties := RegexProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value == "" {
+		properties.value = f.Generate(seed)
+	}
+
+	return properties
+}
+
+// Retrieve wraps an existing string into RegexProperties.
+func (f *RegexFactory) Retrieve(instance string) RegexProperties {
+	return RegexProperties{
+		value: instance,
+	}
+}
+
+// Generate produces the same string as building with seed and no overrides.
+func (f *RegexFactory) Generate(seed int64) string {
+	maxRepeat := f.MaxRepeat
+	if maxRepeat <= 0 {
+		maxRepeat = defaultRegexMaxRepeat
+	}
+
+	generator := &regexGenerator{seed: seed, maxRepeat: maxRepeat}
+	generator.write(f.program)
+	return generator.builder.String()
+}
+
+// regexGenerator walks a parsed expression, drawing each choice from the next step of seed.
+type regexGenerator struct {
+	seed      int64
+	step      int64
+	maxRepeat int
+	builder   strings.Builder
+}
+
+func (g *regexGenerator) next(minimum, maximum int) int {
+	g.step++
+	return int(IntAt(g.seed+g.step, int64(minimum), int64(maximum)))
+}
+
+func (g *regexGenerator) write(expression *syntax.Regexp) {
+	switch expression.Op {
+	case syntax.OpLiteral:
+		for _, character := range expression.Rune {
+			if expression.Flags&syntax.FoldCase != 0 && g.next(0, 1) == 1 {
+				character = unicode.SimpleFold(character)
+			}
+			g.builder.WriteRune(character)
+		}
+	case syntax.OpCharClass:
+		g.builder.WriteRune(g.class(expression.Rune))
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		//nolint:gosec // G115: The character is within printable ASCII
+		g.builder.WriteRune(rune(g.next(' ', '~')))
+	case syntax.OpCapture:
+		g.write(expression.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range expression.Sub {
+			g.write(sub)
+		}
+	case syntax.OpAlternate:
+		g.write(expression.Sub[g.next(0, len(expression.Sub)-1)])
+	case syntax.OpStar:
+		g.repeat(expression.Sub[0], 0, -1)
+	case syntax.OpPlus:
+		g.repeat(expression.Sub[0], 1, -1)
+	case syntax.OpQuest:
+		g.repeat(expression.Sub[0], 0, 1)
+	case syntax.OpRepeat:
+		g.repeat(expression.Sub[0], expression.Min, expression.Max)
+	}
+}
+
+// repeat writes expression minimum to maximum times; a negative maximum is unbounded.
+func (g *regexGenerator) repeat(expression *syntax.Regexp, minimum, maximum int) {
+	if maximum < 0 {
+		maximum = minimum + g.maxRepeat
+	}
+	for range g.next(minimum, maximum) {
+		g.write(expression)
+	}
+}
+
+// class picks a character from the ranges of a character class, given as inclusive pairs,
+// preferring printable ASCII.
+func (g *regexGenerator) class(ranges []rune) rune {
+	printable := make([]rune, 0, len(ranges))
+	for index := 0; index+1 < len(ranges); index += 2 {
+		low, high := max(ranges[index], ' '), min(ranges[index+1], '~')
+		if low <= high {
+			printable = append(printable, low, high)
+		}
+	}
+	if len(printable) > 0 {
+		ranges = printable
+	}
+
+	total := 0
+	for index := 0; index+1 < len(ranges); index += 2 {
+		total += int(ranges[index+1]-ranges[index]) + 1
+	}
+
+	offset := g.next(0, total-1)
+	for index := 0; index+1 < len(ranges); index += 2 {
+		size := int(ranges[index+1]-ranges[index]) + 1
+		if offset < size {
+			//nolint:gosec // G115: The offset is within the range size
+			return ranges[index] + rune(offset)
+		}
+		offset -= size
+	}
+	return unicode.ReplacementChar
+}
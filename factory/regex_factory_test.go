@@ -0,0 +1,63 @@
+package factory
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestRegexFactoryMatchesPattern(t *testing.T) {
+	patterns := []string{
+		`^SKU-[A-Z]{3}-\d{4}$`,
+		`^ORD[0-9]{8}(-[A-Z])?$`,
+		`^[A-Z]{2}-[0-9]{1,4}-[A-Z]{1,3}$`,
+		`^(foo|bar|baz)+\.(com|net)$`,
+		`^[^\s]{3,6}\w*$`,
+		`^(?i)abc[a-f]$`,
+		`^.+@example\.org$`,
+		`^\p{Greek}{2}\d?$`,
+	}
+
+	for _, pattern := range patterns {
+		compiled := regexp.MustCompile(pattern)
+		for index, value := range Builder(NewRegexFactory(pattern)).BuildListWith(100, 1, nil) {
+			if !compiled.MatchString(value) {
+				t.Fatalf("value %d %q does not match %s", index, value, pattern)
+			}
+		}
+	}
+}
+
+func TestRegexFactoryIsDeterministic(t *testing.T) {
+	factory := NewRegexFactory(`[a-z]{4}-\d+`)
+
+	for seed := range int64(20) {
+		if Builder(factory).BuildWith(seed, nil) != factory.Generate(seed) {
+			t.Fatalf("expected Build and Generate to agree for seed %d", seed)
+		}
+	}
+
+	if NewRegexFactory(`[a-z]{12}`).Generate(1) == NewRegexFactory(`[a-z]{12}`).Generate(2) {
+		t.Fatal("expected different seeds to generate different strings")
+	}
+}
+
+func TestRegexFactoryMaxRepeat(t *testing.T) {
+	factory := NewRegexFactory(`a*`)
+	factory.MaxRepeat = 3
+
+	for _, value := range Builder(factory).BuildListWith(50, 1, nil) {
+		if len(value) > 3 {
+			t.Fatalf("expected at most 3 repetitions, got %q", value)
+		}
+	}
+}
+
+func TestRegexFactoryPanicsForInvalidPattern(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for an invalid pattern")
+		}
+	}()
+
+	NewRegexFactory(`[a-`)
+}
@@ -0,0 +1,109 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Requiring is implemented by factories whose properties have fields that must never stay zero,
+// e.g. fields new business logic depends on. Builders check them after Prepare and overrides.
+type Requiring interface {
+	RequiredFields() []string
+}
+
+// Required declares properties fields that must not be zero once Prepare and overrides ran,
+// taking precedence over the fields reported by a Requiring factory. Field names match like
+// override keys, case-insensitively. Build panics with a *RequiredFieldsError when a field stays
+// zero; BuildE returns it.
+func Required(fields ...string) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.required = fields
+	}
+}
+
+// RequiredFieldsError reports the required fields left zero by a build.
+type RequiredFieldsError struct {
+	Type   string
+	Fields []string
+}
+
+func (e *RequiredFieldsError) Error() string {
+	return fmt.Sprintf("required: %s fields left zero: %s", e.Type, strings.Join(e.Fields, ", "))
+}
+
+// requiredFactory checks the required fields of the final properties before instantiating.
+type requiredFactory[T any, P any] struct {
+	inner  Factory[T, P]
+	fields []string
+}
+
+// newRequiredFactory wraps inner, panicking when a field does not exist on P.
+func newRequiredFactory[T any, P any](inner Factory[T, P], fields []string) *requiredFactory[T, P] {
+	typ := reflect.TypeFor[P]()
+	if typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("required: %s is not a struct", typ))
+	}
+
+	canonical := make([]string, len(fields))
+	for index, name := range fields {
+		_, field, ok := lookupField(reflect.New(typ).Elem(), name, true)
+		if !ok {
+			panic(fmt.Sprintf("required: %s has no field %q", typ, name))
+		}
+		canonical[index] = field.Name
+	}
+
+	return &requiredFactory[T, P]{inner: inner, fields: canonical}
+}
+
+func (f *requiredFactory[T, P]) Instantiate(properties P) T {
+	value := reflect.ValueOf(&properties).Elem()
+
+	var missing []string
+	for _, name := range f.fields {
+		field, _, ok := lookupField(value, name, false)
+		if !ok || field.IsZero() {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(&RequiredFieldsError{Type: value.Type().Name(), Fields: missing})
+	}
+
+	return f.inner.Instantiate(properties)
+}
+
+func (f *requiredFactory[T, P]) Prepare(overrides Partial[P], seed int64) P {
+	return f.inner.Prepare(overrides, seed)
+}
+
+func (f *requiredFactory[T, P]) Retrieve(instance T) P {
+	return f.inner.Retrieve(instance)
+}
+
+func (f *requiredFactory[T, P]) memoized(seed int64, build func() T) T {
+	if memoizer, ok := f.inner.(seedMemoizer[T]); ok {
+		return memoizer.memoized(seed, build)
+	}
+	return build()
+}
+
+// recoverRequired turns a *RequiredFieldsError panic into err and re-panics anything else.
+func recoverRequired(err *error) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+
+	if required, ok := recovered.(error); ok {
+		var requiredErr *RequiredFieldsError
+		if errors.As(required, &requiredErr) {
+			*err = requiredErr
+			return
+		}
+	}
+
+	panic(recovered)
+}
@@ -0,0 +1,75 @@
+package factory
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type requiringStubFactory struct {
+	stubFactory
+}
+
+func (f *requiringStubFactory) RequiredFields() []string {
+	return []string{"Value"}
+}
+
+func TestBuildEReportsZeroRequiredFields(t *testing.T) {
+	builder := Builder(&stubFactory{}, Required("value", "Seed"))
+
+	if _, err := BuildE(builder, nil); err != nil {
+		t.Fatalf("expected generated fields to satisfy requirements, got %v", err)
+	}
+
+	_, err := BuildE(builder, Override[stubProps](map[string]any{"Value": ""}))
+
+	var required *RequiredFieldsError
+	if !errors.As(err, &required) || len(required.Fields) != 1 || required.Fields[0] != "Value" {
+		t.Fatalf("expected Value to be reported, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "stubProps") {
+		t.Fatalf("expected the properties type in %q", err)
+	}
+}
+
+func TestBuildPanicsOnZeroRequiredFields(t *testing.T) {
+	builder := Builder(&requiringStubFactory{})
+
+	defer func() {
+		if _, ok := recover().(*RequiredFieldsError); !ok {
+			t.Fatal("expected a *RequiredFieldsError panic")
+		}
+	}()
+
+	builder.Build(Override[stubProps](map[string]any{"Value": ""}))
+}
+
+func TestRequiredOptionOverridesRequiringFactory(t *testing.T) {
+	builder := Builder(&requiringStubFactory{}, Required("Seed"))
+
+	if _, err := BuildE(builder, Override[stubProps](map[string]any{"Value": ""})); err != nil {
+		t.Fatalf("expected the option to replace the declared fields, got %v", err)
+	}
+}
+
+func TestRequiredRejectsUnknownFields(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for an unknown field")
+		}
+	}()
+
+	Builder(&stubFactory{}, Required("Missing"))
+}
+
+func TestBuildEPropagatesOtherPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected unrelated panics to propagate")
+		}
+	}()
+
+	_, _ = BuildE(Builder(NewEnumFactory([]Status{StatusActive})), Override[EnumProperties[Status]](map[string]any{
+		"exclusions": []Status{StatusActive},
+	}))
+}
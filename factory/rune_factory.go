@@ -0,0 +1,46 @@
+package factory
+
+// RuneProperties carries the generated rune for RuneFactory.
+type RuneProperties struct {
+	value rune
+}
+
+// RuneFactory generates single runes from Characters (Characters.Alphanumeric when empty), e.g.
+// Characters.CJK or Script(unicode.Greek) for multibyte input.
+type RuneFactory struct {
+	Characters CharacterSet
+}
+
+// Instantiate returns the generated rune.
+func (f *RuneFactory) Instantiate(properties RuneProperties) rune {
+	return properties.value
+}
+
+// Prepare picks a rune before applying overrides, so overriding with 0 is honored.
+func (f *RuneFactory) Prepare(overrides Partial[RuneProperties], seed int64) RuneProperties {
+	properties := RuneProperties{
+		value: f.Generate(seed),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return properties
+}
+
+// Retrieve wraps an existing rune into RuneProperties.
+func (f *RuneFactory) Retrieve(instance rune) RuneProperties {
+	return RuneProperties{
+		value: instance,
+	}
+}
+
+// Generate produces the same rune as building with seed and no overrides.
+func (f *RuneFactory) Generate(seed int64) rune {
+	characters := f.Characters
+	if len(characters) == 0 {
+		characters = Characters.Alphanumeric
+	}
+	return characterAt(seed, 0, characters)
+}
@@ -0,0 +1,43 @@
+package factory
+
+import (
+	"slices"
+	"testing"
+	"unicode"
+)
+
+func TestRuneFactoryDrawsFromCharacters(t *testing.T) {
+	for _, value := range Builder(&RuneFactory{}).BuildListWith(100, 1, nil) {
+		if !slices.Contains(Characters.Alphanumeric, value) {
+			t.Fatalf("expected an alphanumeric rune by default, got %q", value)
+		}
+	}
+
+	seen := make(map[rune]bool)
+	for _, value := range Builder(&RuneFactory{Characters: Characters.Cyrillic}).BuildListWith(200, 1, nil) {
+		if !unicode.Is(unicode.Cyrillic, value) {
+			t.Fatalf("expected a Cyrillic rune, got %q", value)
+		}
+		seen[value] = true
+	}
+	if len(seen) < 30 {
+		t.Fatalf("expected varied runes, got %d distinct", len(seen))
+	}
+}
+
+func TestRuneFactoryIsDeterministic(t *testing.T) {
+	factory := &RuneFactory{Characters: Characters.Emoji}
+
+	for seed := range int64(20) {
+		if Builder(factory).BuildWith(seed, nil) != factory.Generate(seed) {
+			t.Fatalf("expected Build and Generate to agree for seed %d", seed)
+		}
+	}
+}
+
+func TestRuneFactoryOverride(t *testing.T) {
+	value := Builder(&RuneFactory{}).Build(Override[RuneProperties](map[string]any{"value": rune(0)}))
+	if value != 0 {
+		t.Fatalf("expected the zero override to be honored, got %q", value)
+	}
+}
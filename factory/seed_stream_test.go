@@ -0,0 +1,89 @@
+package factory
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSeedStreamsAreReproducible(t *testing.T) {
+	first, second := NewSeedStreams(42).Stream(3), NewSeedStreams(42).Stream(3)
+	other := NewSeedStreams(42).Stream(4)
+
+	for range 100 {
+		value := first.Int63()
+		if value != second.Int63() {
+			t.Fatal("expected streams with the same base and index to agree")
+		}
+		if value < 0 {
+			t.Fatalf("expected a non-negative value, got %d", value)
+		}
+		if value == other.Int63() {
+			t.Fatal("expected streams with different indexes to diverge")
+		}
+	}
+
+	if first.Draws() != 100 {
+		t.Fatalf("expected 100 draws, got %d", first.Draws())
+	}
+}
+
+func TestSeedStreamReseeds(t *testing.T) {
+	stream := NewSeedStreams(1).Stream(0)
+	stream.Seed(9)
+	expected := stream.Uint64()
+
+	stream.Seed(9)
+	if stream.Uint64() != expected || stream.Draws() != 1 {
+		t.Fatal("expected Seed to restart the stream")
+	}
+}
+
+func TestConcurrentBuildersWithSeedStreamsAreReproducible(t *testing.T) {
+	const workers = 8
+	factory := &IntFactory{Min: 0, Max: 1_000_000}
+
+	run := func() [][]int {
+		streams := NewSeedStreams(7)
+		results := make([][]int, workers)
+
+		var wait sync.WaitGroup
+		for worker := range workers {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				builder := Builder(factory, WithSeedStream(streams, worker))
+				for range 50 {
+					results[worker] = append(results[worker], builder.Build(nil))
+				}
+			}()
+		}
+		wait.Wait()
+
+		if streams.Issued() != workers {
+			t.Fatalf("expected %d streams, got %d", workers, streams.Issued())
+		}
+		return results
+	}
+
+	first, second := run(), run()
+	for worker := range workers {
+		if !slices.Equal(first[worker], second[worker]) {
+			t.Fatalf("expected worker %d to reproduce its values", worker)
+		}
+	}
+}
+
+func TestConcurrentBuildsShareOneBuilderSafely(t *testing.T) {
+	builder := Builder(&IntFactory{}, WithSeedSource(NewSeedStreams(1).Stream(0)))
+
+	var wait sync.WaitGroup
+	for range 8 {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			builder.BuildList(100, nil)
+		}()
+	}
+	wait.Wait()
+}
@@ -0,0 +1,65 @@
+package factory
+
+import (
+	"github.com/lihs-ie/forge/internal/math"
+	"github.com/lihs-ie/forge/intervalset"
+)
+
+const (
+	// singleSeedBits sizes the range [0, 2^52) of single seeds; BulkAllocation runs start in
+	// [2^52, 2^53), so the two never meet.
+	singleSeedBits    = 52
+	singleSeedSpan    = 1 << singleSeedBits
+	seedHalfBits      = singleSeedBits / 2
+	seedHalfMask      = 1<<seedHalfBits - 1
+	seedPermuteRounds = 4
+)
+
+// seedTracker issues the seeds of a builder without repeating one. Single seeds come from a
+// counter permuted by a keyed Feistel network over [0, 2^52), so they are unique without being
+// recorded. The consecutive runs of BulkAllocation are drawn from [2^52, 2^53) and go to an
+// interval set, where a whole run takes a single interval. Memory therefore grows with the number
+// of runs only. A seedTracker is not safe for concurrent use.
+type seedTracker struct {
+	key     uint64
+	counter uint64
+	runs    *intervalset.Set
+}
+
+func newSeedTracker(key uint64) *seedTracker {
+	return &seedTracker{
+		key:  key,
+		runs: intervalset.New(),
+	}
+}
+
+// next returns the next single seed. Seeds repeat only after 2^52 calls.
+func (t *seedTracker) next() int64 {
+	seed := permuteSeed(t.counter, t.key)
+	t.counter++
+
+	//nolint:gosec // G115: permuteSeed stays below 2^52
+	return int64(seed)
+}
+
+// appendRun appends up to size consecutive seeds starting at start, skipping any already issued.
+func (t *seedTracker) appendRun(next []int64, start int64, size int) []int64 {
+	for offset := range int64(size) {
+		seed := start + offset
+		if t.runs.Add(seed) {
+			next = append(next, seed)
+		}
+	}
+	return next
+}
+
+// permuteSeed maps counter onto [0, 2^52) bijectively, splitting it into two 26-bit halves that
+// each Feistel round mixes with math.Mix64 under key.
+func permuteSeed(counter, key uint64) uint64 {
+	left, right := counter>>seedHalfBits&seedHalfMask, counter&seedHalfMask
+	for round := range uint64(seedPermuteRounds) {
+		left, right = right, left^math.Mix64((right^key)+round)&seedHalfMask
+	}
+
+	return left<<seedHalfBits | right
+}
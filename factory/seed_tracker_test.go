@@ -0,0 +1,56 @@
+package factory
+
+import "testing"
+
+func TestSeedTrackerIssuesUniqueSingleSeeds(t *testing.T) {
+	tracker := newSeedTracker(0x5EED)
+
+	issued := make(map[int64]struct{})
+	for range 100_000 {
+		seed := tracker.next()
+		if seed < 0 || seed >= singleSeedSpan {
+			t.Fatalf("seed %d outside the single seed range", seed)
+		}
+		if _, ok := issued[seed]; ok {
+			t.Fatalf("seed %d issued twice", seed)
+		}
+		issued[seed] = struct{}{}
+	}
+}
+
+func TestSeedTrackerRejectsRepeatedRuns(t *testing.T) {
+	tracker := newSeedTracker(0)
+
+	first := tracker.appendRun(nil, singleSeedSpan+5, 10)
+	run := tracker.appendRun(nil, singleSeedSpan, 10)
+	if len(first) != 10 || len(run) != 5 {
+		t.Fatalf("expected the second run to skip the issued seeds, got %v and %v", first, run)
+	}
+	for _, seed := range run {
+		if seed >= singleSeedSpan+5 {
+			t.Fatalf("seed %d issued twice in %v", seed, run)
+		}
+	}
+}
+
+func TestSeedTrackerKeepsSingleSeedsOutOfMemory(t *testing.T) {
+	tracker := newSeedTracker(1)
+
+	for range 100_000 {
+		tracker.next()
+	}
+	if tracker.runs.Intervals() != 0 {
+		t.Fatalf("expected single seeds to leave the interval set empty, got %d intervals", tracker.runs.Intervals())
+	}
+
+	tracker.appendRun(nil, singleSeedSpan, 100_000)
+	if tracker.runs.Intervals() != 1 {
+		t.Fatalf("expected one interval for a run, got %d", tracker.runs.Intervals())
+	}
+}
+
+func TestPermuteSeedDependsOnKey(t *testing.T) {
+	if permuteSeed(0, 1) == permuteSeed(0, 2) && permuteSeed(1, 1) == permuteSeed(1, 2) {
+		t.Fatal("expected different keys to permute counters differently")
+	}
+}
@@ -0,0 +1,71 @@
+package factory
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSequenceFactoryIncreases(t *testing.T) {
+	sequence := &SequenceFactory{Start: 10, Step: 5}
+
+	if values := Builder(sequence).BuildList(4, nil); !slices.Equal(values, []int{10, 15, 20, 25}) {
+		t.Fatalf("unexpected sequence %v", values)
+	}
+	if value := Builder(sequence).Build(nil); value != 30 {
+		t.Fatalf("expected builders to share the counter, got %d", value)
+	}
+
+	sequence.Reset()
+	if value := Builder(sequence).Build(nil); value != 10 {
+		t.Fatalf("expected Reset to restart at Start, got %d", value)
+	}
+}
+
+func TestSequenceFactoryConcurrentBuildsAreUnique(t *testing.T) {
+	sequence := &SequenceFactory{}
+
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				value := sequence.Instantiate(sequence.Prepare(nil, 0))
+				mu.Lock()
+				seen[value] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != 800 || !seen[0] || !seen[799] {
+		t.Fatalf("expected 0..799 exactly once, got %d values", len(seen))
+	}
+}
+
+func TestSequenceFactoryFormatted(t *testing.T) {
+	sequence := &SequenceFactory{Start: 1}
+	usernames := Builder(sequence.Formatted("user-%04d"))
+
+	if values := usernames.BuildList(2, nil); !slices.Equal(values, []string{"user-0001", "user-0002"}) {
+		t.Fatalf("unexpected formatted sequence %v", values)
+	}
+	if value := Builder(sequence).Build(nil); value != 3 {
+		t.Fatalf("expected the formatted factory to share the counter, got %d", value)
+	}
+}
+
+func TestSequenceFactoryOverride(t *testing.T) {
+	builder := Builder(&SequenceFactory{})
+
+	if value := builder.Build(Override[SequenceProperties](map[string]any{"value": 42})); value != 42 {
+		t.Fatalf("expected override, got %d", value)
+	}
+	if value := builder.Build(nil); value != 1 {
+		t.Fatalf("expected overridden builds to advance the sequence, got %d", value)
+	}
+}
@@ -0,0 +1,127 @@
+package factory
+
+import (
+	"reflect"
+	"unicode/utf8"
+)
+
+// maxShrinkAttempts bounds how many candidate instances Shrink evaluates.
+const maxShrinkAttempts = 1000
+
+// Shrink searches for a simpler instance that still fails, mirroring property-testing workflows.
+// Starting from instance, it repeatedly simplifies one property at a time (zeroing it, halving
+// strings, slices and numbers, or dropping their last element), keeps every simplification for
+// which fails still returns true and stops when no property can be simplified further:
+//
+//	user := users.BuildWith(seed, nil)
+//	if !valid(user) {
+//		t.Fatalf("minimal failing user: %+v", factory.Shrink(&UserFactory{}, user, invalid))
+//	}
+//
+// Candidates whose Instantiate panics are treated as passing. Nested structs are zeroed as a whole.
+func Shrink[T any, P any](factory Factory[T, P], instance T, fails func(T) bool) T {
+	properties := factory.Retrieve(instance)
+	value := reflect.ValueOf(&properties).Elem()
+	if value.Kind() != reflect.Struct {
+		return instance
+	}
+
+	attempts := 0
+	for progressed := true; progressed && attempts < maxShrinkAttempts; {
+		progressed = false
+
+		for index := range value.NumField() {
+			field := exposeField(value.Field(index))
+
+			for _, candidate := range shrinkCandidates(field) {
+				if attempts >= maxShrinkAttempts {
+					break
+				}
+				attempts++
+
+				previous := reflect.New(field.Type()).Elem()
+				previous.Set(field)
+				field.Set(candidate)
+
+				if shrunk, ok := stillFails(factory, properties, fails); ok {
+					instance = shrunk
+					progressed = true
+					break
+				}
+				field.Set(previous)
+			}
+		}
+	}
+
+	return instance
+}
+
+func stillFails[T any, P any](factory Factory[T, P], properties P, fails func(T) bool) (instance T, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+
+	instance = factory.Instantiate(properties)
+	return instance, fails(instance)
+}
+
+// shrinkCandidates returns simpler values for field, simplest first; values equal to field are skipped.
+func shrinkCandidates(field reflect.Value) []reflect.Value {
+	if field.IsZero() {
+		return nil
+	}
+
+	candidates := []reflect.Value{reflect.Zero(field.Type())}
+
+	switch field.Kind() {
+	case reflect.String:
+		text := field.String()
+		_, lastSize := utf8.DecodeLastRuneInString(text)
+		candidates = append(candidates, convertedString(field, text[:halfRuneBoundary(text)]), convertedString(field, text[:len(text)-lastSize]))
+	case reflect.Slice:
+		if field.Len() == 0 {
+			break
+		}
+		candidates = append(candidates, field.Slice(0, field.Len()/2), field.Slice(0, field.Len()-1))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number := field.Int()
+		step := int64(1)
+		if number < 0 {
+			step = -1
+		}
+		candidates = append(candidates, convertedValue(field, number/2), convertedValue(field, number-step))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number := field.Uint()
+		candidates = append(candidates, convertedValue(field, number/2), convertedValue(field, number-1))
+	case reflect.Float32, reflect.Float64:
+		number := field.Float()
+		candidates = append(candidates, convertedValue(field, float64(int64(number))), convertedValue(field, number/2))
+	}
+
+	simpler := candidates[:0]
+	for _, candidate := range candidates {
+		if !reflect.DeepEqual(candidate.Interface(), field.Interface()) {
+			simpler = append(simpler, candidate)
+		}
+	}
+
+	return simpler
+}
+
+func halfRuneBoundary(text string) int {
+	half := len(text) / 2
+	for half > 0 && !utf8.RuneStart(text[half]) {
+		half--
+	}
+	return half
+}
+
+func convertedString(field reflect.Value, text string) reflect.Value {
+	return reflect.ValueOf(text).Convert(field.Type())
+}
+
+func convertedValue[N int64 | uint64 | float64](field reflect.Value, number N) reflect.Value {
+	return reflect.ValueOf(number).Convert(field.Type())
+}
@@ -0,0 +1,87 @@
+package factory
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+type shrinkProps struct {
+	Name  string
+	Age   int
+	Tags  []string
+	score float64
+}
+
+type shrinkFactory struct{}
+
+func (f *shrinkFactory) Instantiate(props shrinkProps) shrinkProps {
+	if props.Age == 13 {
+		panic("unlucky")
+	}
+	return props
+}
+
+func (f *shrinkFactory) Prepare(overrides Partial[shrinkProps], _ int64) shrinkProps {
+	props := shrinkProps{}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *shrinkFactory) Retrieve(instance shrinkProps) shrinkProps {
+	return instance
+}
+
+func TestShrinkFindsMinimalFailingInstance(t *testing.T) {
+	original := shrinkProps{
+		Name:  "Alexander the Great",
+		Age:   97,
+		Tags:  []string{"a", "b", "c", "d", "e"},
+		score: 12.5,
+	}
+
+	// Fails when the name contains "x" and the person is at least 18.
+	fails := func(props shrinkProps) bool {
+		return strings.Contains(props.Name, "x") && props.Age >= 18
+	}
+
+	shrunk := Shrink(&shrinkFactory{}, original, fails)
+
+	if !fails(shrunk) {
+		t.Fatalf("expected shrunk instance to still fail, got %+v", shrunk)
+	}
+	if shrunk.Name != "Alex" || shrunk.Age != 18 || shrunk.Tags != nil || shrunk.score != 0 {
+		t.Fatalf("expected minimal failing instance, got %+v", shrunk)
+	}
+}
+
+func TestShrinkReturnsInstanceWhenNothingSimplifies(t *testing.T) {
+	original := shrinkProps{Name: "x"}
+
+	shrunk := Shrink(&shrinkFactory{}, original, func(props shrinkProps) bool { return props.Name == "x" })
+
+	if shrunk.Name != "x" {
+		t.Fatalf("expected original instance, got %+v", shrunk)
+	}
+}
+
+func TestShrinkTreatsPanicsAsPassing(t *testing.T) {
+	original := shrinkProps{Age: 26}
+
+	shrunk := Shrink(&shrinkFactory{}, original, func(props shrinkProps) bool { return props.Age > 12 })
+
+	if shrunk.Age != 14 {
+		t.Fatalf("expected shrinking to skip the panicking age 13, got %d", shrunk.Age)
+	}
+}
+
+func TestShrinkCandidatesKeepMultibyteRunes(t *testing.T) {
+	for _, candidate := range shrinkCandidates(reflect.ValueOf("漢字かな")) {
+		if !strings.HasPrefix("漢字かな", candidate.String()) || !utf8.ValidString(candidate.String()) {
+			t.Fatalf("unexpected candidate %q", candidate.String())
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package factory
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	defaultSliceMin = 1
+	defaultSliceMax = 10
+)
+
+// SliceProperties stores the elements prepared for SliceFactory.
+type SliceProperties[T any] struct {
+	elements []T
+}
+
+// SliceFactory builds slices using a dedicated element factory.
+type SliceFactory[T any, P any] struct {
+	elementFactory Factory[T, P]
+	min            int
+	max            int
+	unique         bool
+	compare        func(a, b T) int
+}
+
+type sliceOptions struct {
+	min    int
+	max    int
+	unique bool
+}
+
+// SliceOption configures a SliceFactory created by NewSliceFactory.
+type SliceOption func(*sliceOptions)
+
+// SliceLength bounds the number of generated elements to [min, max] (1 to 10 by default).
+func SliceLength(min, max int) SliceOption {
+	return func(opts *sliceOptions) {
+		opts.min = min
+		opts.max = max
+	}
+}
+
+// UniqueElements makes every generated slice hold pairwise distinct elements, compared by their
+// properties as returned by Retrieve. Colliding elements are rebuilt with fresh seeds, up to 100
+// times each before Prepare panics.
+func UniqueElements() SliceOption {
+	return func(opts *sliceOptions) {
+		opts.unique = true
+	}
+}
+
+// NewSliceFactory wires an element factory into a SliceFactory.
+func NewSliceFactory[T any, P any](elementFactory Factory[T, P], opts ...SliceOption) *SliceFactory[T, P] {
+	config := sliceOptions{min: defaultSliceMin, max: defaultSliceMax}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if config.min < 0 {
+		config.min = 0
+	}
+	if config.max < config.min {
+		config.max = config.min
+	}
+
+	return &SliceFactory[T, P]{
+		elementFactory: elementFactory,
+		min:            config.min,
+		max:            config.max,
+		unique:         config.unique,
+	}
+}
+
+// WithOrder returns a copy of the factory that sorts generated slices by compare
+// (e.g. cmp.Compare[int]). Overridden elements are kept in the given order.
+func (f *SliceFactory[T, P]) WithOrder(compare func(a, b T) int) *SliceFactory[T, P] {
+	ordered := *f
+	ordered.compare = compare
+	return &ordered
+}
+
+// Instantiate returns the prepared elements.
+func (f *SliceFactory[T, P]) Instantiate(properties SliceProperties[T]) []T {
+	return properties.elements
+}
+
+// Prepare builds a seed-determined number of elements unless they are overridden.
+func (f *SliceFactory[T, P]) Prepare(overrides Partial[SliceProperties[T]], seed int64) SliceProperties[T] {
+	properties := SliceProperties[T]{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.elements == nil {
+		properties.elements = f.generate(seed)
+	}
+
+	return properties
+}
+
+// Retrieve converts an existing slice into SliceProperties.
+func (f *SliceFactory[T, P]) Retrieve(instance []T) SliceProperties[T] {
+	return SliceProperties[T]{
+		elements: instance,
+	}
+}
+
+func (f *SliceFactory[T, P]) generate(seed int64) []T {
+	count := int(IntAt(seed, int64(f.min), int64(f.max)))
+	elements := make([]T, 0, count)
+
+	var seen map[uint64]struct{}
+	if f.unique {
+		seen = make(map[uint64]struct{}, count)
+	}
+
+	retrySeed := seed + int64(count)
+	for index := range count {
+		element := create(f.elementFactory, Overrider[P]{}, seed+int64(index))
+
+		for attempt := 0; seen != nil; attempt++ {
+			key := propertiesKey(f.elementFactory.Retrieve(element))
+			if _, exists := seen[key]; !exists {
+				seen[key] = struct{}{}
+				break
+			}
+			if attempt == defaultFilterAttempts {
+				panic(fmt.Sprintf("slice: no unique element found within %d attempts", defaultFilterAttempts))
+			}
+
+			element = create(f.elementFactory, Overrider[P]{}, retrySeed)
+			retrySeed++
+		}
+
+		elements = append(elements, element)
+	}
+
+	if f.compare != nil {
+		slices.SortStableFunc(elements, f.compare)
+	}
+
+	return elements
+}
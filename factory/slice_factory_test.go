@@ -0,0 +1,73 @@
+package factory
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestSliceFactoryLengthBounds(t *testing.T) {
+	builder := Builder(NewSliceFactory(&IntFactory{}, SliceLength(2, 4)))
+
+	lengths := make(map[int]bool)
+	for _, values := range builder.BuildListWith(100, 1, nil) {
+		if len(values) < 2 || len(values) > 4 {
+			t.Fatalf("length %d out of range", len(values))
+		}
+		lengths[len(values)] = true
+	}
+
+	if len(lengths) != 3 {
+		t.Fatalf("expected every length in [2, 4], got %v", lengths)
+	}
+}
+
+func TestSliceFactoryDefaults(t *testing.T) {
+	for _, values := range Builder(NewSliceFactory(&IntFactory{})).BuildList(50, nil) {
+		if len(values) < defaultSliceMin || len(values) > defaultSliceMax {
+			t.Fatalf("length %d outside default range", len(values))
+		}
+	}
+}
+
+func TestSliceFactoryUniqueElements(t *testing.T) {
+	builder := Builder(NewSliceFactory(&IntFactory{Min: 1, Max: 6}, SliceLength(6, 6), UniqueElements()))
+
+	for _, values := range builder.BuildListWith(20, 1, nil) {
+		sorted := slices.Sorted(slices.Values(values))
+		if !slices.Equal(sorted, []int{1, 2, 3, 4, 5, 6}) {
+			t.Fatalf("expected a permutation of 1..6, got %v", values)
+		}
+	}
+}
+
+func TestSliceFactoryUniqueElementsPanicsWhenExhausted(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic when the element domain is too small")
+		}
+	}()
+
+	Builder(NewSliceFactory(&IntFactory{Min: 1, Max: 2}, SliceLength(3, 3), UniqueElements())).Build(nil)
+}
+
+func TestSliceFactoryWithOrder(t *testing.T) {
+	builder := Builder(NewSliceFactory(&IntFactory{}, SliceLength(5, 10)).WithOrder(cmp.Compare[int]))
+
+	for _, values := range builder.BuildList(20, nil) {
+		if !slices.IsSorted(values) {
+			t.Fatalf("expected sorted values, got %v", values)
+		}
+	}
+}
+
+func TestSliceFactoryOverrideAndDeterminism(t *testing.T) {
+	builder := Builder(NewSliceFactory(&IntFactory{}))
+
+	if values := builder.Build(Override[SliceProperties[int]](map[string]any{"elements": []int{7}})); !slices.Equal(values, []int{7}) {
+		t.Fatalf("expected overridden elements, got %v", values)
+	}
+	if !slices.Equal(builder.BuildWith(9, nil), builder.BuildWith(9, nil)) {
+		t.Fatal("expected identical slices for the same seed")
+	}
+}
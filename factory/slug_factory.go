@@ -0,0 +1,126 @@
+package factory
+
+import (
+	"strings"
+)
+
+const (
+	defaultSlugMinWords = 2
+	defaultSlugMaxWords = 4
+	slugCountSalt       = 0x0510_6C0D
+)
+
+var slugWords = []string{
+	"alpha", "amber", "anchor", "apple", "autumn", "beacon", "birch", "bright", "canyon", "cedar",
+	"cloud", "coral", "crimson", "delta", "dream", "ember", "falcon", "field", "forest", "garden",
+	"glacier", "golden", "harbor", "horizon", "island", "jade", "lake", "lunar", "maple", "meadow",
+	"mist", "north", "ocean", "orbit", "pixel", "prairie", "quiet", "rapid", "river", "silver",
+	"solar", "spring", "stone", "summit", "swift", "thunder", "valley", "velvet", "willow", "winter",
+}
+
+// SlugProperties carries the source text and the generated slug for SlugFactory.
+type SlugProperties struct {
+	text  string
+	value string
+}
+
+// SlugFactory generates URL-safe slugs: lowercase ASCII letters and digits in hyphen-separated
+// words, such as "silver-harbor-lake". Words are drawn from a bundled list, MinWords to MaxWords
+// of them (2 to 4 when zero), or taken from another string factory with SlugFrom. MaxLength, when
+// positive, shortens slugs at a word boundary where possible.
+type SlugFactory struct {
+	MinWords  int
+	MaxWords  int
+	MaxLength int
+
+	source func(seed int64) string
+}
+
+// SlugFrom creates a SlugFactory that slugifies the output of source built with the same seed,
+// e.g. titles from a MarkovFactory.
+func SlugFrom[P any](source Factory[string, P]) *SlugFactory {
+	return &SlugFactory{
+		source: func(seed int64) string {
+			return create(source, Overrider[P]{}, seed)
+		},
+	}
+}
+
+// Instantiate returns the slug.
+func (f *SlugFactory) Instantiate(properties SlugProperties) string {
+	return properties.value
+}
+
+// Prepare generates the source text unless overridden and slugifies it; an overridden slug is
+// kept as is.
+func (f *SlugFactory) Prepare(overrides Partial[SlugProperties], seed int64) SlugProperties {
+	properties := SlugProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value != "" {
+		return properties
+	}
+
+	if properties.text == "" {
+		properties.text = f.text(seed)
+	}
+	properties.value = truncateSlug(Slugify(properties.text), f.MaxLength)
+
+	return properties
+}
+
+// Retrieve wraps an existing slug into SlugProperties.
+func (f *SlugFactory) Retrieve(instance string) SlugProperties {
+	return SlugProperties{
+		text:  instance,
+		value: instance,
+	}
+}
+
+func (f *SlugFactory) text(seed int64) string {
+	if f.source != nil {
+		return f.source(seed)
+	}
+
+	minimum, maximum := f.MinWords, f.MaxWords
+	if minimum <= 0 {
+		minimum = defaultSlugMinWords
+	}
+	if maximum <= 0 {
+		maximum = max(minimum, defaultSlugMaxWords)
+	}
+
+	words := make([]string, IntAt(seed^slugCountSalt, int64(minimum), int64(max(minimum, maximum))))
+	for index := range words {
+		words[index] = slugWords[IntAt(seed+int64(index), 0, int64(len(slugWords)-1))]
+	}
+	return strings.Join(words, " ")
+}
+
+// Slugify lowercases text and joins its runs of ASCII letters and digits with hyphens, e.g.
+// "Hello, World 2!" becomes "hello-world-2". Other characters only separate words.
+func Slugify(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(character rune) bool {
+		return (character < 'a' || character > 'z') && (character < '0' || character > '9')
+	})
+	return strings.Join(words, "-")
+}
+
+// truncateSlug shortens slug to at most maxLength bytes, cutting at the last hyphen that keeps a
+// word when there is one.
+func truncateSlug(slug string, maxLength int) string {
+	if maxLength <= 0 || len(slug) <= maxLength {
+		return slug
+	}
+
+	cut := slug[:maxLength]
+	if slug[maxLength] != '-' {
+		if index := strings.LastIndexByte(cut, '-'); index > 0 {
+			cut = cut[:index]
+		}
+	}
+	return strings.TrimSuffix(cut, "-")
+}
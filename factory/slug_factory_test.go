@@ -0,0 +1,60 @@
+package factory
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+func TestSlugFactoryWords(t *testing.T) {
+	for _, slug := range Builder(&SlugFactory{MinWords: 2, MaxWords: 3}).BuildList(200, nil) {
+		if !slugPattern.MatchString(slug) {
+			t.Fatalf("unexpected slug %q", slug)
+		}
+		if words := strings.Count(slug, "-") + 1; words < 2 || words > 3 {
+			t.Fatalf("expected two or three words, got %q", slug)
+		}
+	}
+}
+
+func TestSlugFactoryMaxLength(t *testing.T) {
+	for _, slug := range Builder(&SlugFactory{MinWords: 5, MaxWords: 5, MaxLength: 16}).BuildList(200, nil) {
+		if len(slug) > 16 || !slugPattern.MatchString(slug) {
+			t.Fatalf("unexpected slug %q", slug)
+		}
+	}
+
+	if got := truncateSlug("verylongsingleword", 8); got != "verylong" {
+		t.Fatalf("expected a hard cut for a single long word, got %q", got)
+	}
+	if got := truncateSlug("silver-harbor-lake", 13); got != "silver-harbor" {
+		t.Fatalf("expected a cut at the word boundary, got %q", got)
+	}
+}
+
+func TestSlugFrom(t *testing.T) {
+	titles := NewMarkovFactory("")
+	builder := Builder(SlugFrom(titles))
+
+	for seed := range int64(20) {
+		slug := builder.BuildWith(seed, nil)
+		if slug != Slugify(Builder(titles).BuildWith(seed, nil)) || !slugPattern.MatchString(slug) {
+			t.Fatalf("expected the slugified title for seed %d, got %q", seed, slug)
+		}
+	}
+}
+
+func TestSlugFactoryOverrideText(t *testing.T) {
+	slug := Builder(&SlugFactory{}).Build(Override[SlugProperties](map[string]any{"text": "Crème Brûlée: 10 Tips!"}))
+	if slug != "cr-me-br-l-e-10-tips" {
+		t.Fatalf("unexpected slug %q", slug)
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	if got := Slugify("  Hello, World 2!  "); got != "hello-world-2" {
+		t.Fatalf("unexpected slug %q", got)
+	}
+}
@@ -0,0 +1,279 @@
+package factory
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"unsafe"
+)
+
+type propertiesSnapshot struct {
+	Type       string          `json:"type"`
+	Properties json.RawMessage `json:"properties"`
+}
+
+var (
+	jsonMarshalerType   = reflect.TypeFor[json.Marshaler]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+)
+
+// MarshalProperties encodes prepared properties, including unexported fields, as stable JSON.
+// Struct fields keep their declaration order and map keys are sorted, so equal properties
+// always produce identical bytes. The snapshot records the properties type for UnmarshalProperties.
+func MarshalProperties[P any](properties P) ([]byte, error) {
+	value := reflect.New(reflect.TypeFor[P]()).Elem()
+	value.Set(reflect.ValueOf(&properties).Elem())
+
+	encoded, err := encodeSnapshotValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(propertiesSnapshot{
+		Type:       snapshotTypeName(value.Type()),
+		Properties: encoded,
+	})
+}
+
+// UnmarshalProperties restores properties produced by MarshalProperties so they can be inspected
+// or replayed with Factory.Instantiate.
+func UnmarshalProperties[P any](data []byte) (P, error) {
+	var properties P
+
+	var snapshot propertiesSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return properties, fmt.Errorf("snapshot: %w", err)
+	}
+
+	target := reflect.ValueOf(&properties).Elem()
+	if expected := snapshotTypeName(target.Type()); snapshot.Type != expected {
+		return properties, fmt.Errorf("snapshot: type mismatch, expected %s, got %s", expected, snapshot.Type)
+	}
+
+	if err := decodeSnapshotValue(snapshot.Properties, target); err != nil {
+		return properties, err
+	}
+
+	return properties, nil
+}
+
+func snapshotTypeName(typ reflect.Type) string {
+	if typ.PkgPath() == "" {
+		return typ.String()
+	}
+	return typ.PkgPath() + "." + typ.Name()
+}
+
+func exposeField(field reflect.Value) reflect.Value {
+	if field.CanInterface() || !field.CanAddr() {
+		return field
+	}
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
+
+func encodeSnapshotValue(value reflect.Value) (json.RawMessage, error) {
+	if value.Type().Implements(jsonMarshalerType) || reflect.PointerTo(value.Type()).Implements(jsonMarshalerType) {
+		return json.Marshal(value.Interface())
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		return encodeSnapshotStruct(value)
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		return encodeSnapshotValue(value.Elem())
+	case reflect.Slice:
+		if value.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		return encodeSnapshotSequence(value)
+	case reflect.Array:
+		return encodeSnapshotSequence(value)
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return json.Marshal(value.Interface())
+		}
+		return encodeSnapshotMap(value)
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil, fmt.Errorf("snapshot: unsupported kind %s", value.Kind())
+	default:
+		return json.Marshal(value.Interface())
+	}
+}
+
+func encodeSnapshotStruct(value reflect.Value) (json.RawMessage, error) {
+	addressable := reflect.New(value.Type()).Elem()
+	addressable.Set(value)
+
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for index := 0; index < addressable.NumField(); index++ {
+		field := addressable.Type().Field(index)
+		encoded, err := encodeSnapshotValue(exposeField(addressable.Field(index)))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Name, err)
+		}
+
+		if index > 0 {
+			buffer.WriteByte(',')
+		}
+		name, _ := json.Marshal(field.Name)
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(encoded)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+func encodeSnapshotSequence(value reflect.Value) (json.RawMessage, error) {
+	elements := make([]json.RawMessage, value.Len())
+	for index := range value.Len() {
+		encoded, err := encodeSnapshotValue(value.Index(index))
+		if err != nil {
+			return nil, err
+		}
+		elements[index] = encoded
+	}
+	return json.Marshal(elements)
+}
+
+func encodeSnapshotMap(value reflect.Value) (json.RawMessage, error) {
+	if value.IsNil() {
+		return json.RawMessage("null"), nil
+	}
+
+	keys := value.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for index, key := range keys {
+		encoded, err := encodeSnapshotValue(value.MapIndex(key))
+		if err != nil {
+			return nil, err
+		}
+
+		if index > 0 {
+			buffer.WriteByte(',')
+		}
+		name, _ := json.Marshal(key.String())
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(encoded)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+func decodeSnapshotValue(data json.RawMessage, target reflect.Value) error {
+	if reflect.PointerTo(target.Type()).Implements(jsonUnmarshalerType) {
+		return json.Unmarshal(data, target.Addr().Interface())
+	}
+
+	isNull := bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+
+	switch target.Kind() {
+	case reflect.Struct:
+		return decodeSnapshotStruct(data, target)
+	case reflect.Pointer:
+		if isNull {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+		element := reflect.New(target.Type().Elem())
+		if err := decodeSnapshotValue(data, element.Elem()); err != nil {
+			return err
+		}
+		target.Set(element)
+		return nil
+	case reflect.Slice, reflect.Array:
+		if isNull {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+		return decodeSnapshotSequence(data, target)
+	case reflect.Map:
+		if target.Type().Key().Kind() != reflect.String {
+			return json.Unmarshal(data, target.Addr().Interface())
+		}
+		if isNull {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+		return decodeSnapshotMap(data, target)
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return fmt.Errorf("snapshot: unsupported kind %s", target.Kind())
+	default:
+		return json.Unmarshal(data, target.Addr().Interface())
+	}
+}
+
+func decodeSnapshotStruct(data json.RawMessage, target reflect.Value) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+
+	for index := 0; index < target.NumField(); index++ {
+		field := target.Type().Field(index)
+		raw, ok := fields[field.Name]
+		if !ok {
+			continue
+		}
+		if err := decodeSnapshotValue(raw, exposeField(target.Field(index))); err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeSnapshotSequence(data json.RawMessage, target reflect.Value) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+
+	if target.Kind() == reflect.Slice {
+		target.Set(reflect.MakeSlice(target.Type(), len(elements), len(elements)))
+	} else if len(elements) != target.Len() {
+		return errors.New("snapshot: array length mismatch")
+	}
+
+	for index, element := range elements {
+		if err := decodeSnapshotValue(element, target.Index(index)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeSnapshotMap(data json.RawMessage, target reflect.Value) error {
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+
+	result := reflect.MakeMapWithSize(target.Type(), len(entries))
+	for key, raw := range entries {
+		value := reflect.New(target.Type().Elem()).Elem()
+		if err := decodeSnapshotValue(raw, value); err != nil {
+			return err
+		}
+		result.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), value)
+	}
+	target.Set(result)
+
+	return nil
+}
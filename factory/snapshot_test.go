@@ -0,0 +1,112 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type snapshotChild struct {
+	label string
+	Count int
+}
+
+type snapshotProps struct {
+	name     string
+	Tags     []string
+	Scores   map[string]int
+	child    snapshotChild
+	children []snapshotChild
+	parent   *snapshotChild
+	At       time.Time
+}
+
+func TestMarshalPropertiesRoundTrip(t *testing.T) {
+	original := snapshotProps{
+		name:     "alice",
+		Tags:     []string{"a", "b"},
+		Scores:   map[string]int{"z": 1, "a": 2},
+		child:    snapshotChild{label: "first", Count: 1},
+		children: []snapshotChild{{label: "second", Count: 2}},
+		parent:   &snapshotChild{label: "root"},
+		At:       time.Date(2025, time.May, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	data, err := MarshalProperties(original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	restored, err := UnmarshalProperties[snapshotProps](data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if restored.name != "alice" || restored.child.label != "first" || restored.children[0].label != "second" {
+		t.Fatalf("expected unexported fields to round-trip, got %+v", restored)
+	}
+	if restored.parent == nil || restored.parent.label != "root" {
+		t.Fatalf("expected pointer to round-trip, got %+v", restored.parent)
+	}
+	if !restored.At.Equal(original.At) || restored.Scores["a"] != 2 || len(restored.Tags) != 2 {
+		t.Fatalf("unexpected restored properties %+v", restored)
+	}
+}
+
+func TestMarshalPropertiesIsStable(t *testing.T) {
+	props := snapshotProps{Scores: map[string]int{"b": 1, "a": 2, "c": 3}}
+
+	first, _ := MarshalProperties(props)
+	for range 10 {
+		again, _ := MarshalProperties(props)
+		if string(again) != string(first) {
+			t.Fatalf("expected identical output, got %s and %s", first, again)
+		}
+	}
+
+	if !strings.Contains(string(first), `"Scores":{"a":2,"b":1,"c":3}`) {
+		t.Fatalf("expected sorted map keys, got %s", first)
+	}
+	if !strings.HasPrefix(string(first), `{"type":"github.com/lihs-ie/forge/factory.snapshotProps"`) {
+		t.Fatalf("expected type header, got %s", first)
+	}
+}
+
+func TestSnapshotReplaysPreparedProperties(t *testing.T) {
+	factory := &StringFactory{Min: 5, Max: 10}
+	prepared := factory.Prepare(nil, 42)
+
+	data, err := MarshalProperties(prepared)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restored, err := UnmarshalProperties[StringProperties](data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if factory.Instantiate(restored) != factory.Instantiate(prepared) {
+		t.Fatal("expected replayed instance to match")
+	}
+	if restored.min != 5 || restored.max != 10 {
+		t.Fatalf("expected configuration to round-trip, got %+v", restored)
+	}
+}
+
+func TestUnmarshalPropertiesRejectsOtherType(t *testing.T) {
+	data, _ := MarshalProperties(StringProperties{value: "x"})
+
+	if _, err := UnmarshalProperties[snapshotProps](data); err == nil {
+		t.Fatal("expected type mismatch error")
+	}
+}
+
+func TestMarshalPropertiesRejectsFunctions(t *testing.T) {
+	type withFunc struct {
+		fn func()
+	}
+
+	if _, err := MarshalProperties(withFunc{fn: func() {}}); err == nil {
+		t.Fatal("expected error for function field")
+	}
+}
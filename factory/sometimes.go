@@ -0,0 +1,30 @@
+package factory
+
+// sometimesSalt decorrelates the Sometimes decision from values factories derive from the same seed.
+const sometimesSalt = 0x5D0E_7A11
+
+// Sometimes returns an Overrider that applies overrider only to the given fraction of builds.
+// Whether a build is affected depends on its seed alone, so BuildList(100, Sometimes(0.2, inactive))
+// yields roughly 20 inactive instances and BuildWith reproduces the same decision for a seed.
+// Apply and Func have no seed and behave as if building with seed 0.
+func Sometimes[P any](probability float64, overrider Overrider[P]) Overrider[P] {
+	at := func(seed int64) Partial[P] {
+		if BoolAt(seed^sometimesSalt, probability) {
+			return overrider.forSeed(seed)
+		}
+		return nil
+	}
+
+	after := func(seed int64) Partial[P] {
+		if BoolAt(seed^sometimesSalt, probability) {
+			return overrider.afterPrepare(seed)
+		}
+		return nil
+	}
+
+	return Overrider[P]{
+		fn:    at(0),
+		at:    at,
+		after: after,
+	}
+}
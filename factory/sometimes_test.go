@@ -0,0 +1,58 @@
+package factory
+
+import "testing"
+
+func TestSometimesAppliesToFraction(t *testing.T) {
+	builder := Builder(&StringFactory{})
+	marked := Sometimes(0.2, Override[StringProperties](map[string]any{"value": "marked"}))
+
+	count := 0
+	for _, value := range builder.BuildListWith(1000, 1, marked) {
+		if value == "marked" {
+			count++
+		}
+	}
+
+	if count < 150 || count > 250 {
+		t.Fatalf("expected about 200 marked values, got %d", count)
+	}
+}
+
+func TestSometimesIsDeterministicPerSeed(t *testing.T) {
+	builder := Builder(&StringFactory{})
+	marked := Sometimes(0.5, Override[StringProperties](map[string]any{"value": "marked"}))
+
+	for seed := range int64(50) {
+		if builder.BuildWith(seed, marked) != builder.BuildWith(seed, marked) {
+			t.Fatalf("expected identical result for seed %d", seed)
+		}
+	}
+}
+
+func TestSometimesBounds(t *testing.T) {
+	builder := Builder(&StringFactory{})
+	always := Sometimes(1, Override[StringProperties](map[string]any{"value": "marked"}))
+	never := Sometimes(0, Override[StringProperties](map[string]any{"value": "marked"}))
+
+	for _, value := range builder.BuildList(100, always) {
+		if value != "marked" {
+			t.Fatalf("expected probability 1 to always apply, got %q", value)
+		}
+	}
+	for _, value := range builder.BuildList(100, never) {
+		if value == "marked" {
+			t.Fatal("expected probability 0 to never apply")
+		}
+	}
+}
+
+func TestSometimesNests(t *testing.T) {
+	builder := Builder(&StringFactory{})
+	marked := Sometimes(1, Sometimes(0, Override[StringProperties](map[string]any{"value": "marked"})))
+
+	for _, value := range builder.BuildList(50, marked) {
+		if value == "marked" {
+			t.Fatal("expected inner Sometimes to be honoured")
+		}
+	}
+}
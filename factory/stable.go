@@ -0,0 +1,25 @@
+package factory
+
+import "fmt"
+
+// GeneratorVersion identifies the output of the built-in factories. It changes whenever a
+// built-in factory produces different output for the same seed and configuration; within one
+// version the output is pinned by the golden tests in testdata/stable.golden.
+const GeneratorVersion = "0.4"
+
+const stableSeedSource = 0
+
+// StableMode asserts that the library still provides generator version and makes the builder's
+// random seeds (Build, BuildList, Shared) follow a fixed sequence, so every build is reproducible.
+// Builder panics when version differs from GeneratorVersion.
+func StableMode(version string) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.stableVersion = version
+	}
+}
+
+func requireGeneratorVersion(version string) {
+	if version != GeneratorVersion {
+		panic(fmt.Sprintf("builder: stable mode requires generator version %s, library provides %s", version, GeneratorVersion))
+	}
+}
@@ -0,0 +1,179 @@
+package factory
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files")
+
+// stableGenerator builds one pinned value per seed for the golden file.
+type stableGenerator struct {
+	name  string
+	build func(seed int64) any
+}
+
+// stable pins factory under name, rendering each value with format when it is not nil.
+func stable[T any, P any](name string, factory Factory[T, P], format func(T) any, opts ...BuilderOption) stableGenerator {
+	builder := Builder(factory, append(opts, StableMode(GeneratorVersion))...)
+
+	return stableGenerator{name: name, build: func(seed int64) any {
+		value := builder.BuildWith(seed, nil)
+		if format == nil {
+			return value
+		}
+		return format(value)
+	}}
+}
+
+func stableOutput() string {
+	var lines []string
+	record := func(name string, seed int64, value any) {
+		lines = append(lines, fmt.Sprintf("%s seed=%d: %v", name, seed, value))
+	}
+
+	anchor := WithTimeAnchor(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	texts := Builder(&StringFactory{Min: 4, Max: 16}, StableMode(GeneratorVersion))
+	uploads := NewMultipartFactory(&formTestSignupFactory{})
+	uploads.Files = []FormFile{{Field: "document", MinSize: 4, MaxSize: 12}}
+	document := &JSONFactory{MaxDepth: 2, MaxKeys: 3}
+
+	generators := []stableGenerator{
+		stable("string", &StringFactory{Min: 4, Max: 16}, nil),
+		stable("enum", NewEnumFactory([]Status{StatusPending, StatusActive, StatusInactive, StatusClosed}), nil),
+		stable("map", NewMapFactory(&StringFactory{Max: 8}, &StringFactory{Max: 8}), func(values map[string]string) any { return sortedEntries(values) }),
+		stable("timeseries", &TimeSeriesFactory{Count: 4, Base: 10, Trend: 1, Noise: 2, Seasonality: 3, Period: 4}, func(points []TimeSeriesPoint) any { return formatSeries(points) }),
+		stable("markov", NewMarkovFactory(""), nil),
+		stable("money", &MoneyFactory{Currencies: []Currency{Currencies.USD, Currencies.JPY, Currencies.KWD}, Sign: SignAny}, nil),
+		stable("bytes", (&BytesFactory{Min: 4, Max: 12}).Hex(), nil),
+		stable("ipv4", &IPFactory{Mode: IPModeV4}, nil),
+		stable("ipv6", &IPFactory{Mode: IPModePrivateV6}, nil),
+		stable("frame", &FrameFactory{
+			ByteOrder: binary.BigEndian,
+			Fields: []FrameField{
+				{Name: "magic", Width: 2, Fixed: true, Value: 0xCAFE},
+				{Name: "id", Width: 4},
+				{Name: "payload", MaxLength: 8},
+			},
+			MutationRate: 0.5,
+		}, func(frame []byte) any { return fmt.Sprintf("%x", frame) }),
+		stable("multipart", uploads, func(body MultipartBody) any { return fmt.Sprintf("%q", body.Body) }),
+		stable("int", &IntFactory{Min: -1000, Max: 1000}, nil),
+		stable("float", &FloatFactory{Min: -10, Max: 10, Precision: 3}, nil),
+		stable("bool", &BoolFactory{Probability: 0.3}, nil),
+		stable("rune", &RuneFactory{}, func(value rune) any { return string(value) }),
+		stable("uuid", &UUIDFactory{}, nil),
+		stable("uuid5", &UUIDFactory{Version: 5, Namespace: "6ba7b811-9dad-11d1-80b4-00c04fd430c8"}, nil),
+		stable("time", &TimeFactory{}, func(value time.Time) any { return value.Format(time.RFC3339Nano) }, anchor),
+		stable("email", &EmailFactory{}, nil),
+		stable("url", &URLFactory{MaxDepth: 3, MaxQuery: 2}, nil),
+		stable("cidr", &CIDRFactory{Mode: IPModePrivateV4}, nil),
+		stable("phone", &PhoneFactory{}, nil),
+		stable("token", &TokenFactory{Key: []byte("stable"), Issuer: "forge"}, nil, anchor),
+		stable("regex", NewRegexFactory(`[a-z]{3}-\d{2,4}(x|y)?`), nil),
+		stable("normal", &DistributionFactory{Distribution: Normal(0, 1), Precision: 4}, nil),
+		stable("zipf", &DistributionFactory{Distribution: Zipf(1.2, 1, 100)}, nil),
+		stable("color", &ColorFactory{Format: ColorHSL}, nil),
+		stable("country", &CountryFactory{}, nil),
+		stable("creditcard", &CreditCardFactory{}, nil, anchor),
+		stable("currency", &CurrencyFactory{}, nil),
+		stable("filepath", &FilePathFactory{Style: PathPOSIX, MaxDepth: 3}, nil),
+		stable("hotspot", NewHotspotFactory(HotspotSpec{
+			Hotspots: []Hotspot{{Name: "tokyo", Latitude: 35.68, Longitude: 139.76, RadiusKm: 5}},
+			Peaks:    []PeakHour{{Hour: 18}},
+		}), nil),
+		stable("iban", &IBANFactory{Format: IBANPrint}, nil),
+		stable("json", document, func(value json.RawMessage) any { return string(value) }),
+		stable("jsonmap", document.Map(), func(value map[string]any) any { encoded, _ := json.Marshal(value); return string(encoded) }),
+		stable("locale", &LocaleFactory{}, nil),
+		stable("number", (&LocalizedNumberFactory{Min: -1e6, Max: 1e6, Decimals: 2}).Localized(LocaleGerman), nil),
+		stable("date", &LocalizedDateFactory{}, nil, anchor, WithLocale(LocaleJapanese)),
+		stable("sequence", (&SequenceFactory{Start: 100, Step: 5}).Formatted("INV-%06d"), nil),
+		stable("slug", &SlugFactory{MaxWords: 4}, nil),
+		stable("template", NewTemplateFactory("user-{seq:3}-{alpha:5}-{hex:4}"), nil),
+		stable("useragent", &UserAgentFactory{}, nil),
+		stable("slice", NewSliceFactory[int](&IntFactory{Max: 9}), nil),
+		stable("pointer", NewPointerFactory[int](&IntFactory{Max: 9}), func(value *int) any { return *value }),
+		stable("oneof", NewOneOfFactory(Branch("low", &IntFactory{Max: 9}, 1), Branch("high", &IntFactory{Min: 100, Max: 999}, 3)), nil),
+	}
+
+	for _, seed := range []int64{0, 1, 42, 123456789} {
+		for _, generator := range generators {
+			record(generator.name, seed, generator.build(seed))
+		}
+	}
+
+	record("string-build", 0, texts.Build(nil))
+	record("string-build", 1, texts.Build(nil))
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func sortedEntries(values map[string]string) string {
+	entries := make([]string, 0, len(values))
+	for key, value := range values {
+		entries = append(entries, key+"="+value)
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+func formatSeries(points []TimeSeriesPoint) string {
+	formatted := make([]string, len(points))
+	for index, point := range points {
+		formatted[index] = fmt.Sprintf("%s=%.6f", point.Timestamp.Format(time.RFC3339), point.Value)
+	}
+	return strings.Join(formatted, ",")
+}
+
+func TestStableModeGolden(t *testing.T) {
+	path := filepath.Join("testdata", "stable.golden")
+	actual := stableOutput()
+
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(actual), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+
+	if string(expected) != actual {
+		t.Fatalf("generator output changed for version %s; bump GeneratorVersion and run go test -update.\nexpected:\n%s\nactual:\n%s", GeneratorVersion, expected, actual)
+	}
+}
+
+func TestStableModeBuildIsReproducible(t *testing.T) {
+	first := Builder(&StringFactory{}, StableMode(GeneratorVersion)).BuildList(5, nil)
+	second := Builder(&StringFactory{}, StableMode(GeneratorVersion)).BuildList(5, nil)
+
+	for index := range first {
+		if first[index] != second[index] {
+			t.Fatalf("expected reproducible builds at %d", index)
+		}
+	}
+}
+
+func TestStableModePanicsOnVersionMismatch(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic for mismatched generator version")
+		}
+	}()
+
+	Builder(&StringFactory{}, StableMode("0.0"))
+}
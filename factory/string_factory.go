@@ -1,18 +1,27 @@
 package factory
 
 import (
-	"github.com/lihs-ie/forge/internal/math"
+	"strings"
+	"unicode"
 )
 
 // CharacterSet defines a pool of runes used for random string generation.
 type CharacterSet []rune
 
-// Characters provides common rune sets for string generation.
+// Characters provides common rune sets for string generation. CJK, Cyrillic and Emoji hold
+// multibyte characters for exercising UTF-8 handling.
 var Characters = struct {
 	Alphanumeric CharacterSet
 	Alpha        CharacterSet
 	Numeric      CharacterSet
 	Symbol       CharacterSet
+	// CJK holds the CJK Unified Ideographs block, U+4E00 to U+9FFF.
+	CJK CharacterSet
+	// Cyrillic holds the Russian alphabet: А to я, Ё and ё.
+	Cyrillic CharacterSet
+	// Emoji holds the Miscellaneous Symbols and Pictographs and the Emoticons blocks, U+1F300 to
+	// U+1F64F.
+	Emoji CharacterSet
 }{
 	Alphanumeric: CharacterSet{
 		'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm',
@@ -35,6 +44,34 @@ var Characters = struct {
 		'/', ':', ';', '<', '=', '>', '?', '@', '[', '\\', ']', '^', '_', '`',
 		'{', '|', '}', '~',
 	},
+	CJK:      CharacterRange(0x4E00, 0x9FFF),
+	Cyrillic: append(CharacterRange('А', 'я'), 'Ё', 'ё'),
+	Emoji:    CharacterRange(0x1F300, 0x1F64F),
+}
+
+// CharacterRange returns the runes from low to high inclusive.
+func CharacterRange(low, high rune) CharacterSet {
+	characters := make(CharacterSet, 0, max(high-low+1, 0))
+	for character := low; character <= high; character++ {
+		characters = append(characters, character)
+	}
+	return characters
+}
+
+// Script returns the runes of a Unicode range table such as unicode.Greek or unicode.Hangul.
+func Script(table *unicode.RangeTable) CharacterSet {
+	var characters CharacterSet
+	for _, span := range table.R16 {
+		for character := rune(span.Lo); character <= rune(span.Hi); character += rune(span.Stride) {
+			characters = append(characters, character)
+		}
+	}
+	for _, span := range table.R32 {
+		for character := rune(span.Lo); character <= rune(span.Hi); character += rune(span.Stride) {
+			characters = append(characters, character)
+		}
+	}
+	return characters
 }
 
 // StringProperties carries configuration and generated values for StringFactory.
@@ -50,6 +87,28 @@ type StringFactory struct {
 	Min        int
 	Max        int
 	Characters CharacterSet
+
+	adversarial bool
+}
+
+// Adversarial returns a copy of the factory that favours edge-case strings.
+func (f *StringFactory) Adversarial() Factory[string, StringProperties] {
+	adversarial := *f
+	adversarial.adversarial = true
+	return &adversarial
+}
+
+// Boundaries returns one string for each boundary length of the factory's Min and Max.
+func (f *StringFactory) Boundaries() []string {
+	minLength, maxLength, characters := f.bounds()
+
+	lengths := boundaryPoints(int64(minLength), int64(maxLength))
+	values := make([]string, len(lengths))
+	for index, length := range lengths {
+		values[index] = generateString(int64(index), int(length), int(length), characters)
+	}
+
+	return values
 }
 
 // Instantiate returns the final string value from prepared properties.
@@ -59,23 +118,7 @@ func (f *StringFactory) Instantiate(properties StringProperties) string {
 
 // Prepare produces StringProperties using the provided seed and overrides.
 func (f *StringFactory) Prepare(overrides Partial[StringProperties], seed int64) StringProperties {
-	minLength := f.Min
-	if minLength <= 0 {
-		minLength = 1
-	}
-
-	maxLength := f.Max
-	if maxLength <= 0 {
-		maxLength = 255
-	}
-	if maxLength < minLength {
-		maxLength = minLength
-	}
-
-	chars := f.Characters
-	if len(chars) == 0 {
-		chars = Characters.Alphanumeric
-	}
+	minLength, maxLength, chars := f.bounds()
 
 	properties := StringProperties{
 		min:        minLength,
@@ -98,18 +141,11 @@ func (f *StringFactory) Prepare(overrides Partial[StringProperties], seed int64)
 	}
 
 	if properties.value == "" {
-		offset := seed % int64(properties.max-properties.min+1)
-		length := properties.min + int(offset)
-
-		value := make([]rune, length)
-		for index := range length {
-			//nolint:gosec // G115: Controlled conversion for hash scrambling within expected range
-			scrambled := math.Scramble(uint32(seed + int64(index)))
-			characterIndex := int(scrambled) % len(properties.characters)
-			value[index] = properties.characters[characterIndex]
+		if edge, ok := f.edgeCase(seed, properties.min, properties.max, properties.characters); ok {
+			properties.value = edge
+		} else {
+			properties.value = generateString(seed, properties.min, properties.max, properties.characters)
 		}
-
-		properties.value = string(value)
 	}
 
 	return properties
@@ -121,3 +157,34 @@ func (f *StringFactory) Retrieve(instance string) StringProperties {
 		value: instance,
 	}
 }
+
+const stringEdgeCases = 7
+
+// edgeCase returns an edge-case string for seed when the factory is in adversarial mode.
+func (f *StringFactory) edgeCase(seed int64, minLength, maxLength int, characters CharacterSet) (string, bool) {
+	if !f.adversarial {
+		return "", false
+	}
+
+	index, ok := adversarialCase(seed, stringEdgeCases)
+	if !ok {
+		return "", false
+	}
+
+	switch index {
+	case 0:
+		return "", true
+	case 1:
+		return strings.Repeat(string(characters[0]), minLength), true
+	case 2:
+		return strings.Repeat(string(characters[0]), maxLength), true
+	case 3:
+		return " \t\n", true
+	case 4:
+		return "\x00\x1b[31m\u202e\u200b", true
+	case 5:
+		return "é漢字😀", true
+	default:
+		return "' OR '1'='1", true
+	}
+}
@@ -3,6 +3,8 @@ package factory
 import (
 	"strings"
 	"testing"
+	"unicode"
+	"unicode/utf8"
 )
 
 func TestStringFactoryOverrideConfig(t *testing.T) {
@@ -397,3 +399,52 @@ func TestStringFactoryPrepareVariousLengths(t *testing.T) {
 		t.Errorf("Expected at least 50 different lengths, got %d", len(lengths))
 	}
 }
+
+func TestCharactersUnicodeSets(t *testing.T) {
+	if len(Characters.CJK) != 0x9FFF-0x4E00+1 {
+		t.Errorf("Expected the whole CJK Unified Ideographs block, got %d characters", len(Characters.CJK))
+	}
+	if len(Characters.Cyrillic) != 66 {
+		t.Errorf("Expected 66 Cyrillic characters, got %d", len(Characters.Cyrillic))
+	}
+
+	sets := map[string]struct {
+		characters CharacterSet
+		table      *unicode.RangeTable
+	}{
+		"CJK":      {Characters.CJK, unicode.Han},
+		"Cyrillic": {Characters.Cyrillic, unicode.Cyrillic},
+		"Emoji":    {Characters.Emoji, unicode.So},
+	}
+	for name, set := range sets {
+		for _, char := range set.characters {
+			if !unicode.Is(set.table, char) && !unicode.Is(unicode.Sk, char) {
+				t.Fatalf("Expected %s to hold only matching characters, got %U", name, char)
+			}
+		}
+	}
+
+	value := (&StringFactory{Min: 10, Max: 10, Characters: Characters.CJK}).Generate(1)
+	if utf8.RuneCountInString(value) != 10 || len(value) != 30 {
+		t.Errorf("Expected 10 three-byte characters, got %q", value)
+	}
+}
+
+func TestScript(t *testing.T) {
+	greek := Script(unicode.Greek)
+	if len(greek) == 0 {
+		t.Fatal("Expected Greek characters")
+	}
+	for _, char := range greek {
+		if !unicode.Is(unicode.Greek, char) {
+			t.Fatalf("Expected only Greek characters, got %U", char)
+		}
+	}
+
+	if got := CharacterRange('a', 'e'); string(got) != "abcde" {
+		t.Errorf("Expected abcde, got %q", string(got))
+	}
+	if got := CharacterRange('e', 'a'); len(got) != 0 {
+		t.Errorf("Expected an empty range, got %q", string(got))
+	}
+}
@@ -0,0 +1,141 @@
+package factory
+
+import (
+	"fmt"
+	stdmath "math"
+	"reflect"
+	"time"
+)
+
+const (
+	structStringMin = 8
+	structStringMax = 16
+	structMaxDepth  = 4
+)
+
+// StructField registers the factory of one field for NewStructFactory.
+type StructField struct {
+	name  string
+	typ   reflect.Type
+	build func(seed int64) reflect.Value
+}
+
+// WithField makes StructFactory fill the field called name with values built by factory.
+func WithField[V any, P any](name string, factory Factory[V, P]) StructField {
+	return StructField{
+		name: name,
+		typ:  reflect.TypeFor[V](),
+		build: func(seed int64) reflect.Value {
+			return reflect.ValueOf(create(factory, Overrider[P]{}, seed))
+		},
+	}
+}
+
+// StructFactory assembles structs from per-field factories registered through WithField and
+// generates every other exported field by kind: strings, booleans, integers, floats, time.Time,
+// nested structs and pointers to them. Fields of other kinds, unexported fields and fields tagged
+// `forge:"-"` are left zero. The properties are T itself and overrides apply after generation,
+// so Override[T] can set any field, including to its zero value.
+type StructFactory[T any] struct {
+	fields map[string]StructField
+}
+
+// NewStructFactory creates a StructFactory for T. It panics when T is not a struct, a registered
+// field does not exist or its factory's type is not assignable to the field.
+func NewStructFactory[T any](fields ...StructField) *StructFactory[T] {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("struct: %s must be a struct", typ))
+	}
+
+	registered := make(map[string]StructField, len(fields))
+	for _, field := range fields {
+		target, ok := typ.FieldByName(field.name)
+		if !ok || !target.IsExported() {
+			panic(fmt.Sprintf("struct: %s has no exported field %q", typ, field.name))
+		}
+		if !field.typ.AssignableTo(target.Type) {
+			panic(fmt.Sprintf("struct: cannot assign %s to %s.%s of type %s", field.typ, typ, field.name, target.Type))
+		}
+		registered[field.name] = field
+	}
+
+	return &StructFactory[T]{
+		fields: registered,
+	}
+}
+
+// Instantiate returns the prepared struct.
+func (f *StructFactory[T]) Instantiate(properties T) T {
+	return properties
+}
+
+// Prepare generates every field before applying overrides.
+func (f *StructFactory[T]) Prepare(overrides Partial[T], seed int64) T {
+	var properties T
+	f.fill(reflect.ValueOf(&properties).Elem(), seed, 0)
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return properties
+}
+
+// Retrieve returns the instance, which doubles as its properties.
+func (f *StructFactory[T]) Retrieve(instance T) T {
+	return instance
+}
+
+// fill generates the fields of the struct value; registered factories only apply to top-level
+// fields, at depth 0.
+func (f *StructFactory[T]) fill(value reflect.Value, seed int64, depth int) {
+	typ := value.Type()
+	for index := range typ.NumField() {
+		field := typ.Field(index)
+		if !field.IsExported() || field.Tag.Get("forge") == "-" {
+			continue
+		}
+
+		fieldSeed := seed + int64(index)
+		if registered, ok := f.fields[field.Name]; ok && depth == 0 {
+			value.Field(index).Set(registered.build(fieldSeed))
+			continue
+		}
+
+		f.generate(value.Field(index), fieldSeed, depth)
+	}
+}
+
+// generate fills target with a value derived from seed according to its kind. Pointers nested
+// deeper than structMaxDepth stay nil, so self-referential types terminate.
+func (f *StructFactory[T]) generate(target reflect.Value, seed int64, depth int) {
+	if target.Type() == timeType {
+		target.Set(reflect.ValueOf((&TimeFactory{Truncate: time.Second}).Prepare(nil, seed).value))
+		return
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(generateString(seed, structStringMin, structStringMax, Characters.Alphanumeric))
+	case reflect.Bool:
+		target.SetBool(BoolAt(seed, 0.5))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		target.SetInt(IntAt(seed, 0, min(defaultIntMax, int64(stdmath.MaxInt64)>>(64-target.Type().Bits()))))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		//nolint:gosec // G115: The generated value is non-negative and bounded by the type width
+		target.SetUint(uint64(IntAt(seed, 0, int64(min(defaultIntMax, uint64(stdmath.MaxUint64)>>(64-target.Type().Bits()))))))
+	case reflect.Float32, reflect.Float64:
+		target.SetFloat(stdmath.Round(FloatAt(seed, 0, defaultIntMax)*100) / 100)
+	case reflect.Struct:
+		f.fill(target, seed, depth+1)
+	case reflect.Pointer:
+		if depth >= structMaxDepth {
+			return
+		}
+		element := reflect.New(target.Type().Elem())
+		f.generate(element.Elem(), seed, depth+1)
+		target.Set(element)
+	default:
+	}
+}
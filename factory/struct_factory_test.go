@@ -0,0 +1,105 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type structTestAddress struct {
+	City string
+	Zip  uint16
+}
+
+type structTestUser struct {
+	ID       int
+	Name     string
+	Email    string
+	Score    float64
+	Active   bool
+	Level    int8
+	Joined   time.Time
+	Home     structTestAddress
+	Work     *structTestAddress
+	Tags     []string
+	Internal string `forge:"-"`
+	parent   *structTestUser
+	Manager  *structTestUser
+}
+
+func TestStructFactoryGeneratesFields(t *testing.T) {
+	user := Builder(NewStructFactory[structTestUser]()).BuildWith(3, nil)
+
+	if user.Name == "" || user.Email == "" || user.Home.City == "" || user.Work == nil || user.Work.City == "" {
+		t.Fatalf("expected generated strings and nested structs, got %+v", user)
+	}
+	if user.Joined.IsZero() || user.Level < 0 {
+		t.Fatalf("expected generated time and non-negative int8, got %+v", user)
+	}
+	if user.Tags != nil || user.Internal != "" || user.parent != nil {
+		t.Fatalf("expected unsupported, skipped and unexported fields left zero, got %+v", user)
+	}
+}
+
+func TestStructFactoryRegisteredFields(t *testing.T) {
+	builder := Builder(NewStructFactory[structTestUser](
+		WithField("Email", NewEnumFactory([]string{"a@example.com", "b@example.com"})),
+		WithField("ID", &IntFactory{Min: 100, Max: 200}),
+	))
+
+	for _, user := range builder.BuildList(20, nil) {
+		if user.Email != "a@example.com" && user.Email != "b@example.com" {
+			t.Fatalf("expected registered email factory, got %q", user.Email)
+		}
+		if user.ID < 100 || user.ID > 200 {
+			t.Fatalf("expected registered ID range, got %d", user.ID)
+		}
+	}
+}
+
+func TestStructFactoryOverridesAfterGeneration(t *testing.T) {
+	builder := Builder(NewStructFactory[structTestUser]())
+
+	user := builder.Build(Override[structTestUser](map[string]any{"Name": "alice", "Active": false, "ID": 0}))
+	if user.Name != "alice" || user.Active || user.ID != 0 {
+		t.Fatalf("expected overrides to win, got %+v", user)
+	}
+}
+
+func TestStructFactorySelfReferenceTerminates(t *testing.T) {
+	user := Builder(NewStructFactory[structTestUser]()).Build(nil)
+
+	depth := 0
+	for manager := user.Manager; manager != nil; manager = manager.Manager {
+		depth++
+	}
+	if depth == 0 || depth > structMaxDepth {
+		t.Fatalf("expected a bounded manager chain, got depth %d", depth)
+	}
+}
+
+func TestStructFactoryIsDeterministic(t *testing.T) {
+	builder := Builder(NewStructFactory[structTestUser]())
+
+	first, second := builder.BuildWith(9, nil), builder.BuildWith(9, nil)
+	if first.Name != second.Name || first.Score != second.Score || !first.Joined.Equal(second.Joined) {
+		t.Fatal("expected identical structs for the same seed")
+	}
+}
+
+func TestStructFactoryRejectsInvalidFields(t *testing.T) {
+	for name, register := range map[string]func(){
+		"unknown field": func() { NewStructFactory[structTestUser](WithField("Missing", &IntFactory{})) },
+		"type mismatch": func() { NewStructFactory[structTestUser](WithField("Name", &IntFactory{})) },
+		"non-struct":    func() { NewStructFactory[int]() },
+	} {
+		func() {
+			defer func() {
+				if message, _ := recover().(string); !strings.HasPrefix(message, "struct:") {
+					t.Fatalf("%s: expected panic, got %q", name, message)
+				}
+			}()
+			register()
+		}()
+	}
+}
@@ -0,0 +1,187 @@
+package factory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+const defaultTemplateLength = 8
+
+var templateCharacters = map[string]CharacterSet{
+	"alpha":        Characters.Alpha,
+	"numeric":      Characters.Numeric,
+	"alphanumeric": Characters.Alphanumeric,
+	"lower":        Characters.Alpha[:26],
+	"upper":        Characters.Alpha[26:],
+	"hex":          CharacterSet("0123456789abcdef"),
+}
+
+// templatePart is a literal or a placeholder of a parsed template. A placeholder without
+// characters is {seq}, whose width zero-pads the number.
+type templatePart struct {
+	literal    string
+	characters CharacterSet
+	min        int
+	max        int
+	sequence   bool
+}
+
+// TemplateProperties carries the sequence number and the filled template for TemplateFactory.
+type TemplateProperties struct {
+	sequence int
+	value    string
+}
+
+// TemplateFactory fills a pattern such as "user-{seq}-{alpha:5}-{numeric:3}" with a generated
+// value per placeholder, for formatted identifiers declared inline. Placeholders are:
+//
+//   - {seq} or {seq:N}: the next number of a counter shared by the factory's builds, starting at
+//     1 and zero-padded to N digits; the seed does not affect it
+//   - {alpha:N}, {lower:N}, {upper:N}, {numeric:N}, {alphanumeric:N} and {hex:N}: N seeded
+//     characters from the set (8 when N is omitted), or N to M with {alpha:N-M}
+//
+// "{{" and "}}" produce literal braces. A TemplateFactory must not be copied after first use.
+type TemplateFactory struct {
+	pattern string
+	parts   []templatePart
+	counter atomic.Int64
+}
+
+// NewTemplateFactory parses pattern and panics on unknown placeholders, invalid lengths or
+// unbalanced braces.
+func NewTemplateFactory(pattern string) *TemplateFactory {
+	return &TemplateFactory{
+		pattern: pattern,
+		parts:   parseTemplate(pattern),
+	}
+}
+
+// Pattern returns the template the factory was created with.
+func (f *TemplateFactory) Pattern() string {
+	return f.pattern
+}
+
+// Instantiate returns the filled template.
+func (f *TemplateFactory) Instantiate(properties TemplateProperties) string {
+	return properties.value
+}
+
+// Prepare takes the next sequence number before applying overrides, then fills the template
+// unless the value is overridden; an overridden sequence number is used for {seq}.
+func (f *TemplateFactory) Prepare(overrides Partial[TemplateProperties], seed int64) TemplateProperties {
+	properties := TemplateProperties{
+		sequence: int(f.counter.Add(1)),
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value == "" {
+		properties.value = f.fill(properties.sequence, seed)
+	}
+
+	return properties
+}
+
+// Retrieve wraps an existing string into TemplateProperties.
+func (f *TemplateFactory) Retrieve(instance string) TemplateProperties {
+	return TemplateProperties{
+		value: instance,
+	}
+}
+
+// Reset restarts {seq} at 1.
+func (f *TemplateFactory) Reset() {
+	f.counter.Store(0)
+}
+
+func (f *TemplateFactory) fill(sequence int, seed int64) string {
+	var builder strings.Builder
+	for index, part := range f.parts {
+		switch {
+		case part.sequence:
+			fmt.Fprintf(&builder, "%0*d", part.min, sequence)
+		case part.characters != nil:
+			builder.WriteString(generateString(childSeed(seed, index), part.min, part.max, part.characters))
+		default:
+			builder.WriteString(part.literal)
+		}
+	}
+	return builder.String()
+}
+
+func parseTemplate(pattern string) []templatePart {
+	var parts []templatePart
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			parts = append(parts, templatePart{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for index := 0; index < len(pattern); index++ {
+		switch character := pattern[index]; {
+		case strings.HasPrefix(pattern[index:], "{{"), strings.HasPrefix(pattern[index:], "}}"):
+			literal.WriteByte(character)
+			index++
+		case character == '{':
+			end := strings.IndexByte(pattern[index:], '}')
+			if end < 0 {
+				panic(fmt.Sprintf("template: unclosed placeholder in %q", pattern))
+			}
+			flush()
+			parts = append(parts, parseTemplatePlaceholder(pattern[index+1:index+end]))
+			index += end
+		case character == '}':
+			panic(fmt.Sprintf("template: unmatched '}' in %q", pattern))
+		default:
+			literal.WriteByte(character)
+		}
+	}
+	flush()
+
+	return parts
+}
+
+func parseTemplatePlaceholder(placeholder string) templatePart {
+	name, length, hasLength := strings.Cut(placeholder, ":")
+
+	if name == "seq" {
+		width := 0
+		if hasLength {
+			width = parseTemplateLength(placeholder, length)
+		}
+		return templatePart{sequence: true, min: width}
+	}
+
+	characters, ok := templateCharacters[name]
+	if !ok {
+		panic(fmt.Sprintf("template: unknown placeholder {%s}", placeholder))
+	}
+	if !hasLength {
+		return templatePart{characters: characters, min: defaultTemplateLength, max: defaultTemplateLength}
+	}
+
+	low, high, isRange := strings.Cut(length, "-")
+	minimum := parseTemplateLength(placeholder, low)
+	maximum := minimum
+	if isRange {
+		maximum = parseTemplateLength(placeholder, high)
+	}
+	if minimum == 0 || maximum < minimum {
+		panic(fmt.Sprintf("template: invalid length in {%s}", placeholder))
+	}
+	return templatePart{characters: characters, min: minimum, max: maximum}
+}
+
+func parseTemplateLength(placeholder, length string) int {
+	value, err := strconv.Atoi(length)
+	if err != nil || value < 0 {
+		panic(fmt.Sprintf("template: invalid length in {%s}", placeholder))
+	}
+	return value
+}
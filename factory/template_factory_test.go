@@ -0,0 +1,73 @@
+package factory
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestTemplateFactoryFillsPlaceholders(t *testing.T) {
+	factory := NewTemplateFactory("user-{seq}-{alpha:5}-{numeric:3}")
+	pattern := regexp.MustCompile(`^user-(\d+)-[a-zA-Z]{5}-[0-9]{3}$`)
+
+	for index, value := range Builder(factory).BuildList(20, nil) {
+		match := pattern.FindStringSubmatch(value)
+		if match == nil {
+			t.Fatalf("value %d %q does not match the template", index, value)
+		}
+		if match[1] != strconv.Itoa(index+1) {
+			t.Fatalf("expected sequence %d, got %s", index+1, match[1])
+		}
+	}
+}
+
+func TestTemplateFactoryPlaceholderForms(t *testing.T) {
+	factory := NewTemplateFactory("{{{seq:4}}}/{lower:2-4}{upper:1}/{hex}/{alphanumeric:3}")
+	pattern := regexp.MustCompile(`^\{0001\}/[a-z]{2,4}[A-Z]/[0-9a-f]{8}/[a-zA-Z0-9]{3}$`)
+
+	if value := Builder(factory).Build(nil); !pattern.MatchString(value) {
+		t.Fatalf("unexpected value %q", value)
+	}
+}
+
+func TestTemplateFactoryIsDeterministic(t *testing.T) {
+	first := NewTemplateFactory("{alpha:6}-{numeric:4}")
+	second := NewTemplateFactory("{alpha:6}-{numeric:4}")
+
+	for seed := range int64(10) {
+		if Builder(first).BuildWith(seed, nil) != Builder(second).BuildWith(seed, nil) {
+			t.Fatalf("expected equal values for seed %d", seed)
+		}
+	}
+}
+
+func TestTemplateFactorySequenceOverrideAndReset(t *testing.T) {
+	factory := NewTemplateFactory("order-{seq:3}")
+	builder := Builder(factory)
+
+	if value := builder.Build(Override[TemplateProperties](map[string]any{"sequence": 42})); value != "order-042" {
+		t.Fatalf("unexpected value %q", value)
+	}
+	if value := builder.Build(nil); value != "order-002" {
+		t.Fatalf("expected the override to still advance the sequence, got %q", value)
+	}
+
+	factory.Reset()
+	if value := builder.Build(nil); value != "order-001" {
+		t.Fatalf("expected the sequence to restart, got %q", value)
+	}
+}
+
+func TestTemplateFactoryPanicsForInvalidTemplates(t *testing.T) {
+	for _, pattern := range []string{"{unknown}", "{alpha:x}", "{alpha:0}", "{alpha:5-2}", "open {seq", "close }"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected panic for %q", pattern)
+				}
+			}()
+
+			NewTemplateFactory(pattern)
+		}()
+	}
+}
@@ -0,0 +1,22 @@
+package factory
+
+import "time"
+
+// defaultAnchorWindow is how far before the anchor anchored factories generate times by default.
+const defaultAnchorWindow = 30 * 24 * time.Hour
+
+// Anchorable is implemented by time-producing factories that can generate values relative to a
+// fixed anchor instead of their built-in default range. Anchored must return a copy of the factory
+// bound to anchor, leaving the receiver untouched.
+type Anchorable[T any, P any] interface {
+	Anchored(anchor time.Time) Factory[T, P]
+}
+
+// WithTimeAnchor binds the builder to anchor when the factory implements Anchorable, so generated
+// times fall within 30 days before anchor unless the factory's range is configured explicitly.
+// This keeps datasets coherent regardless of the wall clock.
+func WithTimeAnchor(anchor time.Time) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.anchor = anchor
+	}
+}
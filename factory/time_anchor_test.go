@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"testing"
+	"time"
+)
+
+var testAnchor = time.Date(2030, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+func TestWithTimeAnchorEndsTimeSeriesAtAnchor(t *testing.T) {
+	builder := Builder(&TimeSeriesFactory{Count: 10, Interval: time.Hour}, WithTimeAnchor(testAnchor))
+
+	points := builder.Build(nil)
+
+	if !points[len(points)-1].Timestamp.Equal(testAnchor) {
+		t.Fatalf("expected series to end at anchor, got %s", points[len(points)-1].Timestamp)
+	}
+}
+
+func TestWithTimeAnchorKeepsConfiguredStart(t *testing.T) {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	builder := Builder(&TimeSeriesFactory{Start: start}, WithTimeAnchor(testAnchor))
+
+	if points := builder.Build(nil); !points[0].Timestamp.Equal(start) {
+		t.Fatalf("expected configured start to win, got %s", points[0].Timestamp)
+	}
+}
+
+func TestWithTimeAnchorBoundsLocalizedDates(t *testing.T) {
+	builder := Builder(&LocalizedDateFactory{}, WithTimeAnchor(testAnchor), WithLocale(LocaleGerman))
+
+	for _, value := range builder.BuildList(50, nil) {
+		date, err := time.Parse("02.01.2006", value)
+		if err != nil {
+			t.Fatalf("unexpected date %q: %v", value, err)
+		}
+		if date.Before(testAnchor.Add(-defaultAnchorWindow).Truncate(24*time.Hour)) || date.After(testAnchor) {
+			t.Fatalf("expected date within 30 days before anchor, got %s", date)
+		}
+	}
+}
+
+func TestAnchoredLeavesReceiverUntouched(t *testing.T) {
+	factory := &TimeSeriesFactory{}
+	factory.Anchored(testAnchor)
+
+	if !factory.anchor.IsZero() {
+		t.Fatal("expected Anchored to return a copy")
+	}
+}
@@ -0,0 +1,159 @@
+package factory
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	defaultTimeFrom = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	defaultTimeTo   = time.Date(2030, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// Day truncates TimeFactory values to midnight in the factory's Location.
+const Day = 24 * time.Hour
+
+// TimeProperties carries the range and generated value for TimeFactory.
+type TimeProperties struct {
+	value time.Time
+	from  time.Time
+	to    time.Time
+}
+
+// TimeFactory generates time.Time values within [From, To] (2000-01-01 to 2030-12-31 UTC when
+// unset, or the 30 days before the anchor with WithTimeAnchor). Truncate rounds values down to a
+// multiple of it, with Day meaning midnight in Location, and Location (UTC when nil) is the zone
+// of every generated value.
+type TimeFactory struct {
+	From     time.Time
+	To       time.Time
+	Truncate time.Duration
+	Location *time.Location
+
+	anchor time.Time
+	cursor *timeCursor
+}
+
+// timeCursor tracks the last generated value of a monotonic TimeFactory.
+type timeCursor struct {
+	mu   sync.Mutex
+	step time.Duration
+	last time.Time
+}
+
+// Anchored returns a copy of the factory that picks times within 30 days before anchor unless
+// From or To is configured.
+func (f *TimeFactory) Anchored(anchor time.Time) Factory[time.Time, TimeProperties] {
+	anchored := *f
+	anchored.anchor = anchor
+	return &anchored
+}
+
+// Monotonic returns a copy of the factory whose generated values never decrease in call order:
+// each one lies at most step (and at least one Truncate unit) after the previous, starting at From.
+// Values only increase strictly until To is reached; from then on the last value is repeated. The
+// cursor advances on every Prepare call, including those made internally by operations such as
+// DuplicateAsNew, so a value depends on the builds before it rather than on its seed alone. The
+// copy starts from From again; concurrent builds share the sequence.
+func (f *TimeFactory) Monotonic(step time.Duration) *TimeFactory {
+	monotonic := *f
+	monotonic.cursor = &timeCursor{step: step}
+	return &monotonic
+}
+
+// Instantiate returns the prepared time.
+func (f *TimeFactory) Instantiate(properties TimeProperties) time.Time {
+	return properties.value
+}
+
+// Prepare applies overrides and picks a value within the range when none is set. Overridden
+// values are used as is.
+func (f *TimeFactory) Prepare(overrides Partial[TimeProperties], seed int64) TimeProperties {
+	properties := TimeProperties{
+		from: f.From,
+		to:   f.To,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.from.IsZero() && properties.to.IsZero() && !f.anchor.IsZero() {
+		properties.from = f.anchor.Add(-defaultAnchorWindow)
+		properties.to = f.anchor
+	}
+	if properties.from.IsZero() {
+		properties.from = defaultTimeFrom
+	}
+	if properties.to.IsZero() {
+		properties.to = defaultTimeTo
+	}
+	if properties.to.Before(properties.from) {
+		properties.to = properties.from
+	}
+
+	if properties.value.IsZero() {
+		if f.cursor != nil {
+			properties.value = f.next(seed, properties.from, properties.to)
+		} else {
+			span := properties.to.Sub(properties.from)
+			properties.value = f.truncate(properties.from.Add(time.Duration(IntAt(seed, 0, int64(span)))), properties.from)
+		}
+	}
+
+	return properties
+}
+
+// Retrieve converts a time back into TimeProperties.
+func (f *TimeFactory) Retrieve(instance time.Time) TimeProperties {
+	return TimeProperties{
+		value: instance,
+	}
+}
+
+// next advances the monotonic cursor by a seed-determined step, staying put once to is reached.
+func (f *TimeFactory) next(seed int64, from, to time.Time) time.Time {
+	f.cursor.mu.Lock()
+	defer f.cursor.mu.Unlock()
+
+	if f.cursor.last.IsZero() {
+		f.cursor.last = f.truncate(from, from)
+		return f.cursor.last
+	}
+
+	unit := max(f.Truncate, time.Nanosecond)
+	step := max(f.cursor.step, unit)
+	next := f.truncate(f.cursor.last.Add(time.Duration(IntAt(seed, int64(unit), int64(step)))), from)
+	if next.After(to) {
+		next = f.cursor.last
+	}
+
+	f.cursor.last = next
+	return next
+}
+
+// truncate rounds value down to the configured unit in the factory's location, rounding up instead
+// when that would move it before from.
+func (f *TimeFactory) truncate(value, from time.Time) time.Time {
+	location := f.Location
+	if location == nil {
+		location = time.UTC
+	}
+	value = value.In(location)
+
+	switch {
+	case f.Truncate == Day:
+		year, month, day := value.Date()
+		value = time.Date(year, month, day, 0, 0, 0, 0, location)
+		if value.Before(from) {
+			value = value.AddDate(0, 0, 1)
+		}
+	case f.Truncate > 0:
+		value = value.Truncate(f.Truncate)
+		if value.Before(from) {
+			value = value.Add(f.Truncate)
+		}
+	}
+
+	return value
+}
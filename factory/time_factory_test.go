@@ -0,0 +1,96 @@
+package factory
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeFactoryStaysWithinRange(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	builder := Builder(&TimeFactory{From: from, To: to})
+
+	for _, value := range builder.BuildListWith(200, 1, nil) {
+		if value.Before(from) || value.After(to) {
+			t.Fatalf("value %v out of range", value)
+		}
+	}
+}
+
+func TestTimeFactoryDefaults(t *testing.T) {
+	for _, value := range Builder(&TimeFactory{}).BuildList(100, nil) {
+		if value.Before(defaultTimeFrom) || value.After(defaultTimeTo) || value.Location() != time.UTC {
+			t.Fatalf("value %v outside default range", value)
+		}
+	}
+}
+
+func TestTimeFactoryTruncation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	for _, value := range Builder(&TimeFactory{Truncate: Day, Location: tokyo}).BuildListWith(50, 1, nil) {
+		if value.Location() != tokyo || value.Hour() != 0 || value.Minute() != 0 || value.Nanosecond() != 0 {
+			t.Fatalf("expected midnight in JST, got %v", value)
+		}
+	}
+
+	for _, value := range Builder(&TimeFactory{Truncate: time.Second}).BuildListWith(50, 1, nil) {
+		if value.Nanosecond() != 0 {
+			t.Fatalf("expected whole seconds, got %v", value)
+		}
+	}
+}
+
+func TestTimeFactoryMonotonic(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	builder := Builder((&TimeFactory{From: from, Truncate: time.Second}).Monotonic(time.Minute))
+
+	values := builder.BuildList(100, nil)
+	if !values[0].Equal(from) {
+		t.Fatalf("expected sequence to start at From, got %v", values[0])
+	}
+	for index := 1; index < len(values); index++ {
+		gap := values[index].Sub(values[index-1])
+		if gap < time.Second || gap > time.Minute {
+			t.Fatalf("expected increasing values at most a minute apart, got gap %v", gap)
+		}
+	}
+}
+
+func TestTimeFactoryMonotonicStopsAtTo(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Minute)
+	builder := Builder((&TimeFactory{From: from, To: to}).Monotonic(time.Hour))
+
+	values := builder.BuildListWith(20, 1, nil)
+	for index, value := range values {
+		if value.After(to) {
+			t.Fatalf("value %v after To", value)
+		}
+		if index > 0 && value.Before(values[index-1]) {
+			t.Fatalf("expected non-decreasing values, got %v after %v", value, values[index-1])
+		}
+	}
+	if !values[len(values)-1].Equal(values[len(values)-2]) {
+		t.Fatalf("expected the last value to repeat once To is reached, got %v", values[len(values)-2:])
+	}
+}
+
+func TestTimeFactoryAnchored(t *testing.T) {
+	anchor := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, value := range Builder(&TimeFactory{}, WithTimeAnchor(anchor)).BuildList(50, nil) {
+		if value.Before(anchor.Add(-defaultAnchorWindow)) || value.After(anchor) {
+			t.Fatalf("value %v outside anchored window", value)
+		}
+	}
+}
+
+func TestTimeFactoryOverride(t *testing.T) {
+	moment := time.Date(1999, 12, 31, 23, 59, 59, 0, time.UTC)
+	builder := Builder(&TimeFactory{})
+
+	if value := builder.Build(Override[TimeProperties](map[string]any{"value": moment})); !value.Equal(moment) {
+		t.Fatalf("expected overridden value, got %v", value)
+	}
+}
@@ -0,0 +1,160 @@
+package factory
+
+import (
+	stdmath "math"
+	"time"
+
+	"github.com/lihs-ie/forge/internal/math"
+)
+
+const (
+	defaultTimeSeriesCount    = 24
+	defaultTimeSeriesInterval = time.Hour
+	defaultTimeSeriesPeriod   = 24
+)
+
+var defaultTimeSeriesStart = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// TimeSeriesPoint is a single timestamped observation.
+type TimeSeriesPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// TimeSeriesProperties carries configuration and generated points for TimeSeriesFactory.
+type TimeSeriesProperties struct {
+	start       time.Time
+	interval    time.Duration
+	count       int
+	base        float64
+	trend       float64
+	noise       float64
+	seasonality float64
+	period      int
+	points      []TimeSeriesPoint
+}
+
+// TimeSeriesFactory generates ordered points following base + trend*i + seasonality + noise.
+type TimeSeriesFactory struct {
+	Start       time.Time
+	Interval    time.Duration
+	Count       int
+	Base        float64
+	Trend       float64
+	Noise       float64
+	Seasonality float64
+	Period      int
+
+	adversarial bool
+	anchor      time.Time
+}
+
+// timeSeriesEdgeStarts are the start times favoured in adversarial mode.
+var timeSeriesEdgeStarts = []time.Time{
+	time.Unix(0, 0).UTC(),
+	time.Date(2038, time.January, 19, 3, 14, 7, 0, time.UTC),
+	time.Date(2024, time.February, 29, 23, 0, 0, 0, time.UTC),
+	time.Date(9999, time.December, 31, 23, 0, 0, 0, time.UTC),
+}
+
+// Adversarial returns a copy of the factory that favours edge-case start times such as the Unix
+// epoch, the 2038 rollover and leap days, unless Start is configured or overridden.
+func (f *TimeSeriesFactory) Adversarial() Factory[[]TimeSeriesPoint, TimeSeriesProperties] {
+	adversarial := *f
+	adversarial.adversarial = true
+	return &adversarial
+}
+
+// Anchored returns a copy of the factory whose series ends at anchor unless Start is configured
+// or overridden.
+func (f *TimeSeriesFactory) Anchored(anchor time.Time) Factory[[]TimeSeriesPoint, TimeSeriesProperties] {
+	anchored := *f
+	anchored.anchor = anchor
+	return &anchored
+}
+
+// Instantiate returns the prepared points.
+func (f *TimeSeriesFactory) Instantiate(properties TimeSeriesProperties) []TimeSeriesPoint {
+	return properties.points
+}
+
+// Prepare generates points from the configuration, after overrides are applied.
+func (f *TimeSeriesFactory) Prepare(overrides Partial[TimeSeriesProperties], seed int64) TimeSeriesProperties {
+	properties := TimeSeriesProperties{
+		start:       f.Start,
+		interval:    f.Interval,
+		count:       f.Count,
+		base:        f.Base,
+		trend:       f.Trend,
+		noise:       f.Noise,
+		seasonality: f.Seasonality,
+		period:      f.Period,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.interval <= 0 {
+		properties.interval = defaultTimeSeriesInterval
+	}
+	if properties.count <= 0 {
+		properties.count = defaultTimeSeriesCount
+	}
+	if properties.start.IsZero() {
+		properties.start = defaultTimeSeriesStart
+		if !f.anchor.IsZero() {
+			properties.start = f.anchor.Add(-time.Duration(properties.count-1) * properties.interval)
+		}
+		if index, ok := adversarialCase(seed, len(timeSeriesEdgeStarts)); ok && f.adversarial {
+			properties.start = timeSeriesEdgeStarts[index]
+		}
+	}
+	if properties.period <= 0 {
+		properties.period = defaultTimeSeriesPeriod
+	}
+
+	if properties.points == nil {
+		properties.points = make([]TimeSeriesPoint, properties.count)
+		for index := range properties.count {
+			properties.points[index] = TimeSeriesPoint{
+				Timestamp: properties.start.Add(time.Duration(index) * properties.interval),
+				Value:     properties.valueAt(index, seed),
+			}
+		}
+	}
+
+	return properties
+}
+
+// Retrieve converts existing points back into TimeSeriesProperties.
+func (f *TimeSeriesFactory) Retrieve(instance []TimeSeriesPoint) TimeSeriesProperties {
+	properties := TimeSeriesProperties{
+		count:  len(instance),
+		points: instance,
+	}
+
+	if len(instance) > 0 {
+		properties.start = instance[0].Timestamp
+	}
+	if len(instance) > 1 {
+		properties.interval = instance[1].Timestamp.Sub(instance[0].Timestamp)
+	}
+
+	return properties
+}
+
+func (p *TimeSeriesProperties) valueAt(index int, seed int64) float64 {
+	position := float64(index)
+	value := p.base + p.trend*position
+	value += p.seasonality * stdmath.Sin(2*stdmath.Pi*position/float64(p.period))
+
+	if p.noise != 0 {
+		//nolint:gosec // G115: Controlled conversion for hash scrambling within expected range
+		scrambled := math.Scramble(uint32(seed + int64(index)))
+		unit := float64(scrambled)/float64(stdmath.MaxUint32)*2 - 1
+		value += p.noise * unit
+	}
+
+	return value
+}
@@ -0,0 +1,100 @@
+package factory
+
+import (
+	stdmath "math"
+	"testing"
+	"time"
+)
+
+func TestTimeSeriesFactoryDefaults(t *testing.T) {
+	builder := Builder(&TimeSeriesFactory{})
+
+	points := builder.BuildWith(1, nil)
+
+	if len(points) != defaultTimeSeriesCount {
+		t.Fatalf("expected %d points, got %d", defaultTimeSeriesCount, len(points))
+	}
+	if !points[0].Timestamp.Equal(defaultTimeSeriesStart) {
+		t.Errorf("expected default start, got %v", points[0].Timestamp)
+	}
+	for index := 1; index < len(points); index++ {
+		if points[index].Timestamp.Sub(points[index-1].Timestamp) != time.Hour {
+			t.Fatalf("expected hourly interval at %d", index)
+		}
+	}
+}
+
+func TestTimeSeriesFactoryTrendAndSeasonality(t *testing.T) {
+	builder := Builder(&TimeSeriesFactory{
+		Count:       8,
+		Base:        100,
+		Trend:       2,
+		Seasonality: 10,
+		Period:      4,
+	})
+
+	points := builder.BuildWith(1, nil)
+
+	expected := []float64{100, 112, 104, 96, 108, 120, 112, 104}
+	for index, point := range points {
+		if stdmath.Abs(point.Value-expected[index]) > 1e-9 {
+			t.Errorf("point %d: expected %v, got %v", index, expected[index], point.Value)
+		}
+	}
+}
+
+func TestTimeSeriesFactoryNoiseIsBoundedAndDeterministic(t *testing.T) {
+	builder := Builder(&TimeSeriesFactory{Count: 50, Base: 10, Noise: 1})
+
+	first := builder.BuildWith(7, nil)
+	second := builder.BuildWith(7, nil)
+
+	varied := false
+	for index := range first {
+		if first[index] != second[index] {
+			t.Fatalf("expected deterministic point at %d", index)
+		}
+		if first[index].Value < 9 || first[index].Value > 11 {
+			t.Fatalf("expected noise within bounds, got %v", first[index].Value)
+		}
+		if first[index].Value != 10 {
+			varied = true
+		}
+	}
+	if !varied {
+		t.Error("expected noise to vary values")
+	}
+}
+
+func TestTimeSeriesFactoryOverrides(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	builder := Builder(&TimeSeriesFactory{})
+
+	points := builder.Build(Override[TimeSeriesProperties](map[string]any{
+		"start":    start,
+		"interval": time.Minute,
+		"count":    3,
+		"base":     5.0,
+	}))
+
+	if len(points) != 3 {
+		t.Fatalf("expected 3 points, got %d", len(points))
+	}
+	if !points[2].Timestamp.Equal(start.Add(2 * time.Minute)) {
+		t.Errorf("unexpected timestamp %v", points[2].Timestamp)
+	}
+	if points[1].Value != 5 {
+		t.Errorf("expected flat value 5, got %v", points[1].Value)
+	}
+}
+
+func TestTimeSeriesFactoryDuplicate(t *testing.T) {
+	builder := Builder(&TimeSeriesFactory{Count: 4, Base: 1, Trend: 1})
+
+	original := builder.BuildWith(3, nil)
+	duplicated := builder.Duplicate(original, nil)
+
+	if len(duplicated) != len(original) || duplicated[3] != original[3] {
+		t.Fatalf("expected duplicated series, got %v", duplicated)
+	}
+}
@@ -0,0 +1,203 @@
+package factory
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTokenTTL       = time.Hour
+	defaultTokenAlgorithm = "HS256"
+	tokenSubjectLength    = 12
+)
+
+// DefaultTokenKey is the HMAC key TokenFactory signs with when Key is empty.
+var DefaultTokenKey = []byte("forge-test-secret")
+
+var tokenAlgorithms = map[string]func() hash.Hash{
+	"HS256": sha256.New,
+	"HS384": sha512.New384,
+	"HS512": sha512.New,
+}
+
+// TokenProperties carries the claims of a token built by TokenFactory. Additional claims set
+// through "claims" are merged into the payload; registered claims take precedence.
+type TokenProperties struct {
+	subject   string
+	issuer    string
+	audience  string
+	id        string
+	issuedAt  time.Time
+	expiresAt time.Time
+	claims    map[string]any
+}
+
+// TokenFactory generates signed JWTs for auth middleware tests. Tokens are signed with Key
+// (DefaultTokenKey when empty) using Algorithm, one of HS256 (the default), HS384 and HS512.
+// iat defaults to the current time, or to the anchor with WithTimeAnchor, and exp to iat plus
+// TTL (one hour when zero). Override "expiresAt" with a past time to build expired tokens.
+type TokenFactory struct {
+	Key       []byte
+	Algorithm string
+	Issuer    string
+	Audience  string
+	TTL       time.Duration
+
+	anchor time.Time
+}
+
+// Anchored returns a copy of the factory that issues tokens at anchor instead of the current time.
+func (f *TokenFactory) Anchored(anchor time.Time) Factory[string, TokenProperties] {
+	anchored := *f
+	anchored.anchor = anchor
+	return &anchored
+}
+
+// Instantiate encodes and signs the token.
+func (f *TokenFactory) Instantiate(properties TokenProperties) string {
+	header, _ := json.Marshal(map[string]string{"alg": f.algorithm(), "typ": "JWT"})
+	payload, err := json.Marshal(properties.payload())
+	if err != nil {
+		panic(fmt.Sprintf("token: %v", err))
+	}
+
+	unsigned := encodeTokenSegment(header) + "." + encodeTokenSegment(payload)
+	return unsigned + "." + encodeTokenSegment(f.sign(unsigned))
+}
+
+// Prepare generates the subject, token ID and timestamps missing from the overrides.
+func (f *TokenFactory) Prepare(overrides Partial[TokenProperties], seed int64) TokenProperties {
+	properties := TokenProperties{
+		issuer:   f.Issuer,
+		audience: f.Audience,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.subject == "" {
+		properties.subject = generateString(seed, tokenSubjectLength, tokenSubjectLength, Characters.Alphanumeric)
+	}
+	if properties.id == "" {
+		//nolint:gosec // G115: Two's complement reinterpretation keeps the seed bits intact
+		properties.id = fmt.Sprintf("%016x", uint64(seed))
+	}
+	if properties.issuedAt.IsZero() {
+		properties.issuedAt = f.anchor
+		if properties.issuedAt.IsZero() {
+			properties.issuedAt = time.Now()
+		}
+		properties.issuedAt = properties.issuedAt.Truncate(time.Second)
+	}
+	if properties.expiresAt.IsZero() {
+		ttl := f.TTL
+		if ttl <= 0 {
+			ttl = defaultTokenTTL
+		}
+		properties.expiresAt = properties.issuedAt.Add(ttl)
+	}
+
+	return properties
+}
+
+// Retrieve decodes the claims of token without verifying its signature. It panics when token is
+// not a well-formed JWT.
+func (f *TokenFactory) Retrieve(instance string) TokenProperties {
+	segments := strings.Split(instance, ".")
+	if len(segments) != 3 {
+		panic("token: malformed JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(segments[1])
+	if err != nil {
+		panic(fmt.Sprintf("token: %v", err))
+	}
+
+	claims := make(map[string]any)
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		panic(fmt.Sprintf("token: %v", err))
+	}
+
+	properties := TokenProperties{claims: make(map[string]any)}
+	for name, value := range claims {
+		switch name {
+		case "sub":
+			properties.subject, _ = value.(string)
+		case "iss":
+			properties.issuer, _ = value.(string)
+		case "aud":
+			properties.audience, _ = value.(string)
+		case "jti":
+			properties.id, _ = value.(string)
+		case "iat":
+			properties.issuedAt = tokenTime(value)
+		case "exp":
+			properties.expiresAt = tokenTime(value)
+		default:
+			properties.claims[name] = value
+		}
+	}
+
+	return properties
+}
+
+// payload returns the JWT claims set, omitting empty registered claims.
+func (p TokenProperties) payload() map[string]any {
+	payload := make(map[string]any, len(p.claims)+6)
+	for name, value := range p.claims {
+		payload[name] = value
+	}
+
+	for name, value := range map[string]string{"sub": p.subject, "iss": p.issuer, "aud": p.audience, "jti": p.id} {
+		if value != "" {
+			payload[name] = value
+		}
+	}
+	payload["iat"] = p.issuedAt.Unix()
+	payload["exp"] = p.expiresAt.Unix()
+
+	return payload
+}
+
+func (f *TokenFactory) algorithm() string {
+	if f.Algorithm == "" {
+		return defaultTokenAlgorithm
+	}
+	return f.Algorithm
+}
+
+func (f *TokenFactory) sign(unsigned string) []byte {
+	newHash, ok := tokenAlgorithms[f.algorithm()]
+	if !ok {
+		panic(fmt.Sprintf("token: unsupported algorithm %q", f.algorithm()))
+	}
+
+	key := f.Key
+	if len(key) == 0 {
+		key = DefaultTokenKey
+	}
+
+	mac := hmac.New(newHash, key)
+	mac.Write([]byte(unsigned))
+	return mac.Sum(nil)
+}
+
+func encodeTokenSegment(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func tokenTime(value any) time.Time {
+	seconds, ok := value.(float64)
+	if !ok {
+		return time.Time{}
+	}
+	return time.Unix(int64(seconds), 0)
+}
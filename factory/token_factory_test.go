@@ -0,0 +1,109 @@
+package factory
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func decodeTestToken(t *testing.T, token string, key []byte) (header, claims map[string]any) {
+	t.Helper()
+
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		t.Fatalf("expected three segments, got %q", token)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(segments[0] + "." + segments[1]))
+	if expected := base64.RawURLEncoding.EncodeToString(mac.Sum(nil)); segments[2] != expected {
+		t.Fatalf("invalid signature on %q", token)
+	}
+
+	for index, target := range []*map[string]any{&header, &claims} {
+		raw, err := base64.RawURLEncoding.DecodeString(segments[index])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(raw, target); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return header, claims
+}
+
+func TestTokenFactorySignsWithHS256(t *testing.T) {
+	key := []byte("middleware-key")
+	token := Builder(&TokenFactory{Key: key, Issuer: "forge"}).Build(nil)
+
+	header, claims := decodeTestToken(t, token, key)
+	if header["alg"] != "HS256" || header["typ"] != "JWT" {
+		t.Fatalf("unexpected header %v", header)
+	}
+	if claims["iss"] != "forge" || claims["sub"] == "" || claims["jti"] == "" {
+		t.Fatalf("unexpected claims %v", claims)
+	}
+	if claims["exp"].(float64)-claims["iat"].(float64) != defaultTokenTTL.Seconds() {
+		t.Fatalf("expected exp one TTL after iat, got %v", claims)
+	}
+}
+
+func TestTokenFactoryDefaultKey(t *testing.T) {
+	decodeTestToken(t, Builder(&TokenFactory{}).Build(nil), DefaultTokenKey)
+}
+
+func TestTokenFactoryOverridesClaims(t *testing.T) {
+	expired := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	token := Builder(&TokenFactory{}).Build(Override[TokenProperties](map[string]any{
+		"subject":   "user-1",
+		"expiresAt": expired,
+		"claims":    map[string]any{"role": "admin"},
+	}))
+
+	_, claims := decodeTestToken(t, token, DefaultTokenKey)
+	if claims["sub"] != "user-1" || claims["role"] != "admin" || int64(claims["exp"].(float64)) != expired.Unix() {
+		t.Fatalf("expected overridden claims, got %v", claims)
+	}
+}
+
+func TestTokenFactoryAnchoredIsDeterministic(t *testing.T) {
+	anchor := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	builder := Builder(&TokenFactory{TTL: time.Minute}, WithTimeAnchor(anchor))
+
+	first, second := builder.BuildWith(5, nil), builder.BuildWith(5, nil)
+	if first != second {
+		t.Fatalf("expected identical tokens, got %q and %q", first, second)
+	}
+
+	_, claims := decodeTestToken(t, first, DefaultTokenKey)
+	if int64(claims["iat"].(float64)) != anchor.Unix() || int64(claims["exp"].(float64)) != anchor.Add(time.Minute).Unix() {
+		t.Fatalf("expected anchored timestamps, got %v", claims)
+	}
+}
+
+func TestTokenFactoryRetrieve(t *testing.T) {
+	factory := &TokenFactory{Audience: "api"}
+	properties := factory.Prepare(nil, 9)
+	properties.claims = map[string]any{"scope": "read"}
+
+	retrieved := factory.Retrieve(factory.Instantiate(properties))
+	if retrieved.subject != properties.subject || retrieved.audience != "api" || retrieved.claims["scope"] != "read" ||
+		!retrieved.expiresAt.Equal(properties.expiresAt) {
+		t.Fatalf("expected round-tripped claims, got %+v", retrieved)
+	}
+}
+
+func TestTokenFactoryRejectsUnknownAlgorithm(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for unsupported algorithm")
+		}
+	}()
+
+	Builder(&TokenFactory{Algorithm: "RS256"}).Build(nil)
+}
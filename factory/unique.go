@@ -0,0 +1,63 @@
+package factory
+
+import (
+	"sync"
+
+	"github.com/lihs-ie/forge/internal/collections"
+)
+
+// UniqueScope tracks claimed business keys per namespace so several factories can share uniqueness.
+type UniqueScope struct {
+	mu      sync.Mutex
+	claimed map[string]*collections.Set[any]
+}
+
+// NewUniqueScope creates an empty UniqueScope.
+func NewUniqueScope() *UniqueScope {
+	return &UniqueScope{
+		claimed: make(map[string]*collections.Set[any]),
+	}
+}
+
+// Claim records value under namespace and reports whether it was not claimed before.
+func (s *UniqueScope) Claim(namespace string, value any) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, ok := s.claimed[namespace]
+	if !ok {
+		set = collections.NewSet[any](nil)
+		s.claimed[namespace] = set
+	}
+
+	if set.Has(value) {
+		return false
+	}
+
+	set.Set(value)
+	return true
+}
+
+// Reset forgets every claimed value.
+func (s *UniqueScope) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.claimed = make(map[string]*collections.Set[any])
+}
+
+// ResetOnCleanup resets the scope when the test finishes.
+func (s *UniqueScope) ResetOnCleanup(registrar CleanupRegistrar) {
+	registrar.Cleanup(s.Reset)
+}
+
+// Unique wraps inner so that the key extracted from each instance is unique within namespace of scope.
+// Duplicates are rebuilt with derived seeds like Filter; a nil key uses the whole instance.
+func Unique[T any, P any](scope *UniqueScope, namespace string, inner Factory[T, P], key func(T) any) *FilterFactory[T, P] {
+	return Filter(inner, func(instance T) bool {
+		if key == nil {
+			return scope.Claim(namespace, instance)
+		}
+		return scope.Claim(namespace, key(instance))
+	}, defaultFilterAttempts)
+}
@@ -0,0 +1,84 @@
+package factory
+
+import "testing"
+
+func TestUniqueScopeClaim(t *testing.T) {
+	scope := NewUniqueScope()
+
+	if !scope.Claim("email", "a@example.com") {
+		t.Fatal("expected first claim to succeed")
+	}
+	if scope.Claim("email", "a@example.com") {
+		t.Fatal("expected duplicate claim to fail")
+	}
+	if !scope.Claim("username", "a@example.com") {
+		t.Fatal("expected namespaces to be independent")
+	}
+
+	scope.Reset()
+
+	if !scope.Claim("email", "a@example.com") {
+		t.Fatal("expected claim to succeed after reset")
+	}
+}
+
+func TestUniqueAcrossFactories(t *testing.T) {
+	scope := NewUniqueScope()
+	short := &StringFactory{Min: 1, Max: 1, Characters: Characters.Numeric}
+
+	first := Builder(Unique(scope, "code", short, nil))
+	second := Builder(Unique(scope, "code", short, nil))
+
+	seen := map[string]bool{}
+	for index := range 5 {
+		for _, value := range []string{first.BuildWith(int64(index), nil), second.BuildWith(int64(index), nil)} {
+			if seen[value] {
+				t.Fatalf("duplicate value %q", value)
+			}
+			seen[value] = true
+		}
+	}
+}
+
+func TestUniqueUsesKeySelector(t *testing.T) {
+	scope := NewUniqueScope()
+	builder := Builder(Unique(scope, "value", &stubFactory{}, func(instance stubInstance) any {
+		return instance.Value
+	}))
+
+	first := builder.BuildWith(1, nil)
+	second := builder.BuildWith(1, nil)
+
+	if first.Value == second.Value {
+		t.Fatalf("expected distinct keys, got %s twice", first.Value)
+	}
+}
+
+func TestUniquePanicsWhenExhausted(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic when no unique value remains")
+		}
+	}()
+
+	scope := NewUniqueScope()
+	builder := Builder(Unique(scope, "fixed", &stubFactory{}, func(stubInstance) any {
+		return "constant"
+	}))
+
+	builder.Build(nil)
+	builder.Build(nil)
+}
+
+func TestUniqueScopeResetOnCleanup(t *testing.T) {
+	scope := NewUniqueScope()
+
+	t.Run("scope", func(t *testing.T) {
+		scope.ResetOnCleanup(t)
+		scope.Claim("email", "a@example.com")
+	})
+
+	if !scope.Claim("email", "a@example.com") {
+		t.Fatal("expected scope to reset after cleanup")
+	}
+}
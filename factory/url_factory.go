@@ -0,0 +1,169 @@
+package factory
+
+import (
+	"net/url"
+	"strings"
+)
+
+const (
+	urlSchemeSalt     = 0x0C5E_3E11
+	urlHostSalt       = 0x0A05_7ED1
+	urlPathSalt       = 0x0FA7_8D11
+	urlQuerySalt      = 0x0DE7_1E55
+	defaultURLMaxPath = 3
+	defaultURLMaxKeys = 2
+)
+
+var urlSegmentCharacters = CharacterSet([]rune(emailLocalFirstLetters))
+
+// URLProperties carries the parts of the URL generated by URLFactory.
+type URLProperties struct {
+	scheme string
+	host   string
+	path   string
+	query  url.Values
+}
+
+// URLFactory generates valid absolute URLs such as "https://example.org/abc/defg?key=v4lue".
+// The scheme comes from Schemes ("https" when empty) and the host from Hosts (the RFC 2606 example
+// domains when empty). The path has MinDepth to MaxDepth lowercase segments (0 to 3 when both are
+// zero) and the query MinQuery to MaxQuery parameters (0 to 2 when both are zero).
+type URLFactory struct {
+	Schemes  []string
+	Hosts    []string
+	MinDepth int
+	MaxDepth int
+	MinQuery int
+	MaxQuery int
+}
+
+// Parsed returns a factory producing *url.URL values from the same configuration.
+func (f *URLFactory) Parsed() *ParsedURLFactory {
+	return &ParsedURLFactory{urls: f}
+}
+
+// Instantiate returns the URL as a string.
+func (f *URLFactory) Instantiate(properties URLProperties) string {
+	return properties.url().String()
+}
+
+// Prepare generates every part of the URL that overrides leave empty; a nil query is generated,
+// while an empty one is kept.
+func (f *URLFactory) Prepare(overrides Partial[URLProperties], seed int64) URLProperties {
+	properties := URLProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.scheme == "" {
+		properties.scheme = pickString(f.Schemes, []string{"https"}, seed^urlSchemeSalt)
+	}
+	if properties.host == "" {
+		properties.host = pickString(f.Hosts, exampleDomains, seed^urlHostSalt)
+	}
+	if properties.path == "" {
+		properties.path = f.generatePath(seed ^ urlPathSalt)
+	}
+	if properties.query == nil {
+		properties.query = f.generateQuery(seed ^ urlQuerySalt)
+	}
+
+	return properties
+}
+
+// Retrieve parses a URL string back into URLProperties. Unparsable strings yield empty properties.
+func (f *URLFactory) Retrieve(instance string) URLProperties {
+	parsed, err := url.Parse(instance)
+	if err != nil {
+		return URLProperties{}
+	}
+	return urlPropertiesOf(parsed)
+}
+
+func (f *URLFactory) generatePath(seed int64) string {
+	minDepth, maxDepth := f.MinDepth, f.MaxDepth
+	if minDepth == 0 && maxDepth == 0 {
+		maxDepth = defaultURLMaxPath
+	}
+	if maxDepth < minDepth {
+		maxDepth = minDepth
+	}
+
+	depth := IntAt(seed, int64(minDepth), int64(maxDepth))
+	if depth == 0 {
+		return "/"
+	}
+
+	segments := make([]string, depth)
+	for index := range segments {
+		segments[index] = generateString(seed+int64(index)+1, 3, 10, urlSegmentCharacters)
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func (f *URLFactory) generateQuery(seed int64) url.Values {
+	minKeys, maxKeys := f.MinQuery, f.MaxQuery
+	if minKeys == 0 && maxKeys == 0 {
+		maxKeys = defaultURLMaxKeys
+	}
+	if maxKeys < minKeys {
+		maxKeys = minKeys
+	}
+
+	count := int(IntAt(seed, int64(minKeys), int64(maxKeys)))
+	query := make(url.Values, count)
+	for index := int64(0); len(query) < count; index += 2 {
+		key := generateString(seed+index, 3, 8, urlSegmentCharacters)
+		query.Set(key, generateString(seed+index+1, 1, 12, Characters.Alphanumeric))
+	}
+
+	return query
+}
+
+func (p URLProperties) url() *url.URL {
+	return &url.URL{
+		Scheme:   p.scheme,
+		Host:     p.host,
+		Path:     p.path,
+		RawQuery: p.query.Encode(),
+	}
+}
+
+func urlPropertiesOf(parsed *url.URL) URLProperties {
+	return URLProperties{
+		scheme: parsed.Scheme,
+		host:   parsed.Host,
+		path:   parsed.Path,
+		query:  parsed.Query(),
+	}
+}
+
+// pickString returns the value of pool chosen by seed, or of fallback when pool is empty.
+func pickString(pool, fallback []string, seed int64) string {
+	if len(pool) == 0 {
+		pool = fallback
+	}
+	return pool[IntAt(seed, 0, int64(len(pool)-1))]
+}
+
+// ParsedURLFactory produces the URLs of a URLFactory as *url.URL; see URLFactory.Parsed.
+type ParsedURLFactory struct {
+	urls *URLFactory
+}
+
+// Instantiate returns a freshly allocated URL.
+func (f *ParsedURLFactory) Instantiate(properties URLProperties) *url.URL {
+	return properties.url()
+}
+
+// Prepare delegates to the underlying URLFactory.
+func (f *ParsedURLFactory) Prepare(overrides Partial[URLProperties], seed int64) URLProperties {
+	return f.urls.Prepare(overrides, seed)
+}
+
+// Retrieve converts a URL back into URLProperties.
+func (f *ParsedURLFactory) Retrieve(instance *url.URL) URLProperties {
+	return urlPropertiesOf(instance)
+}
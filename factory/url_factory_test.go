@@ -0,0 +1,74 @@
+package factory
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestURLFactoryProducesValidURLs(t *testing.T) {
+	for _, value := range Builder(&URLFactory{}).BuildList(200, nil) {
+		parsed, err := url.Parse(value)
+		if err != nil || !parsed.IsAbs() {
+			t.Fatalf("expected an absolute URL, got %q: %v", value, err)
+		}
+		if parsed.Scheme != "https" || !slices.Contains(exampleDomains, parsed.Host) {
+			t.Fatalf("unexpected default scheme or host in %q", value)
+		}
+
+		depth := len(strings.Split(strings.Trim(parsed.Path, "/"), "/"))
+		if parsed.Path == "/" {
+			depth = 0
+		}
+		if depth > defaultURLMaxPath || len(parsed.Query()) > defaultURLMaxKeys {
+			t.Fatalf("path or query outside defaults in %q", value)
+		}
+	}
+}
+
+func TestURLFactoryConfiguration(t *testing.T) {
+	factory := &URLFactory{
+		Schemes:  []string{"http", "ftp"},
+		Hosts:    []string{"api.test:8080"},
+		MinDepth: 2,
+		MaxDepth: 2,
+		MinQuery: 3,
+		MaxQuery: 3,
+	}
+
+	for _, parsed := range Builder(factory.Parsed()).BuildList(50, nil) {
+		if parsed.Scheme != "http" && parsed.Scheme != "ftp" {
+			t.Fatalf("unexpected scheme %q", parsed.Scheme)
+		}
+		if parsed.Host != "api.test:8080" || strings.Count(parsed.Path, "/") != 2 || len(parsed.Query()) != 3 {
+			t.Fatalf("unexpected URL %s", parsed)
+		}
+	}
+}
+
+func TestURLFactoryStringAndParsedAgree(t *testing.T) {
+	factory := &URLFactory{}
+
+	for seed := range int64(20) {
+		if Builder(factory).BuildWith(seed, nil) != Builder(factory.Parsed()).BuildWith(seed, nil).String() {
+			t.Fatalf("expected both forms to agree for seed %d", seed)
+		}
+	}
+}
+
+func TestURLFactoryOverrides(t *testing.T) {
+	value := Builder(&URLFactory{}).Build(Override[URLProperties](map[string]any{
+		"path":  "/fixed",
+		"query": url.Values{},
+	}))
+
+	if !strings.HasSuffix(value, "/fixed") {
+		t.Fatalf("expected overridden path without query, got %q", value)
+	}
+
+	properties := (&URLFactory{}).Retrieve("http://host.test/a/b?x=1")
+	if properties.scheme != "http" || properties.host != "host.test" || properties.path != "/a/b" || properties.query.Get("x") != "1" {
+		t.Fatalf("unexpected retrieved properties %+v", properties)
+	}
+}
@@ -0,0 +1,184 @@
+package factory
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// UserAgentFamily identifies the browser or crawler a User-Agent string belongs to.
+type UserAgentFamily string
+
+// User-Agent families supported by UserAgentFactory.
+const (
+	UserAgentChrome       UserAgentFamily = "chrome"
+	UserAgentFirefox      UserAgentFamily = "firefox"
+	UserAgentSafari       UserAgentFamily = "safari"
+	UserAgentEdge         UserAgentFamily = "edge"
+	UserAgentMobileSafari UserAgentFamily = "mobile-safari"
+	UserAgentMobileChrome UserAgentFamily = "mobile-chrome"
+	UserAgentGooglebot    UserAgentFamily = "googlebot"
+	UserAgentBingbot      UserAgentFamily = "bingbot"
+)
+
+const (
+	userAgentFamilySalt   = 0x0A6E_F4A1
+	userAgentTemplateSalt = 0x0A6E_7E47
+)
+
+// DefaultUserAgentWeights approximates the traffic share of each family.
+var DefaultUserAgentWeights = map[UserAgentFamily]float64{
+	UserAgentChrome:       60,
+	UserAgentSafari:       12,
+	UserAgentMobileSafari: 8,
+	UserAgentMobileChrome: 4,
+	UserAgentEdge:         6,
+	UserAgentFirefox:      6,
+	UserAgentGooglebot:    2,
+	UserAgentBingbot:      2,
+}
+
+// userAgentTemplates lists the User-Agent templates of each family. {os} is a desktop platform,
+// {mac} a macOS version, {android} an Android version and {pixel} a device model digit; {major}
+// is within the family's version range, and {point}, {minor} and {build} complete the version.
+var userAgentTemplates = map[UserAgentFamily][]string{
+	UserAgentChrome: {
+		"Mozilla/5.0 ({os}) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/{major}.0.{build}.{minor} Safari/537.36",
+	},
+	UserAgentFirefox: {
+		"Mozilla/5.0 ({os}; rv:{major}.0) Gecko/20100101 Firefox/{major}.0",
+	},
+	UserAgentSafari: {
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X {mac}) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/{major}.{point} Safari/605.1.15",
+	},
+	UserAgentEdge: {
+		"Mozilla/5.0 ({os}) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/{major}.0.{build}.{minor} Safari/537.36 Edg/{major}.0.{build}.{minor}",
+	},
+	UserAgentMobileSafari: {
+		"Mozilla/5.0 (iPhone; CPU iPhone OS {major}_{point} like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/{major}.{point} Mobile/15E148 Safari/604.1",
+		"Mozilla/5.0 (iPad; CPU OS {major}_{point} like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/{major}.{point} Mobile/15E148 Safari/604.1",
+	},
+	UserAgentMobileChrome: {
+		"Mozilla/5.0 (Linux; Android {android}; Pixel {pixel}) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/{major}.0.{build}.{minor} Mobile Safari/537.36",
+		"Mozilla/5.0 (Linux; Android {android}; SM-S9{pixel}1B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/{major}.0.{build}.{minor} Mobile Safari/537.36",
+	},
+	UserAgentGooglebot: {
+		"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+		"Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/{major}.0.{build}.{minor} Mobile Safari/537.36 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+	},
+	UserAgentBingbot: {
+		"Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)",
+	},
+}
+
+// userAgentVersions bounds the major version of each family.
+var userAgentVersions = map[UserAgentFamily][2]int64{
+	UserAgentChrome:       {110, 130},
+	UserAgentFirefox:      {110, 130},
+	UserAgentSafari:       {15, 18},
+	UserAgentEdge:         {110, 130},
+	UserAgentMobileSafari: {15, 18},
+	UserAgentMobileChrome: {110, 130},
+	UserAgentGooglebot:    {110, 130},
+	UserAgentBingbot:      {110, 130},
+}
+
+var userAgentPlatforms = []string{
+	"Windows NT 10.0; Win64; x64",
+	"Macintosh; Intel Mac OS X 10_15_7",
+	"X11; Linux x86_64",
+}
+
+// UserAgentProperties carries the family and the generated string for UserAgentFactory.
+type UserAgentProperties struct {
+	family UserAgentFamily
+	value  string
+}
+
+// UserAgentFactory generates realistic browser and crawler User-Agent strings for HTTP middleware
+// tests, picking a family in proportion to Weights (DefaultUserAgentWeights when nil) and filling
+// one of its templates with seeded platforms and versions. A zero weight disables a family.
+type UserAgentFactory struct {
+	Weights map[UserAgentFamily]float64
+}
+
+// Instantiate returns the generated string.
+func (f *UserAgentFactory) Instantiate(properties UserAgentProperties) string {
+	return properties.value
+}
+
+// Prepare picks a family unless overridden and fills one of its templates; an overridden value
+// is kept. It panics on an unknown family or when no family has a positive weight.
+func (f *UserAgentFactory) Prepare(overrides Partial[UserAgentProperties], seed int64) UserAgentProperties {
+	properties := UserAgentProperties{}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.value != "" {
+		return properties
+	}
+
+	if properties.family == "" {
+		properties.family = f.pickFamily(seed ^ userAgentFamilySalt)
+	}
+	templates, ok := userAgentTemplates[properties.family]
+	if !ok {
+		panic(fmt.Sprintf("user agent: unknown family %q", properties.family))
+	}
+
+	template := templates[IntAt(seed^userAgentTemplateSalt, 0, int64(len(templates)-1))]
+	properties.value = fillUserAgentTemplate(template, userAgentVersions[properties.family], seed)
+
+	return properties
+}
+
+// Retrieve wraps an existing string into UserAgentProperties.
+func (f *UserAgentFactory) Retrieve(instance string) UserAgentProperties {
+	return UserAgentProperties{
+		value: instance,
+	}
+}
+
+func (f *UserAgentFactory) pickFamily(seed int64) UserAgentFamily {
+	weights := f.Weights
+	if weights == nil {
+		weights = DefaultUserAgentWeights
+	}
+
+	families := make([]UserAgentFamily, 0, len(weights))
+	for family, weight := range weights {
+		if weight < 0 {
+			panic(fmt.Sprintf("user agent: negative weight %v for %q", weight, family))
+		}
+		if weight > 0 {
+			families = append(families, family)
+		}
+	}
+	if len(families) == 0 {
+		panic("user agent: no family has a positive weight")
+	}
+	slices.Sort(families)
+
+	values := make([]float64, len(families))
+	for index, family := range families {
+		values[index] = weights[family]
+	}
+	return families[pickWeighted(values, seed)]
+}
+
+func fillUserAgentTemplate(template string, versions [2]int64, seed int64) string {
+	major := IntAt(seed, versions[0], versions[1])
+
+	return strings.NewReplacer(
+		"{os}", userAgentPlatforms[IntAt(seed+1, 0, int64(len(userAgentPlatforms)-1))],
+		"{mac}", fmt.Sprintf("10_15_%d", IntAt(seed+2, 1, 7)),
+		"{point}", fmt.Sprint(IntAt(seed+3, 0, 6)),
+		"{android}", fmt.Sprint(IntAt(seed+5, 10, 14)),
+		"{pixel}", fmt.Sprint(IntAt(seed+6, 5, 9)),
+		"{major}", fmt.Sprint(major),
+		"{minor}", fmt.Sprint(IntAt(seed+7, 0, 200)),
+		"{build}", fmt.Sprint(IntAt(seed+8, 5000, 6999)),
+	).Replace(template)
+}
@@ -0,0 +1,58 @@
+package factory
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestUserAgentFactoryFamilies(t *testing.T) {
+	patterns := map[UserAgentFamily]*regexp.Regexp{
+		UserAgentChrome:       regexp.MustCompile(`^Mozilla/5\.0 \(.+\) AppleWebKit/537\.36 \(KHTML, like Gecko\) Chrome/1[1-3][0-9]\.0\.[0-9]{4}\.[0-9]+ Safari/537\.36$`),
+		UserAgentFirefox:      regexp.MustCompile(`^Mozilla/5\.0 \(.+; rv:([0-9]+)\.0\) Gecko/20100101 Firefox/([0-9]+)\.0$`),
+		UserAgentSafari:       regexp.MustCompile(`^Mozilla/5\.0 \(Macintosh; Intel Mac OS X 10_15_[1-7]\) .+ Version/1[5-8]\.[0-6] Safari/605\.1\.15$`),
+		UserAgentEdge:         regexp.MustCompile(` Edg/1[1-3][0-9]\.0\.[0-9]{4}\.[0-9]+$`),
+		UserAgentMobileSafari: regexp.MustCompile(`^Mozilla/5\.0 \((iPhone; CPU iPhone|iPad; CPU) OS 1[5-8]_[0-6] like Mac OS X\) .+ Mobile/15E148 Safari/604\.1$`),
+		UserAgentMobileChrome: regexp.MustCompile(`^Mozilla/5\.0 \(Linux; Android 1[0-4]; .+\) .+ Mobile Safari/537\.36$`),
+		UserAgentGooglebot:    regexp.MustCompile(`Googlebot/2\.1`),
+		UserAgentBingbot:      regexp.MustCompile(`bingbot/2\.0`),
+	}
+
+	for family, pattern := range patterns {
+		builder := Builder(&UserAgentFactory{Weights: map[UserAgentFamily]float64{family: 1}})
+		for _, value := range builder.BuildList(50, nil) {
+			if !pattern.MatchString(value) || strings.Contains(value, "{") {
+				t.Fatalf("unexpected %s User-Agent %q", family, value)
+			}
+		}
+	}
+}
+
+func TestUserAgentFactoryWeights(t *testing.T) {
+	builder := Builder(&UserAgentFactory{Weights: map[UserAgentFamily]float64{
+		UserAgentFirefox:   3,
+		UserAgentGooglebot: 1,
+		UserAgentChrome:    0,
+	}})
+
+	firefox := 0
+	for _, value := range builder.BuildListWith(2000, 1, nil) {
+		switch {
+		case strings.Contains(value, "Firefox/"):
+			firefox++
+		case !strings.Contains(value, "Googlebot"):
+			t.Fatalf("unexpected User-Agent %q", value)
+		}
+	}
+
+	if share := float64(firefox) / 2000; share < 0.72 || share > 0.78 {
+		t.Fatalf("expected about 75%% Firefox, got %.3f", share)
+	}
+}
+
+func TestUserAgentFactoryOverrideFamily(t *testing.T) {
+	value := Builder(&UserAgentFactory{}).Build(Override[UserAgentProperties](map[string]any{"family": UserAgentBingbot}))
+	if !strings.Contains(value, "bingbot") {
+		t.Fatalf("expected a bingbot User-Agent, got %q", value)
+	}
+}
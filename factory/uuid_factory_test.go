@@ -0,0 +1,56 @@
+package factory
+
+import (
+	"regexp"
+	"testing"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-([45])[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestUUIDFactoryGeneratesVersion4(t *testing.T) {
+	builder := Builder(&UUIDFactory{})
+
+	seen := make(map[string]bool)
+	for _, value := range builder.BuildList(200, nil) {
+		if match := uuidPattern.FindStringSubmatch(value); match == nil || match[1] != "4" {
+			t.Fatalf("expected a version 4 UUID, got %q", value)
+		}
+		seen[value] = true
+	}
+
+	if len(seen) != 200 {
+		t.Fatalf("expected distinct UUIDs, got %d", len(seen))
+	}
+}
+
+func TestUUIDFactoryIsDeterministic(t *testing.T) {
+	factory := &UUIDFactory{}
+
+	if Builder(factory).BuildWith(3, nil) != factory.Generate(3) || factory.Generate(3) == factory.Generate(4) {
+		t.Fatal("expected one UUID per seed")
+	}
+}
+
+func TestUUIDFactoryVersion5(t *testing.T) {
+	builder := Builder(&UUIDFactory{Version: 5})
+
+	// Reference value from Python's uuid.uuid5(uuid.NAMESPACE_DNS, "python.org").
+	value := builder.Build(Override[UUIDProperties](map[string]any{"name": "python.org"}))
+	if value != "886313e1-3b8a-5372-9b90-0c9aee199e5d" {
+		t.Fatalf("unexpected version 5 UUID %q", value)
+	}
+
+	if match := uuidPattern.FindStringSubmatch(builder.BuildWith(8, nil)); match == nil || match[1] != "5" {
+		t.Fatal("expected a version 5 UUID derived from the seed")
+	}
+}
+
+func TestUUIDFactoryRejectsMalformedNamespace(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for malformed namespace")
+		}
+	}()
+
+	Builder(&UUIDFactory{Version: 5, Namespace: "not-a-uuid"}).Build(nil)
+}
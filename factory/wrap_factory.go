@@ -0,0 +1,75 @@
+package factory
+
+const wrapSalt = 0x0A1E_7A7E
+
+// WrapProperties carries the inner value and whether the alternative variant is built for
+// WrapFactory.
+type WrapProperties[T any] struct {
+	value       T
+	alternative bool
+	seed        int64
+}
+
+// WrapFactory builds a generic domain type W, such as Optional[T], Result[T] or Page[T], around
+// the values of an inner factory, so each instantiation needs no bespoke factory. The builder
+// infers T, P and W from the constructor arguments.
+type WrapFactory[W any, T any, P any] struct {
+	inner       Factory[T, P]
+	wrap        func(T) W
+	unwrap      func(W) (T, bool)
+	probability float64
+	build       func(seed int64) W
+}
+
+// Wrap creates a WrapFactory that passes every inner value to wrap. unwrap recovers the inner
+// value for Retrieve and reports false for instances of the alternative variant; see
+// WithAlternative.
+func Wrap[W any, T any, P any](inner Factory[T, P], wrap func(T) W, unwrap func(W) (T, bool)) *WrapFactory[W, T, P] {
+	return &WrapFactory[W, T, P]{
+		inner:  inner,
+		wrap:   wrap,
+		unwrap: unwrap,
+	}
+}
+
+// WithAlternative returns a copy of the factory that builds the alternative variant of W, such as
+// None or an error Result, for a seed-determined fraction probability of builds.
+func (f *WrapFactory[W, T, P]) WithAlternative(probability float64, build func(seed int64) W) *WrapFactory[W, T, P] {
+	alternative := *f
+	alternative.probability = probability
+	alternative.build = build
+	return &alternative
+}
+
+// Instantiate wraps the inner value, or builds the alternative variant.
+func (f *WrapFactory[W, T, P]) Instantiate(properties WrapProperties[T]) W {
+	if properties.alternative && f.build != nil {
+		return f.build(properties.seed)
+	}
+	return f.wrap(properties.value)
+}
+
+// Prepare builds the inner value and decides the variant before applying overrides, so "value"
+// and "alternative" can both be overridden.
+func (f *WrapFactory[W, T, P]) Prepare(overrides Partial[WrapProperties[T]], seed int64) WrapProperties[T] {
+	properties := WrapProperties[T]{
+		value:       create(f.inner, Overrider[P]{}, seed),
+		alternative: f.build != nil && BoolAt(seed^wrapSalt, f.probability),
+		seed:        seed,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	return properties
+}
+
+// Retrieve converts an instance back into WrapProperties through unwrap.
+func (f *WrapFactory[W, T, P]) Retrieve(instance W) WrapProperties[T] {
+	value, ok := f.unwrap(instance)
+	return WrapProperties[T]{
+		value:       value,
+		alternative: !ok,
+	}
+}
@@ -0,0 +1,94 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type optional[T any] struct {
+	value T
+	ok    bool
+}
+
+type result[T any] struct {
+	value T
+	err   error
+}
+
+func optionalOf[T any, P any](inner Factory[T, P]) *WrapFactory[optional[T], T, P] {
+	return Wrap(inner,
+		func(value T) optional[T] { return optional[T]{value: value, ok: true} },
+		func(instance optional[T]) (T, bool) { return instance.value, instance.ok },
+	).WithAlternative(0.3, func(int64) optional[T] { return optional[T]{} })
+}
+
+func TestWrapFactoryWrapsInnerValues(t *testing.T) {
+	inner := &StringFactory{Min: 4, Max: 4}
+	builder := Builder(Wrap(inner,
+		func(value string) result[string] { return result[string]{value: value} },
+		func(instance result[string]) (string, bool) { return instance.value, instance.err == nil },
+	))
+
+	for seed := range int64(20) {
+		wrapped := builder.BuildWith(seed, nil)
+		if wrapped.err != nil || wrapped.value != Builder(inner).BuildWith(seed, nil) {
+			t.Fatalf("expected the inner value for seed %d, got %+v", seed, wrapped)
+		}
+	}
+}
+
+func TestWrapFactoryAlternative(t *testing.T) {
+	values := Builder(optionalOf(&StringFactory{})).BuildListWith(1000, 1, nil)
+
+	empty := 0
+	for _, value := range values {
+		if !value.ok {
+			empty++
+		}
+	}
+	if empty < 250 || empty > 350 {
+		t.Fatalf("expected about 30%% empty values, got %d", empty)
+	}
+}
+
+func TestWrapFactoryAlternativeReceivesSeed(t *testing.T) {
+	failure := errors.New("failure")
+	builder := Builder(Wrap[result[int]](&IntFactory{},
+		func(value int) result[int] { return result[int]{value: value} },
+		func(instance result[int]) (int, bool) { return instance.value, instance.err == nil },
+	).WithAlternative(1, func(seed int64) result[int] { return result[int]{err: fmt.Errorf("%w %d", failure, seed)} }))
+
+	if got := builder.BuildWith(7, nil); got.err == nil || got.err.Error() != "failure 7" {
+		t.Fatalf("expected the alternative built with the seed, got %+v", got)
+	}
+}
+
+func TestWrapFactoryOverrides(t *testing.T) {
+	builder := Builder(optionalOf(&StringFactory{}))
+
+	if got := builder.Build(Override[WrapProperties[string]](map[string]any{"value": "fixed", "alternative": false})); got != (optional[string]{value: "fixed", ok: true}) {
+		t.Fatalf("expected the overridden value, got %+v", got)
+	}
+	if got := builder.Build(Override[WrapProperties[string]](map[string]any{"alternative": true})); got.ok {
+		t.Fatalf("expected the alternative variant, got %+v", got)
+	}
+}
+
+func TestWrapFactoryComposesSlices(t *testing.T) {
+	type page[T any] struct{ items []T }
+
+	pages := Wrap(NewSliceFactory(&IntFactory{}, SliceLength(3, 3)),
+		func(items []int) page[int] { return page[int]{items: items} },
+		func(instance page[int]) ([]int, bool) { return instance.items, true },
+	)
+
+	if got := Builder(pages).Build(nil); len(got.items) != 3 {
+		t.Fatalf("expected a page of three items, got %+v", got)
+	}
+
+	retrieved := pages.Retrieve(page[int]{items: []int{1, 2}})
+	if retrieved.alternative || len(retrieved.value) != 2 {
+		t.Fatalf("unexpected retrieved properties %+v", retrieved)
+	}
+}
@@ -0,0 +1,192 @@
+package faker
+
+import (
+	"fmt"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+const (
+	addressCitySalt   = 0x0C17_7A11
+	addressNumberSalt = 0x0B0A_5E22
+	addressPostalSalt = 0x0905_7A1C
+)
+
+var bundledCities = map[factory.Locale][]string{
+	factory.LocaleEnglish: {
+		"Springfield", "Riverside", "Franklin", "Greenville", "Bristol", "Clinton", "Fairview",
+		"Salem", "Madison", "Georgetown", "Arlington", "Ashland",
+	},
+	factory.LocaleJapanese: {
+		"東京都新宿区", "東京都渋谷区", "大阪市北区", "横浜市中区", "名古屋市中区", "札幌市中央区",
+		"福岡市博多区", "京都市下京区", "神戸市中央区", "仙台市青葉区",
+	},
+	factory.LocaleGerman: {
+		"Berlin", "Hamburg", "München", "Köln", "Frankfurt am Main", "Stuttgart", "Leipzig",
+		"Dresden", "Bremen", "Hannover",
+	},
+}
+
+var bundledStreets = map[factory.Locale][]string{
+	factory.LocaleEnglish: {
+		"Main Street", "Oak Avenue", "Maple Drive", "Cedar Lane", "Pine Street", "Elm Street",
+		"Park Place", "Lake View Road", "Hillcrest Drive", "Church Street",
+	},
+	factory.LocaleJapanese: {
+		"西新宿", "神南", "梅田", "山下町", "栄", "大通西", "博多駅前", "四条通", "三宮町", "一番町",
+	},
+	factory.LocaleGerman: {
+		"Hauptstraße", "Schulstraße", "Gartenstraße", "Bahnhofstraße", "Bergstraße", "Lindenstraße",
+		"Kirchstraße", "Waldstraße", "Goethestraße", "Schillerstraße",
+	},
+}
+
+// addressFormat describes how a country writes addresses. In postal, '#' is a digit.
+type addressFormat struct {
+	country string
+	postal  string
+	street  func(name string, seed int64) string
+	line    func(address Address) string
+}
+
+var addressFormats = map[factory.Locale]addressFormat{
+	factory.LocaleEnglish: {
+		country: "US",
+		postal:  "#####",
+		street: func(name string, seed int64) string {
+			return fmt.Sprintf("%d %s", factory.IntAt(seed, 1, 9999), name)
+		},
+		line: func(address Address) string {
+			return fmt.Sprintf("%s, %s %s, %s", address.Street, address.City, address.PostalCode, address.Country)
+		},
+	},
+	factory.LocaleJapanese: {
+		country: "JP",
+		postal:  "###-####",
+		street: func(name string, seed int64) string {
+			return fmt.Sprintf("%s%d-%d-%d", name, factory.IntAt(seed, 1, 9), factory.IntAt(seed+1, 1, 30), factory.IntAt(seed+2, 1, 20))
+		},
+		line: func(address Address) string {
+			return fmt.Sprintf("〒%s %s%s", address.PostalCode, address.City, address.Street)
+		},
+	},
+	factory.LocaleGerman: {
+		country: "DE",
+		postal:  "#####",
+		street: func(name string, seed int64) string {
+			return fmt.Sprintf("%s %d", name, factory.IntAt(seed, 1, 199))
+		},
+		line: func(address Address) string {
+			return fmt.Sprintf("%s, %s %s, %s", address.Street, address.PostalCode, address.City, address.Country)
+		},
+	},
+}
+
+// Address is a structured postal address. Country is an ISO 3166-1 alpha-2 code.
+type Address struct {
+	Street     string
+	City       string
+	PostalCode string
+	Country    string
+}
+
+// Line formats the address on a single line the way its country writes it, e.g.
+// "742 Main Street, Springfield 12345, US" or "〒150-0041 東京都渋谷区神南1-2-3".
+func (a Address) Line() string {
+	for _, format := range addressFormats {
+		if format.country == a.Country {
+			return format.line(a)
+		}
+	}
+	return fmt.Sprintf("%s, %s %s, %s", a.Street, a.PostalCode, a.City, a.Country)
+}
+
+// AddressProperties carries the parts of the address for AddressFactory.
+type AddressProperties struct {
+	street     string
+	city       string
+	postalCode string
+	country    string
+	locale     factory.Locale
+}
+
+// AddressFactory generates addresses in the builder's locale with that country's street and postal
+// code formats: US for English, JP for Japanese and DE for German. Other locales use English.
+type AddressFactory struct {
+	locale factory.Locale
+}
+
+// Localized returns a copy of the factory bound to locale.
+func (f *AddressFactory) Localized(locale factory.Locale) factory.Factory[Address, AddressProperties] {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Instantiate assembles the address.
+func (f *AddressFactory) Instantiate(properties AddressProperties) Address {
+	return Address{
+		Street:     properties.street,
+		City:       properties.city,
+		PostalCode: properties.postalCode,
+		Country:    properties.country,
+	}
+}
+
+// Prepare generates every part that overrides leave empty.
+func (f *AddressFactory) Prepare(overrides factory.Partial[AddressProperties], seed int64) AddressProperties {
+	properties := AddressProperties{
+		locale: f.locale,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.locale == "" {
+		properties.locale = factory.CurrentLocale()
+	}
+	format, ok := addressFormats[properties.locale]
+	if !ok {
+		format = addressFormats[factory.LocaleEnglish]
+	}
+
+	if properties.street == "" {
+		name := pick(properties.locale, factory.DatasetStreets, bundledStreets, seed)
+		properties.street = format.street(name, seed^addressNumberSalt)
+	}
+	if properties.city == "" {
+		properties.city = pick(properties.locale, factory.DatasetCities, bundledCities, seed^addressCitySalt)
+	}
+	if properties.postalCode == "" {
+		properties.postalCode = postalCode(format.postal, seed^addressPostalSalt)
+	}
+	if properties.country == "" {
+		properties.country = format.country
+	}
+
+	return properties
+}
+
+// Retrieve converts an address back into AddressProperties.
+func (f *AddressFactory) Retrieve(instance Address) AddressProperties {
+	return AddressProperties{
+		street:     instance.Street,
+		city:       instance.City,
+		postalCode: instance.PostalCode,
+		country:    instance.Country,
+		locale:     f.locale,
+	}
+}
+
+// postalCode fills the '#' placeholders of pattern with digits drawn from seed.
+func postalCode(pattern string, seed int64) string {
+	code := []byte(pattern)
+	for index, character := range code {
+		if character == '#' {
+			//nolint:gosec // G115: The digit is within 0-9
+			code[index] = byte('0' + factory.IntAt(seed+int64(index), 0, 9))
+		}
+	}
+	return string(code)
+}
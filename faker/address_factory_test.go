@@ -0,0 +1,60 @@
+package faker
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+func TestAddressFactoryLocaleFormats(t *testing.T) {
+	cases := []struct {
+		locale  factory.Locale
+		country string
+		postal  *regexp.Regexp
+		line    *regexp.Regexp
+	}{
+		{factory.LocaleEnglish, "US", regexp.MustCompile(`^[0-9]{5}$`), regexp.MustCompile(`^[0-9]+ .+, .+ [0-9]{5}, US$`)},
+		{factory.LocaleJapanese, "JP", regexp.MustCompile(`^[0-9]{3}-[0-9]{4}$`), regexp.MustCompile(`^〒[0-9]{3}-[0-9]{4} .+[0-9]+-[0-9]+-[0-9]+$`)},
+		{factory.LocaleGerman, "DE", regexp.MustCompile(`^[0-9]{5}$`), regexp.MustCompile(`^.+ [0-9]+, [0-9]{5} .+, DE$`)},
+	}
+
+	for _, c := range cases {
+		builder := factory.Builder(&AddressFactory{}, factory.WithLocale(c.locale))
+		for _, address := range builder.BuildList(50, nil) {
+			if address.Country != c.country || !c.postal.MatchString(address.PostalCode) {
+				t.Fatalf("unexpected %s address %+v", c.locale, address)
+			}
+			if address.Street == "" || address.City == "" {
+				t.Fatalf("expected street and city, got %+v", address)
+			}
+			if !c.line.MatchString(address.Line()) {
+				t.Fatalf("unexpected %s line %q", c.locale, address.Line())
+			}
+		}
+	}
+}
+
+func TestAddressFactoryIsDeterministic(t *testing.T) {
+	builder := factory.Builder(&AddressFactory{}, factory.WithLocale(factory.LocaleEnglish))
+
+	for seed := range int64(20) {
+		if builder.BuildWith(seed, nil) != builder.BuildWith(seed, nil) {
+			t.Fatalf("expected the same address for seed %d", seed)
+		}
+	}
+}
+
+func TestAddressFactoryOverrides(t *testing.T) {
+	builder := factory.Builder(&AddressFactory{}, factory.WithLocale(factory.LocaleGerman))
+
+	address := builder.Build(factory.Override[AddressProperties](map[string]any{"city": "Bonn", "postalCode": "53111"}))
+	if address.City != "Bonn" || address.PostalCode != "53111" || !strings.HasSuffix(address.Line(), "53111 Bonn, DE") {
+		t.Fatalf("expected overridden city and postal code, got %+v", address)
+	}
+
+	if line := (Address{Street: "1 Rue", City: "Paris", PostalCode: "75001", Country: "FR"}).Line(); line != "1 Rue, 75001 Paris, FR" {
+		t.Fatalf("unexpected fallback line %q", line)
+	}
+}
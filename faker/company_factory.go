@@ -0,0 +1,133 @@
+package faker
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+const (
+	companyPatternSalt  = 0x0C0A_9A17
+	companyIndustrySalt = 0x01D5_7A1E
+	companySuffixSalt   = 0x05F1_C0DE
+)
+
+var companyPrefixes = []string{
+	"Northwind", "Blue Harbor", "Summit", "Ironwood", "Silverline", "Redstone", "Brightpath",
+	"Evergreen", "Clearwater", "Highland", "Golden Gate", "Pioneer", "Starling", "Keystone",
+	"Crescent", "Maplewood",
+}
+
+var companyIndustries = []string{
+	"Logistics", "Analytics", "Foods", "Systems", "Labs", "Energy", "Capital", "Health",
+	"Media", "Robotics", "Software", "Partners", "Manufacturing", "Consulting", "Networks",
+	"Outfitters",
+}
+
+var companySuffixes = []string{"Inc.", "LLC", "Ltd.", "Corp.", "Co.", "PLC", "GmbH", "S.A."}
+
+// Company is a generated organization. Name excludes the legal suffix; Slug is a lowercase,
+// hyphen-separated form of Name suitable for tenant identifiers and URLs.
+type Company struct {
+	Name   string
+	Suffix string
+	Slug   string
+}
+
+// LegalName returns the name followed by the legal suffix, e.g. "Northwind Logistics Inc.".
+func (c Company) LegalName() string {
+	if c.Suffix == "" {
+		return c.Name
+	}
+	return c.Name + " " + c.Suffix
+}
+
+// CompanyProperties carries the parts of the company for CompanyFactory.
+type CompanyProperties struct {
+	name   string
+	suffix string
+	slug   string
+	locale factory.Locale
+}
+
+// CompanyFactory generates plausible company names from bundled word lists, either a brand prefix
+// or a surname of the builder's locale followed by an industry, e.g. "Summit Analytics" or
+// "Carter Foods", with a legal suffix and a slug derived from the name. Surnames come from
+// DatasetLastNames like NameFactory's. Suffixes restricts the legal suffixes when set.
+type CompanyFactory struct {
+	Suffixes []string
+
+	locale factory.Locale
+}
+
+// Localized returns a copy of the factory bound to locale.
+func (f *CompanyFactory) Localized(locale factory.Locale) factory.Factory[Company, CompanyProperties] {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Instantiate assembles the company.
+func (f *CompanyFactory) Instantiate(properties CompanyProperties) Company {
+	return Company{
+		Name:   properties.name,
+		Suffix: properties.suffix,
+		Slug:   properties.slug,
+	}
+}
+
+// Prepare generates every part that overrides leave empty; the slug follows an overridden name.
+func (f *CompanyFactory) Prepare(overrides factory.Partial[CompanyProperties], seed int64) CompanyProperties {
+	properties := CompanyProperties{
+		locale: f.locale,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.locale == "" {
+		properties.locale = factory.CurrentLocale()
+	}
+
+	if properties.name == "" {
+		var head string
+		if factory.BoolAt(seed^companyPatternSalt, 0.5) {
+			head = companyPrefixes[factory.IntAt(seed, 0, int64(len(companyPrefixes)-1))]
+		} else {
+			head = pick(properties.locale, factory.DatasetLastNames, bundledLastNames, seed)
+		}
+		properties.name = head + " " + companyIndustries[factory.IntAt(seed^companyIndustrySalt, 0, int64(len(companyIndustries)-1))]
+	}
+	if properties.suffix == "" {
+		suffixes := f.Suffixes
+		if len(suffixes) == 0 {
+			suffixes = companySuffixes
+		}
+		properties.suffix = suffixes[factory.IntAt(seed^companySuffixSalt, 0, int64(len(suffixes)-1))]
+	}
+	if properties.slug == "" {
+		properties.slug = companySlug(properties.name)
+	}
+
+	return properties
+}
+
+// Retrieve converts a company back into CompanyProperties.
+func (f *CompanyFactory) Retrieve(instance Company) CompanyProperties {
+	return CompanyProperties{
+		name:   instance.Name,
+		suffix: instance.Suffix,
+		slug:   instance.Slug,
+		locale: f.locale,
+	}
+}
+
+// companySlug lowercases name and joins its letter and digit runs with hyphens.
+func companySlug(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
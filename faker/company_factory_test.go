@@ -0,0 +1,66 @@
+package faker
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+func TestCompanyFactoryGeneratesNamesAndSlugs(t *testing.T) {
+	slug := regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+	distinct := make(map[string]bool)
+	for _, company := range factory.Builder(&CompanyFactory{}).BuildList(200, nil) {
+		if len(strings.Fields(company.Name)) < 2 {
+			t.Fatalf("expected a multi-word name, got %q", company.Name)
+		}
+		if !slices.Contains(companySuffixes, company.Suffix) {
+			t.Fatalf("unexpected suffix %q", company.Suffix)
+		}
+		if !slug.MatchString(company.Slug) {
+			t.Fatalf("unexpected slug %q for %q", company.Slug, company.Name)
+		}
+		if company.LegalName() != company.Name+" "+company.Suffix {
+			t.Fatalf("unexpected legal name %q", company.LegalName())
+		}
+		distinct[company.Name] = true
+	}
+
+	if len(distinct) < 50 {
+		t.Fatalf("expected varied names, got %d distinct", len(distinct))
+	}
+}
+
+func TestCompanyFactorySuffixes(t *testing.T) {
+	for _, company := range factory.Builder(&CompanyFactory{Suffixes: []string{"GmbH"}}).BuildList(20, nil) {
+		if company.Suffix != "GmbH" {
+			t.Fatalf("expected the configured suffix, got %q", company.Suffix)
+		}
+	}
+}
+
+func TestCompanyFactorySlugFollowsOverriddenName(t *testing.T) {
+	company := factory.Builder(&CompanyFactory{}).Build(factory.Override[CompanyProperties](map[string]any{"name": "Acme & Sons, 2nd Branch"}))
+	if company.Slug != "acme-sons-2nd-branch" {
+		t.Fatalf("unexpected slug %q", company.Slug)
+	}
+}
+
+func TestCompanyFactoryUsesSurnamesOfTheLocale(t *testing.T) {
+	surnames := 0
+	for _, company := range factory.Builder(&CompanyFactory{}, factory.WithLocale(factory.LocaleJapanese)).BuildList(100, nil) {
+		head := company.Name[:strings.LastIndex(company.Name, " ")]
+		if slices.Contains(bundledLastNames[factory.LocaleJapanese], head) {
+			surnames++
+		} else if !slices.Contains(companyPrefixes, head) {
+			t.Fatalf("expected a brand prefix or a Japanese surname, got %q", company.Name)
+		}
+	}
+
+	if surnames == 0 {
+		t.Fatal("expected some names built on Japanese surnames")
+	}
+}
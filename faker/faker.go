@@ -0,0 +1,24 @@
+// Package faker provides factories for realistic personal data, such as names, built on the
+// forge Factory and Builder lifecycle. Small word lists are bundled for every supported locale;
+// richer catalogs registered by the github.com/lihs-ie/forge/datapack modules take precedence.
+package faker
+
+import "github.com/lihs-ie/forge/factory"
+
+// pick returns the value of the registered dataset name for locale chosen by seed, falling back
+// to the bundled list for locale, then to the registered English dataset and the bundled English
+// list, so a bundled translation is never shadowed by an English pack.
+func pick(locale factory.Locale, name string, bundled map[factory.Locale][]string, seed int64) string {
+	values, ok := factory.LookupLocaleDataset(locale, name)
+	if !ok {
+		values, ok = bundled[locale]
+	}
+	if !ok {
+		values, ok = factory.LookupLocaleDataset(factory.LocaleEnglish, name)
+	}
+	if !ok {
+		values = bundled[factory.LocaleEnglish]
+	}
+
+	return values[factory.IntAt(seed, 0, int64(len(values)-1))]
+}
@@ -0,0 +1,42 @@
+package faker
+
+import (
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+func TestPickPrefersRegisteredDatasets(t *testing.T) {
+	factory.RegisterDataset(factory.LocaleGerman, "faker.test", []string{"registered"})
+
+	bundled := map[factory.Locale][]string{
+		factory.LocaleEnglish: {"english"},
+		factory.LocaleGerman:  {"bundled"},
+	}
+
+	if value := pick(factory.LocaleGerman, "faker.test", bundled, 1); value != "registered" {
+		t.Fatalf("expected the registered dataset, got %q", value)
+	}
+	if value := pick(factory.LocaleJapanese, "faker.missing", bundled, 1); value != "english" {
+		t.Fatalf("expected the English fallback, got %q", value)
+	}
+	if value := pick(factory.LocaleGerman, "faker.missing", bundled, 1); value != "bundled" {
+		t.Fatalf("expected the bundled list, got %q", value)
+	}
+}
+
+func TestPickPrefersBundledLocaleOverRegisteredEnglish(t *testing.T) {
+	factory.RegisterDataset(factory.LocaleEnglish, "faker.english", []string{"registered english"})
+
+	bundled := map[factory.Locale][]string{
+		factory.LocaleEnglish: {"english"},
+		factory.LocaleGerman:  {"bundled"},
+	}
+
+	if value := pick(factory.LocaleGerman, "faker.english", bundled, 1); value != "bundled" {
+		t.Fatalf("expected the bundled German list, got %q", value)
+	}
+	if value := pick(factory.LocaleJapanese, "faker.english", bundled, 1); value != "registered english" {
+		t.Fatalf("expected the registered English dataset, got %q", value)
+	}
+}
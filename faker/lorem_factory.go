@@ -0,0 +1,191 @@
+package faker
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+const (
+	loremSentenceMinWords  = 4
+	loremSentenceMaxWords  = 12
+	loremParagraphMinCount = 3
+	loremParagraphMaxCount = 6
+	loremUnitSalt          = 0x01_0AE3
+)
+
+var loremWords = []string{
+	"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit", "sed", "do",
+	"eiusmod", "tempor", "incididunt", "ut", "labore", "et", "dolore", "magna", "aliqua", "enim",
+	"ad", "minim", "veniam", "quis", "nostrud", "exercitation", "ullamco", "laboris", "nisi",
+	"aliquip", "ex", "ea", "commodo", "consequat", "duis", "aute", "irure", "in", "reprehenderit",
+	"voluptate", "velit", "esse", "cillum", "fugiat", "nulla", "pariatur", "excepteur", "sint",
+	"occaecat", "cupidatat", "non", "proident", "sunt", "culpa", "qui", "officia", "deserunt",
+	"mollit", "anim", "id", "est", "laborum",
+}
+
+var bundledLoremWords = map[factory.Locale][]string{
+	factory.LocaleJapanese: {
+		"空", "海", "山", "川", "森", "花", "風", "雨", "雪", "光", "星", "月", "道", "街", "橋",
+		"窓", "本", "声", "夢", "時",
+	},
+	factory.LocaleGerman: {
+		"Abend", "Baum", "Brücke", "Dach", "Feld", "Garten", "Himmel", "Insel", "Kraft", "Licht",
+		"Meer", "Nacht", "Quelle", "Regen", "Sonne", "Stadt", "Tisch", "Wald", "Wasser", "Zeit",
+	},
+}
+
+// loremScript describes how a locale joins words and ends sentences.
+type loremScript struct {
+	space  string
+	period string
+}
+
+var (
+	latinScript  = loremScript{space: " ", period: "."}
+	loremScripts = map[factory.Locale]loremScript{
+		factory.LocaleJapanese: {space: "", period: "。"},
+	}
+)
+
+// LoremUnit selects what LoremFactory counts.
+type LoremUnit int
+
+// Units of text produced by LoremFactory.
+const (
+	// LoremWords produces space-separated words.
+	LoremWords LoremUnit = iota
+	// LoremSentences produces capitalized sentences ending with a period.
+	LoremSentences
+	// LoremParagraphs produces paragraphs of 3 to 6 sentences separated by blank lines.
+	LoremParagraphs
+)
+
+// LoremProperties carries the locale, the number of units and the generated text for
+// LoremFactory.
+type LoremProperties struct {
+	locale factory.Locale
+	count  int
+	text   string
+}
+
+// LoremFactory generates placeholder text for free-text fields, Min to Max units long (1 to 3
+// when zero). Unit selects words, sentences of 4 to 12 words, or paragraphs. Words come from the
+// DatasetWords list registered for the builder's locale, then the bundled Japanese and German
+// lists, and are lorem ipsum otherwise. Japanese text is written without spaces and ends sentences
+// with "。".
+type LoremFactory struct {
+	Unit LoremUnit
+	Min  int
+	Max  int
+
+	locale factory.Locale
+}
+
+// Localized returns a copy of the factory bound to locale.
+func (f *LoremFactory) Localized(locale factory.Locale) factory.Factory[string, LoremProperties] {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Instantiate returns the generated text.
+func (f *LoremFactory) Instantiate(properties LoremProperties) string {
+	return properties.text
+}
+
+// Prepare draws the number of units and generates the text; a positive count or non-empty text
+// from overrides is kept.
+func (f *LoremFactory) Prepare(overrides factory.Partial[LoremProperties], seed int64) LoremProperties {
+	properties := LoremProperties{
+		locale: f.locale,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.locale == "" {
+		properties.locale = factory.CurrentLocale()
+	}
+
+	if properties.text != "" {
+		return properties
+	}
+
+	if properties.count <= 0 {
+		minimum, maximum := f.Min, f.Max
+		if minimum <= 0 {
+			minimum = 1
+		}
+		if maximum <= 0 {
+			maximum = 3
+		}
+		properties.count = int(factory.IntAt(seed^loremUnitSalt, int64(minimum), int64(max(minimum, maximum))))
+	}
+
+	vocabulary := loremVocabulary(properties.locale)
+	script, ok := loremScripts[properties.locale]
+	if !ok {
+		script = latinScript
+	}
+
+	switch f.Unit {
+	case LoremSentences:
+		properties.text = loremSentences(vocabulary, script, seed, properties.count)
+	case LoremParagraphs:
+		paragraphs := make([]string, properties.count)
+		for index := range paragraphs {
+			paragraphSeed := seed + int64(index)*1_000
+			sentences := factory.IntAt(paragraphSeed, loremParagraphMinCount, loremParagraphMaxCount)
+			paragraphs[index] = loremSentences(vocabulary, script, paragraphSeed, int(sentences))
+		}
+		properties.text = strings.Join(paragraphs, "\n\n")
+	default:
+		properties.text = strings.Join(loremWordList(vocabulary, seed, properties.count), script.space)
+	}
+
+	return properties
+}
+
+// Retrieve wraps existing text into LoremProperties.
+func (f *LoremFactory) Retrieve(instance string) LoremProperties {
+	return LoremProperties{
+		text: instance,
+	}
+}
+
+// loremVocabulary returns the words for locale: the registered DatasetWords list, then the bundled
+// list, then lorem ipsum.
+func loremVocabulary(locale factory.Locale) []string {
+	if words, ok := factory.LookupLocaleDataset(locale, factory.DatasetWords); ok {
+		return words
+	}
+	if words, ok := bundledLoremWords[locale]; ok {
+		return words
+	}
+	return loremWords
+}
+
+func loremWordList(vocabulary []string, seed int64, count int) []string {
+	words := make([]string, count)
+	for index := range words {
+		words[index] = vocabulary[factory.IntAt(seed+int64(index), 0, int64(len(vocabulary)-1))]
+	}
+	return words
+}
+
+func loremSentences(vocabulary []string, script loremScript, seed int64, count int) string {
+	sentences := make([]string, count)
+	for index := range sentences {
+		sentenceSeed := seed + int64(index)*100
+		words := loremWordList(vocabulary, sentenceSeed, int(factory.IntAt(sentenceSeed, loremSentenceMinWords, loremSentenceMaxWords)))
+
+		first, size := utf8.DecodeRuneInString(words[0])
+		words[0] = string(unicode.ToUpper(first)) + words[0][size:]
+		sentences[index] = strings.Join(words, script.space) + script.period
+	}
+	return strings.Join(sentences, script.space)
+}
@@ -0,0 +1,113 @@
+package faker
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+func TestLoremFactoryWords(t *testing.T) {
+	builder := factory.Builder(&LoremFactory{Min: 5, Max: 5})
+
+	for _, text := range builder.BuildList(50, nil) {
+		if words := strings.Fields(text); len(words) != 5 {
+			t.Fatalf("expected five words, got %q", text)
+		}
+	}
+}
+
+func TestLoremFactorySentences(t *testing.T) {
+	builder := factory.Builder(&LoremFactory{Unit: LoremSentences, Min: 2, Max: 4})
+
+	for _, text := range builder.BuildList(50, nil) {
+		count := strings.Count(text, ".")
+		if count < 2 || count > 4 || !strings.HasSuffix(text, ".") {
+			t.Fatalf("expected two to four sentences, got %q", text)
+		}
+
+		for _, sentence := range strings.Split(strings.TrimSuffix(text, "."), ". ") {
+			words := strings.Fields(sentence)
+			if len(words) < loremSentenceMinWords || len(words) > loremSentenceMaxWords {
+				t.Fatalf("sentence %q outside word bounds", sentence)
+			}
+			if sentence[0] < 'A' || sentence[0] > 'Z' {
+				t.Fatalf("expected a capitalized sentence, got %q", sentence)
+			}
+		}
+	}
+}
+
+func TestLoremFactoryParagraphs(t *testing.T) {
+	builder := factory.Builder(&LoremFactory{Unit: LoremParagraphs, Min: 3, Max: 3})
+
+	text := builder.BuildWith(1, nil)
+	paragraphs := strings.Split(text, "\n\n")
+	if len(paragraphs) != 3 {
+		t.Fatalf("expected three paragraphs, got %d", len(paragraphs))
+	}
+	for _, paragraph := range paragraphs {
+		if sentences := strings.Count(paragraph, "."); sentences < loremParagraphMinCount || sentences > loremParagraphMaxCount {
+			t.Fatalf("expected three to six sentences, got %q", paragraph)
+		}
+	}
+	if paragraphs[0] == paragraphs[1] {
+		t.Fatal("expected paragraphs to differ")
+	}
+
+	if builder.BuildWith(1, nil) != text {
+		t.Fatal("expected the same text for the same seed")
+	}
+}
+
+func TestLoremFactoryOverrideCount(t *testing.T) {
+	text := factory.Builder(&LoremFactory{}).Build(factory.Override[LoremProperties](map[string]any{"count": 20}))
+	if len(strings.Fields(text)) != 20 {
+		t.Fatalf("expected the overridden word count, got %q", text)
+	}
+}
+
+func TestLoremFactoryLocalizedWords(t *testing.T) {
+	german := factory.Builder(&LoremFactory{Min: 6, Max: 6}, factory.WithLocale(factory.LocaleGerman)).BuildWith(1, nil)
+	for _, word := range strings.Fields(german) {
+		if !slices.Contains(bundledLoremWords[factory.LocaleGerman], word) {
+			t.Fatalf("expected German words, got %q", german)
+		}
+	}
+
+	japanese := (&LoremFactory{Unit: LoremSentences, Min: 2, Max: 2}).Localized(factory.LocaleJapanese)
+	text := factory.Builder(japanese).BuildWith(1, nil)
+	if strings.Count(text, "。") != 2 || strings.ContainsAny(text, " .") {
+		t.Fatalf("expected two Japanese sentences without spaces, got %q", text)
+	}
+
+	latin := factory.Builder(&LoremFactory{Min: 6, Max: 6}, factory.WithLocale("fr")).BuildWith(1, nil)
+	for _, word := range strings.Fields(latin) {
+		if !slices.Contains(loremWords, word) {
+			t.Fatalf("expected lorem ipsum for a locale without words, got %q", latin)
+		}
+	}
+}
+
+func TestLoremFactoryPrefersRegisteredWords(t *testing.T) {
+	factory.RegisterDataset("eo", factory.DatasetWords, []string{"vorto"})
+
+	text := factory.Builder(&LoremFactory{Min: 3, Max: 3}, factory.WithLocale("eo")).BuildWith(1, nil)
+	if text != "vorto vorto vorto" {
+		t.Fatalf("expected the registered words, got %q", text)
+	}
+}
+
+func TestLoremFactoryFollowsGlobalLocale(t *testing.T) {
+	previous := factory.CurrentLocale()
+	t.Cleanup(func() { factory.SetLocale(previous) })
+	factory.SetLocale(factory.LocaleGerman)
+
+	text := factory.Builder(&LoremFactory{Min: 4, Max: 4}).BuildWith(1, nil)
+	for _, word := range strings.Fields(text) {
+		if !slices.Contains(bundledLoremWords[factory.LocaleGerman], word) {
+			t.Fatalf("expected German words under the global locale, got %q", text)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package faker
+
+import (
+	"strings"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+// lastNameSalt decorrelates the last name from the first name drawn with the same seed.
+const lastNameSalt = 0x0A5E_1A57
+
+var bundledFirstNames = map[factory.Locale][]string{
+	factory.LocaleEnglish: {
+		"James", "Mary", "John", "Patricia", "Robert", "Jennifer", "Michael", "Linda", "William",
+		"Elizabeth", "David", "Barbara", "Richard", "Susan", "Joseph", "Jessica", "Thomas", "Sarah",
+		"Charles", "Karen", "Daniel", "Emily", "Matthew", "Olivia",
+	},
+	factory.LocaleJapanese: {
+		"太郎", "花子", "翔太", "陽菜", "蓮", "結衣", "大翔", "美咲", "悠真", "さくら",
+		"湊", "葵", "陽翔", "凛", "樹", "莉子", "健太", "七海",
+	},
+}
+
+var bundledLastNames = map[factory.Locale][]string{
+	factory.LocaleEnglish: {
+		"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis", "Rodriguez",
+		"Martinez", "Wilson", "Anderson", "Taylor", "Thomas", "Moore", "Jackson", "Martin", "Lee",
+		"Thompson", "White", "Harris", "Clark", "Lewis", "Walker",
+	},
+	factory.LocaleJapanese: {
+		"佐藤", "鈴木", "高橋", "田中", "伊藤", "渡辺", "山本", "中村", "小林", "加藤",
+		"吉田", "山田", "佐々木", "山口", "松本", "井上", "木村", "清水",
+	},
+}
+
+// NamePart selects which part of a name NameFactory produces.
+type NamePart int
+
+// Name parts produced by NameFactory.
+const (
+	// FullName joins first and last name in the locale's order, e.g. "Mary Smith" or "佐藤 花子".
+	FullName NamePart = iota
+	// FirstName produces given names only.
+	FirstName
+	// LastName produces family names only.
+	LastName
+)
+
+// NameProperties carries the locale and the name parts for NameFactory.
+type NameProperties struct {
+	first  string
+	last   string
+	locale factory.Locale
+}
+
+// NameFactory generates person names in the builder's locale (English and Japanese are bundled).
+// Part selects the full, first or last name.
+type NameFactory struct {
+	Part NamePart
+
+	locale factory.Locale
+}
+
+// Localized returns a copy of the factory bound to locale.
+func (f *NameFactory) Localized(locale factory.Locale) factory.Factory[string, NameProperties] {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Instantiate returns the requested part of the name.
+func (f *NameFactory) Instantiate(properties NameProperties) string {
+	switch f.Part {
+	case FirstName:
+		return properties.first
+	case LastName:
+		return properties.last
+	default:
+		if properties.locale == factory.LocaleJapanese {
+			return properties.last + " " + properties.first
+		}
+		return properties.first + " " + properties.last
+	}
+}
+
+// Prepare draws the first and last name; non-empty parts from overrides are kept.
+func (f *NameFactory) Prepare(overrides factory.Partial[NameProperties], seed int64) NameProperties {
+	properties := NameProperties{
+		locale: f.locale,
+	}
+
+	if overrides != nil {
+		overrides(&properties)
+	}
+
+	if properties.locale == "" {
+		properties.locale = factory.CurrentLocale()
+	}
+	if properties.first == "" {
+		properties.first = pick(properties.locale, factory.DatasetFirstNames, bundledFirstNames, seed)
+	}
+	if properties.last == "" {
+		properties.last = pick(properties.locale, factory.DatasetLastNames, bundledLastNames, seed^lastNameSalt)
+	}
+
+	return properties
+}
+
+// Retrieve converts a name back into NameProperties. Full names are split at the first space, in
+// the order of the locale Prepare would use.
+func (f *NameFactory) Retrieve(instance string) NameProperties {
+	properties := NameProperties{
+		locale: f.locale,
+	}
+	if properties.locale == "" {
+		properties.locale = factory.CurrentLocale()
+	}
+
+	switch f.Part {
+	case FirstName:
+		properties.first = instance
+	case LastName:
+		properties.last = instance
+	default:
+		head, tail, _ := strings.Cut(instance, " ")
+		properties.first, properties.last = head, tail
+		if properties.locale == factory.LocaleJapanese {
+			properties.first, properties.last = tail, head
+		}
+	}
+
+	return properties
+}
@@ -0,0 +1,83 @@
+package faker
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+func TestNameFactoryEnglish(t *testing.T) {
+	builder := factory.Builder(&NameFactory{}, factory.WithLocale(factory.LocaleEnglish))
+
+	for _, name := range builder.BuildList(100, nil) {
+		first, last, ok := strings.Cut(name, " ")
+		if !ok || !slices.Contains(bundledFirstNames[factory.LocaleEnglish], first) || !slices.Contains(bundledLastNames[factory.LocaleEnglish], last) {
+			t.Fatalf("unexpected english name %q", name)
+		}
+	}
+}
+
+func TestNameFactoryJapaneseOrder(t *testing.T) {
+	builder := factory.Builder(&NameFactory{}, factory.WithLocale(factory.LocaleJapanese))
+
+	for _, name := range builder.BuildList(100, nil) {
+		last, first, ok := strings.Cut(name, " ")
+		if !ok || !slices.Contains(bundledLastNames[factory.LocaleJapanese], last) || !slices.Contains(bundledFirstNames[factory.LocaleJapanese], first) {
+			t.Fatalf("unexpected japanese name %q", name)
+		}
+	}
+}
+
+func TestNameFactoryParts(t *testing.T) {
+	full := factory.Builder(&NameFactory{}, factory.WithLocale(factory.LocaleEnglish))
+	first := factory.Builder(&NameFactory{Part: FirstName}, factory.WithLocale(factory.LocaleEnglish))
+	last := factory.Builder(&NameFactory{Part: LastName}, factory.WithLocale(factory.LocaleEnglish))
+
+	for seed := range int64(30) {
+		if full.BuildWith(seed, nil) != first.BuildWith(seed, nil)+" "+last.BuildWith(seed, nil) {
+			t.Fatalf("expected parts to compose the full name for seed %d", seed)
+		}
+	}
+
+	distinct := make(map[string]bool)
+	for _, name := range full.BuildList(200, nil) {
+		distinct[name] = true
+	}
+	if len(distinct) < 100 {
+		t.Fatalf("expected varied names, got %d distinct", len(distinct))
+	}
+}
+
+func TestNameFactoryOverridesAndRetrieve(t *testing.T) {
+	builder := factory.Builder(&NameFactory{}, factory.WithLocale(factory.LocaleEnglish))
+
+	name := builder.Build(factory.Override[NameProperties](map[string]any{"last": "Doe"}))
+	if !strings.HasSuffix(name, " Doe") {
+		t.Fatalf("expected overridden last name, got %q", name)
+	}
+
+	properties := (&NameFactory{}).Localized(factory.LocaleJapanese).Retrieve("佐藤 花子")
+	if properties.first != "花子" || properties.last != "佐藤" {
+		t.Fatalf("unexpected retrieved properties %+v", properties)
+	}
+}
+
+func TestNameFactoryRoundTripsUnderGlobalLocale(t *testing.T) {
+	previous := factory.CurrentLocale()
+	t.Cleanup(func() { factory.SetLocale(previous) })
+	factory.SetLocale(factory.LocaleJapanese)
+
+	if properties := (&NameFactory{}).Retrieve("佐藤 花子"); properties.first != "花子" || properties.last != "佐藤" {
+		t.Fatalf("expected the family name first, got %+v", properties)
+	}
+
+	builder := factory.Builder(&NameFactory{})
+	for _, name := range builder.BuildList(20, nil) {
+		family, _, _ := strings.Cut(name, " ")
+		if anonymized := factory.Anonymize(builder, name, "first"); !strings.HasPrefix(anonymized, family+" ") {
+			t.Fatalf("expected %q to keep its family name first, got %q", name, anonymized)
+		}
+	}
+}
@@ -41,7 +41,7 @@ func (set *Set[T]) Remove(item T) {
 		return
 	}
 	hash := hamt.Hash(item)
-	newRoot, _ := set.root.Remove(hash, 0)
+	newRoot, _ := set.root.Remove(item, hash, 0)
 	set.root = newRoot
 }
 
@@ -50,7 +50,7 @@ func (set *Set[T]) Has(item T) bool {
 		return false
 	}
 	hash := hamt.Hash(item)
-	_, found := set.root.Get(hash, 0)
+	_, found := set.root.Get(item, hash, 0)
 	return found
 }
 
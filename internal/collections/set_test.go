@@ -1,6 +1,7 @@
 package collections
 
 import (
+	"math/rand"
 	"testing"
 )
 
@@ -136,6 +137,33 @@ func TestHas(t *testing.T) {
 	}
 }
 
+// collidingItem hashes every value alike, so a Set of them must tell items apart by value.
+type collidingItem struct {
+	name string
+}
+
+func (item collidingItem) Hash() (uint64, error) {
+	return 1, nil
+}
+
+func TestHasDistinguishesCollidingItems(t *testing.T) {
+	set := NewFromSlice([]collidingItem{{name: "apple"}, {name: "banana"}})
+
+	if !set.Has(collidingItem{name: "apple"}) || !set.Has(collidingItem{name: "banana"}) {
+		t.Error("Expected set to contain both colliding items")
+	}
+
+	if set.Has(collidingItem{name: "cherry"}) {
+		t.Error("Expected set to not contain 'cherry' despite the shared hash")
+	}
+
+	set.Remove(collidingItem{name: "cherry"})
+	set.Remove(collidingItem{name: "apple"})
+	if set.Has(collidingItem{name: "apple"}) || !set.Has(collidingItem{name: "banana"}) || set.Size() != 1 {
+		t.Errorf("Expected only 'banana' to remain, got %v", set.ToSlice())
+	}
+}
+
 func TestHasEmptySet(t *testing.T) {
 	set := NewSet[int](nil)
 
@@ -250,3 +278,31 @@ func TestSetWithStruct(t *testing.T) {
 		t.Error("Expected set to contain p2")
 	}
 }
+
+func TestSetMatchesReferenceMap(t *testing.T) {
+	for run := range 10 {
+		random := rand.New(rand.NewSource(int64(run)))
+		set := NewSet[int](nil)
+		reference := make(map[int]bool)
+
+		for step := range 500 {
+			item := random.Intn(200)
+			if random.Intn(3) == 0 {
+				set.Remove(item)
+				delete(reference, item)
+			} else {
+				set.Set(item)
+				reference[item] = true
+			}
+
+			if set.Size() != len(reference) {
+				t.Fatalf("run %d step %d: size %d, reference %d", run, step, set.Size(), len(reference))
+			}
+			for candidate := range 200 {
+				if set.Has(candidate) != reference[candidate] {
+					t.Fatalf("run %d step %d: Has(%d) = %v, reference %v", run, step, candidate, set.Has(candidate), reference[candidate])
+				}
+			}
+		}
+	}
+}
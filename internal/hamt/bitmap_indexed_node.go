@@ -21,7 +21,7 @@ func (node *BitmapIndexedNode[K, V]) Value() V {
 	return *new(V)
 }
 
-func (node *BitmapIndexedNode[K, V]) Get(hash uint64, offset int) (V, bool) {
+func (node *BitmapIndexedNode[K, V]) Get(key K, hash uint64, offset int) (V, bool) {
 	position := node.bitmap.Position(hash, offset)
 
 	if !node.bitmap.Has(position) {
@@ -30,7 +30,7 @@ func (node *BitmapIndexedNode[K, V]) Get(hash uint64, offset int) (V, bool) {
 
 	index, _ := node.bitmap.Index(position)
 
-	return node.children[index].Get(hash, offset+1)
+	return node.children[index].Get(key, hash, offset+1)
 }
 
 func (node *BitmapIndexedNode[K, V]) Set(key K, value V, hash uint64, offset int) Node[K, V] {
@@ -40,7 +40,7 @@ func (node *BitmapIndexedNode[K, V]) Set(key K, value V, hash uint64, offset int
 
 	if node.bitmap.Has(position) {
 		target := node.children[index]
-		next := target.Set(key, value, hash, offset)
+		next := target.Set(key, value, hash, offset+1)
 
 		if next == target {
 			return node
@@ -60,7 +60,7 @@ func (node *BitmapIndexedNode[K, V]) Set(key K, value V, hash uint64, offset int
 	)
 }
 
-func (node *BitmapIndexedNode[K, V]) Remove(hash uint64, offset int) (Node[K, V], bool) {
+func (node *BitmapIndexedNode[K, V]) Remove(key K, hash uint64, offset int) (Node[K, V], bool) {
 	position := node.bitmap.Position(hash, offset)
 
 	if !node.bitmap.Has(position) {
@@ -69,7 +69,7 @@ func (node *BitmapIndexedNode[K, V]) Remove(hash uint64, offset int) (Node[K, V]
 
 	index, _ := node.bitmap.Index(position)
 	target := node.children[index]
-	nextNode, exists := target.Remove(hash, offset+1)
+	nextNode, exists := target.Remove(key, hash, offset+1)
 
 	if !exists {
 		return node, false
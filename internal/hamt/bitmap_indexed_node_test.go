@@ -55,7 +55,7 @@ func TestBitmapIndexedNodeGet(t *testing.T) {
 	node := NewBitmapIndexedNode(bitmap, []Node[string, int]{leaf1, leaf2})
 
 	// Get existing values
-	value, found := node.Get(hash1, 0)
+	value, found := node.Get("key1", hash1, 0)
 	if !found {
 		t.Error("Expected to find first value")
 	}
@@ -63,7 +63,7 @@ func TestBitmapIndexedNodeGet(t *testing.T) {
 		t.Errorf("Expected value 100, got %d", value)
 	}
 
-	value, found = node.Get(hash2, 0)
+	value, found = node.Get("key2", hash2, 0)
 	if !found {
 		t.Error("Expected to find second value")
 	}
@@ -72,7 +72,7 @@ func TestBitmapIndexedNodeGet(t *testing.T) {
 	}
 
 	// Get non-existing value
-	_, found = node.Get(99999, 0)
+	_, found = node.Get("missing", 99999, 0)
 	if found {
 		t.Error("Expected not to find non-existing value")
 	}
@@ -92,8 +92,8 @@ func TestBitmapIndexedNodeSet(t *testing.T) {
 	newNode := node.Set("key2", 200, hash2, 0)
 
 	// Both values should be accessible
-	value1, found1 := newNode.Get(hash1, 0)
-	value2, found2 := newNode.Get(hash2, 0)
+	value1, found1 := newNode.Get("key1", hash1, 0)
+	value2, found2 := newNode.Get("key2", hash2, 0)
 
 	if !found1 || !found2 {
 		t.Error("Expected to find both values")
@@ -115,7 +115,7 @@ func TestBitmapIndexedNodeSetUpdate(t *testing.T) {
 	// Update existing value
 	newNode := node.Set("key1", 999, hash, 0)
 
-	value, found := newNode.Get(hash, 0)
+	value, found := newNode.Get("key1", hash, 0)
 	if !found {
 		t.Error("Expected to find updated value")
 	}
@@ -139,17 +139,17 @@ func TestBitmapIndexedNodeRemove(t *testing.T) {
 	node := NewBitmapIndexedNode(bitmap, []Node[string, int]{leaf1, leaf2})
 
 	// Remove first value
-	newNode, removed := node.Remove(hash1, 0)
+	newNode, removed := node.Remove("key1", hash1, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
 
-	_, found := newNode.Get(hash1, 0)
+	_, found := newNode.Get("key1", hash1, 0)
 	if found {
 		t.Error("Expected first value to be removed")
 	}
 
-	value, found := newNode.Get(hash2, 0)
+	value, found := newNode.Get("key2", hash2, 0)
 	if !found {
 		t.Error("Expected second value to still exist")
 	}
@@ -167,7 +167,7 @@ func TestBitmapIndexedNodeRemoveNonExistent(t *testing.T) {
 	node := NewBitmapIndexedNode(bitmap, []Node[string, int]{leaf})
 
 	// Try to remove non-existent value
-	newNode, removed := node.Remove(99999, 0)
+	newNode, removed := node.Remove("missing", 99999, 0)
 	if removed {
 		t.Error("Expected removal to fail")
 	}
@@ -186,7 +186,7 @@ func TestBitmapIndexedNodeRemoveAll(t *testing.T) {
 	node := NewBitmapIndexedNode(bitmap, []Node[string, int]{leaf})
 
 	// Remove the only value
-	newNode, removed := node.Remove(hash, 0)
+	newNode, removed := node.Remove("key1", hash, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
@@ -27,13 +27,13 @@ func (node *CollisionNode[K, V]) Value() V {
 	return *new(V)
 }
 
-func (node *CollisionNode[K, V]) Get(hash uint64, offset int) (V, bool) {
+func (node *CollisionNode[K, V]) Get(key K, hash uint64, offset int) (V, bool) {
 	if node.hash != hash {
 		return *new(V), false
 	}
 
-	if len(node.entries) > 0 {
-		return node.entries[0].Value, true
+	if index := node.indexOf(key); index >= 0 {
+		return node.entries[index].Value, true
 	}
 
 	return *new(V), false
@@ -41,6 +41,13 @@ func (node *CollisionNode[K, V]) Get(hash uint64, offset int) (V, bool) {
 
 func (node *CollisionNode[K, V]) Set(key K, value V, hash uint64, offset int) Node[K, V] {
 	if node.hash == hash {
+		if index := node.indexOf(key); index >= 0 {
+			// Existing key - replace its value
+			newEntries := make([]Entry[K, V], len(node.entries))
+			copy(newEntries, node.entries)
+			newEntries[index] = Entry[K, V]{Key: key, Value: value}
+			return NewCollisionNode(hash, newEntries)
+		}
 
 		newEntries := make([]Entry[K, V], len(node.entries)+1)
 		copy(newEntries, node.entries)
@@ -48,25 +55,50 @@ func (node *CollisionNode[K, V]) Set(key K, value V, hash uint64, offset int) No
 		return NewCollisionNode(hash, newEntries)
 	}
 
-	return NewLeafNode(hash, key, value)
+	// Different hash - split into a BitmapIndexedNode keeping the whole collision bucket
+	bitmap := Initialize()
+	position1 := bitmap.Position(node.hash, offset)
+	position2 := bitmap.Position(hash, offset)
+
+	if position1 == position2 {
+		// Positions collide at this level, need to go deeper
+		nextNode := node.Set(key, value, hash, offset+1)
+		return NewBitmapIndexedNode(
+			bitmap.Next(position1),
+			[]Node[K, V]{nextNode},
+		)
+	}
+
+	bitmapNode := NewBitmapIndexedNode(
+		bitmap.Next(position1),
+		[]Node[K, V]{node},
+	)
+
+	return bitmapNode.Set(key, value, hash, offset)
 }
 
-func (node *CollisionNode[K, V]) Remove(hash uint64, offset int) (Node[K, V], bool) {
+func (node *CollisionNode[K, V]) Remove(key K, hash uint64, offset int) (Node[K, V], bool) {
 	if node.hash != hash {
 		return node, false
 	}
 
+	index := node.indexOf(key)
+	if index < 0 {
+		return node, false
+	}
+
 	if len(node.entries) == 1 {
 		return nil, true
 	}
 
 	if len(node.entries) == 2 {
-		remaining := node.entries[1]
+		remaining := node.entries[1-index]
 		return NewLeafNode(node.hash, remaining.Key, remaining.Value), true
 	}
 
-	newEntries := make([]Entry[K, V], len(node.entries)-1)
-	copy(newEntries, node.entries[1:])
+	newEntries := make([]Entry[K, V], 0, len(node.entries)-1)
+	newEntries = append(newEntries, node.entries[:index]...)
+	newEntries = append(newEntries, node.entries[index+1:]...)
 	return NewCollisionNode(node.hash, newEntries), true
 }
 
@@ -75,3 +107,13 @@ func (node *CollisionNode[K, V]) ToSlice() []Entry[K, V] {
 	copy(result, node.entries)
 	return result
 }
+
+func (node *CollisionNode[K, V]) indexOf(key K) int {
+	for index, entry := range node.entries {
+		if sameKey(entry.Key, key) {
+			return index
+		}
+	}
+
+	return -1
+}
@@ -79,17 +79,25 @@ func TestCollisionNodeGet(t *testing.T) {
 
 	node := NewCollisionNode(hash, entries)
 
-	// Get with matching hash
-	value, found := node.Get(hash, 0)
-	if !found {
-		t.Error("Expected to find value with matching hash")
+	// Get each key with the shared hash
+	value, found := node.Get("key1", hash, 0)
+	if !found || value != 100 {
+		t.Errorf("Expected key1 to hold 100, got %d, %v", value, found)
 	}
-	if value != 100 {
-		t.Errorf("Expected value 100, got %d", value)
+
+	value, found = node.Get("key2", hash, 0)
+	if !found || value != 200 {
+		t.Errorf("Expected key2 to hold 200, got %d, %v", value, found)
+	}
+
+	// Get an absent key with the shared hash
+	_, found = node.Get("key3", hash, 0)
+	if found {
+		t.Error("Expected not to find an absent key with matching hash")
 	}
 
 	// Get with non-matching hash
-	_, found = node.Get(99999, 0)
+	_, found = node.Get("key1", 99999, 0)
 	if found {
 		t.Error("Expected not to find value with non-matching hash")
 	}
@@ -98,7 +106,7 @@ func TestCollisionNodeGet(t *testing.T) {
 func TestCollisionNodeGetEmpty(t *testing.T) {
 	node := NewCollisionNode[string, int](12345, []Entry[string, int]{})
 
-	_, found := node.Get(12345, 0)
+	_, found := node.Get("key1", 12345, 0)
 	if found {
 		t.Error("Expected not to find value in empty collision node")
 	}
@@ -135,30 +143,116 @@ func TestCollisionNodeSetSameHash(t *testing.T) {
 	}
 }
 
+func TestCollisionNodeSetExistingKey(t *testing.T) {
+	hash := uint64(12345)
+	node := NewCollisionNode(hash, []Entry[string, int]{
+		{Key: "key1", Value: 100},
+		{Key: "key2", Value: 200},
+	})
+
+	newNode := node.Set("key2", 999, hash, 0)
+
+	if size := len(newNode.ToSlice()); size != 2 {
+		t.Errorf("Expected updating a key to keep 2 entries, got %d", size)
+	}
+	if value, found := newNode.Get("key2", hash, 0); !found || value != 999 {
+		t.Errorf("Expected key2 to hold 999, got %d, %v", value, found)
+	}
+	if value, found := newNode.Get("key1", hash, 0); !found || value != 100 {
+		t.Errorf("Expected key1 to keep 100, got %d, %v", value, found)
+	}
+	if value, _ := node.Get("key2", hash, 0); value != 200 {
+		t.Errorf("Expected original node to keep 200, got %d", value)
+	}
+}
+
 func TestCollisionNodeSetDifferentHash(t *testing.T) {
 	hash1 := uint64(12345)
 	hash2 := uint64(67890)
 
 	entries := []Entry[string, int]{
 		{Key: "key1", Value: 100},
+		{Key: "key1b", Value: 101},
 	}
 
 	node := NewCollisionNode(hash1, entries)
 
-	// Set with different hash should create a new leaf
+	// Set with different hash should split into a BitmapIndexedNode keeping the bucket
 	newNode := node.Set("key2", 200, hash2, 0)
 
-	leaf, ok := newNode.(*LeafNode[string, int])
-	if !ok {
-		t.Fatal("Expected result to be LeafNode")
+	if _, ok := newNode.(*BitmapIndexedNode[string, int]); !ok {
+		t.Fatalf("Expected result to be BitmapIndexedNode, got %T", newNode)
+	}
+
+	if value, found := newNode.Get("key1", hash1, 0); !found || value != 100 {
+		t.Errorf("Expected collision bucket to remain reachable, got %d, %v", value, found)
+	}
+
+	if value, found := newNode.Get("key2", hash2, 0); !found || value != 200 {
+		t.Errorf("Expected new key to be reachable, got %d, %v", value, found)
+	}
+
+	if size := len(newNode.ToSlice()); size != 3 {
+		t.Errorf("Expected 3 entries, got %d", size)
+	}
+}
+
+func TestCollisionNodeSetDifferentHashSamePosition(t *testing.T) {
+	hash1 := uint64(12345)
+	hash2 := hash1 + (1 << shiftWidth) // same position at offset 0, different at offset 1
+
+	node := NewCollisionNode(hash1, []Entry[string, int]{
+		{Key: "key1", Value: 100},
+		{Key: "key1b", Value: 101},
+	})
+
+	newNode := node.Set("key2", 200, hash2, 0)
+
+	if value, found := newNode.Get("key1", hash1, 0); !found || value != 100 {
+		t.Errorf("Expected collision bucket to remain reachable, got %d, %v", value, found)
+	}
+
+	if value, found := newNode.Get("key2", hash2, 0); !found || value != 200 {
+		t.Errorf("Expected new key to be reachable, got %d, %v", value, found)
+	}
+
+	if size := len(newNode.ToSlice()); size != 3 {
+		t.Errorf("Expected 3 entries, got %d", size)
+	}
+}
+
+func TestCollisionNodeSplitKeepsOriginal(t *testing.T) {
+	node := NewCollisionNode(uint64(12345), []Entry[string, int]{
+		{Key: "key1", Value: 100},
+		{Key: "key1b", Value: 101},
+	})
+
+	node.Set("key2", 200, uint64(67890), 0)
+
+	if size := len(node.ToSlice()); size != 2 {
+		t.Errorf("Expected original node to keep 2 entries, got %d", size)
 	}
+}
 
-	if leaf.hash != hash2 {
-		t.Errorf("Expected hash %d, got %d", hash2, leaf.hash)
+func TestBitmapIndexedNodeGetAfterManySets(t *testing.T) {
+	var root Node[int, int]
+	for i := range 5000 {
+		hash := Hash(i)
+		if root == nil {
+			root = NewLeafNode(hash, i, i)
+		} else {
+			root = root.Set(i, i, hash, 0)
+		}
 	}
 
-	if leaf.Key() != "key2" {
-		t.Errorf("Expected key 'key2', got '%s'", leaf.Key())
+	for i := range 5000 {
+		if value, found := root.Get(i, Hash(i), 0); !found || value != i {
+			t.Fatalf("Expected %d to be reachable, got %d, %v", i, value, found)
+		}
+	}
+
+	if size := len(root.ToSlice()); size != 5000 {
+		t.Errorf("Expected 5000 entries, got %d", size)
 	}
 }
 
@@ -171,7 +265,7 @@ func TestCollisionNodeRemoveSingleEntry(t *testing.T) {
 	node := NewCollisionNode(hash, entries)
 
 	// Remove the only entry
-	newNode, removed := node.Remove(hash, 0)
+	newNode, removed := node.Remove("key1", hash, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
@@ -191,7 +285,7 @@ func TestCollisionNodeRemoveTwoEntries(t *testing.T) {
 	node := NewCollisionNode(hash, entries)
 
 	// Remove one entry, should get a leaf back
-	newNode, removed := node.Remove(hash, 0)
+	newNode, removed := node.Remove("key1", hash, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
@@ -216,8 +310,8 @@ func TestCollisionNodeRemoveMultipleEntries(t *testing.T) {
 
 	node := NewCollisionNode(hash, entries)
 
-	// Remove one entry
-	newNode, removed := node.Remove(hash, 0)
+	// Remove the middle entry
+	newNode, removed := node.Remove("key2", hash, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
@@ -231,9 +325,9 @@ func TestCollisionNodeRemoveMultipleEntries(t *testing.T) {
 		t.Errorf("Expected 2 entries, got %d", len(collision.entries))
 	}
 
-	// Should have removed the first entry
-	if collision.entries[0].Key != "key2" || collision.entries[0].Value != 200 {
-		t.Error("Expected first remaining entry to be key2")
+	// Should have removed only key2
+	if collision.entries[0].Key != "key1" || collision.entries[0].Value != 100 {
+		t.Error("Expected first remaining entry to be key1")
 	}
 
 	if collision.entries[1].Key != "key3" || collision.entries[1].Value != 300 {
@@ -250,7 +344,7 @@ func TestCollisionNodeRemoveNonMatching(t *testing.T) {
 	node := NewCollisionNode(hash, entries)
 
 	// Try to remove with different hash
-	newNode, removed := node.Remove(99999, 0)
+	newNode, removed := node.Remove("key1", 99999, 0)
 	if removed {
 		t.Error("Expected removal to fail")
 	}
@@ -258,4 +352,14 @@ func TestCollisionNodeRemoveNonMatching(t *testing.T) {
 	if newNode != node {
 		t.Error("Expected node to be unchanged")
 	}
+
+	// Try to remove an absent key with the same hash
+	newNode, removed = node.Remove("key2", hash, 0)
+	if removed {
+		t.Error("Expected removal of an absent key to fail")
+	}
+
+	if newNode != node {
+		t.Error("Expected node to be unchanged")
+	}
 }
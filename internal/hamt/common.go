@@ -1,5 +1,7 @@
 package hamt
 
+import "reflect"
+
 type Entry[K any, V any] struct {
 	Key   K
 	Value V
@@ -8,8 +10,14 @@ type Entry[K any, V any] struct {
 type Node[K any, V any] interface {
 	Key() K
 	Value() V
-	Get(hash uint64, offset int) (V, bool)
+	Get(key K, hash uint64, offset int) (V, bool)
 	Set(key K, value V, hash uint64, offset int) Node[K, V]
-	Remove(hash uint64, offset int) (Node[K, V], bool)
+	Remove(key K, hash uint64, offset int) (Node[K, V], bool)
 	ToSlice() []Entry[K, V]
 }
+
+// sameKey reports whether two keys with equal hashes are the same key; distinct keys sharing a
+// hash are kept apart in a CollisionNode.
+func sameKey[K any](left, right K) bool {
+	return reflect.DeepEqual(left, right)
+}
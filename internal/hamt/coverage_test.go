@@ -52,8 +52,8 @@ func TestLeafNodeSetPositionCollision(t *testing.T) {
 	newNode := leaf1.Set("key2", 200, hash2, 0)
 
 	// Should be able to get both values
-	value1, found1 := newNode.Get(hash1, 0)
-	value2, found2 := newNode.Get(hash2, 0)
+	value1, found1 := newNode.Get("key1", hash1, 0)
+	value2, found2 := newNode.Get("key2", hash2, 0)
 
 	if !found1 || !found2 {
 		t.Error("Expected to find both values after position collision")
@@ -83,7 +83,7 @@ func TestBitmapIndexedNodeSetNoChange(t *testing.T) {
 	newNode := node.Set("key1", 100, hash, 0)
 
 	// Verify the value is still accessible
-	value, found := newNode.Get(hash, 0)
+	value, found := newNode.Get("key1", hash, 0)
 	if !found {
 		t.Error("Expected to find value")
 	}
@@ -105,7 +105,7 @@ func TestBitmapIndexedNodeRemoveEdgeCases(t *testing.T) {
 
 	// Try to remove a non-existent deep value
 	deepHash := uint64(0b000001 | (0b000010 << 6))
-	_, removed := node.Remove(deepHash, 0)
+	_, removed := node.Remove("missing", deepHash, 0)
 
 	if removed {
 		t.Error("Expected removal of non-existent value to fail")
@@ -144,7 +144,7 @@ func TestCompleteHAMTWorkflow(t *testing.T) {
 	// Verify all values are present
 	for key, expectedValue := range testData {
 		hash := Hash(key)
-		value, found := root.Get(hash, 0)
+		value, found := root.Get(key, hash, 0)
 		if !found {
 			t.Errorf("Expected to find key '%s'", key)
 		}
@@ -164,7 +164,7 @@ func TestCompleteHAMTWorkflow(t *testing.T) {
 	// Verify updates
 	for key, originalValue := range testData {
 		hash := Hash(key)
-		value, found := root.Get(hash, 0)
+		value, found := root.Get(key, hash, 0)
 		if !found {
 			t.Errorf("Expected to find key '%s' after update", key)
 		}
@@ -184,7 +184,7 @@ func TestCompleteHAMTWorkflow(t *testing.T) {
 	for _, key := range keysToRemove {
 		hash := Hash(key)
 		var removed bool
-		root, removed = root.Remove(hash, 0)
+		root, removed = root.Remove(key, hash, 0)
 		if !removed {
 			t.Errorf("Expected removal of key '%s' to succeed", key)
 		}
@@ -193,7 +193,7 @@ func TestCompleteHAMTWorkflow(t *testing.T) {
 	// Verify removals
 	for _, key := range keysToRemove {
 		hash := Hash(key)
-		_, found := root.Get(hash, 0)
+		_, found := root.Get(key, hash, 0)
 		if found {
 			t.Errorf("Expected key '%s' to be removed", key)
 		}
@@ -203,7 +203,7 @@ func TestCompleteHAMTWorkflow(t *testing.T) {
 	remainingKeys := []string{"b", "d", "f", "h", "j"}
 	for _, key := range remainingKeys {
 		hash := Hash(key)
-		value, found := root.Get(hash, 0)
+		value, found := root.Get(key, hash, 0)
 		if !found {
 			t.Errorf("Expected key '%s' to still exist", key)
 		}
@@ -27,12 +27,12 @@ func TestBitmapIndexedNodeDeepRecursion(t *testing.T) {
 	node = node.Set("deep2", 200, hash2, 0)
 
 	// Verify both values are accessible
-	value1, found1 := node.Get(hash1, 0)
+	value1, found1 := node.Get("deep1", hash1, 0)
 	if !found1 || value1 != 100 {
 		t.Errorf("Expected to find value 100 for hash1, got %d (found: %v)", value1, found1)
 	}
 
-	value2, found2 := node.Get(hash2, 0)
+	value2, found2 := node.Get("deep2", hash2, 0)
 	if !found2 || value2 != 200 {
 		t.Errorf("Expected to find value 200 for hash2, got %d (found: %v)", value2, found2)
 	}
@@ -56,7 +56,7 @@ func TestBitmapIndexedNodeSetUnchanged(t *testing.T) {
 	newNode := node.Set("unchanged", 100, hash, 0)
 
 	// Even though no change, we should still be able to get the value
-	value, found := newNode.Get(hash, 0)
+	value, found := newNode.Get("unchanged", hash, 0)
 	if !found || value != 100 {
 		t.Errorf("Expected value 100, got %d (found: %v)", value, found)
 	}
@@ -76,24 +76,24 @@ func TestBitmapIndexedNodeRemoveDeepRecursion(t *testing.T) {
 	root = root.Set("remove_deep3", 300, hash3, 0)
 
 	// Remove one value from deep in the tree
-	newRoot, removed := root.Remove(hash2, 0)
+	newRoot, removed := root.Remove("remove_deep2", hash2, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
 
 	// Verify the value is gone
-	_, found := newRoot.Get(hash2, 0)
+	_, found := newRoot.Get("remove_deep2", hash2, 0)
 	if found {
 		t.Error("Expected removed value to be gone")
 	}
 
 	// Verify other values still exist
-	value1, found1 := newRoot.Get(hash1, 0)
+	value1, found1 := newRoot.Get("remove_deep1", hash1, 0)
 	if !found1 || value1 != 100 {
 		t.Error("Expected first value to remain")
 	}
 
-	value3, found3 := newRoot.Get(hash3, 0)
+	value3, found3 := newRoot.Get("remove_deep3", hash3, 0)
 	if !found3 || value3 != 300 {
 		t.Error("Expected third value to remain")
 	}
@@ -111,19 +111,19 @@ func TestBitmapIndexedNodeRemoveUnchanged(t *testing.T) {
 
 	// Try to remove a non-existent value at a deeper level
 	nonExistentHash := Hash("nonexistent_deep")
-	_, removed := root.Remove(nonExistentHash, 0)
+	_, removed := root.Remove("nonexistent_deep", nonExistentHash, 0)
 
 	if removed {
 		t.Error("Expected removal of non-existent value to fail")
 	}
 
 	// Verify original values still exist
-	value1, found1 := root.Get(hash1, 0)
+	value1, found1 := root.Get("remove_unchanged1", hash1, 0)
 	if !found1 || value1 != 100 {
 		t.Error("Expected first value to remain unchanged")
 	}
 
-	value2, found2 := root.Get(hash2, 0)
+	value2, found2 := root.Get("remove_unchanged2", hash2, 0)
 	if !found2 || value2 != 200 {
 		t.Error("Expected second value to remain unchanged")
 	}
@@ -142,7 +142,7 @@ func TestBitmapIndexedNodeRemoveEmptyResult(t *testing.T) {
 	node := NewBitmapIndexedNode(bitmap, []Node[string, int]{leaf})
 
 	// Remove the only value
-	newNode, removed := node.Remove(hash, 0)
+	newNode, removed := node.Remove("only_value", hash, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
@@ -165,23 +165,23 @@ func TestBitmapIndexedNodeReplaceChild(t *testing.T) {
 	root = root.Set("replace3", 300, hash3, 0)
 
 	// Remove one value - this should replace a child in BitmapIndexedNode
-	newRoot, removed := root.Remove(hash2, 0)
+	newRoot, removed := root.Remove("replace2", hash2, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
 
 	// Verify the structure still works
-	value1, found1 := newRoot.Get(hash1, 0)
+	value1, found1 := newRoot.Get("replace1", hash1, 0)
 	if !found1 || value1 != 100 {
 		t.Error("Expected first value to remain")
 	}
 
-	value3, found3 := newRoot.Get(hash3, 0)
+	value3, found3 := newRoot.Get("replace3", hash3, 0)
 	if !found3 || value3 != 300 {
 		t.Error("Expected third value to remain")
 	}
 
-	_, found2 := newRoot.Get(hash2, 0)
+	_, found2 := newRoot.Get("replace2", hash2, 0)
 	if found2 {
 		t.Error("Expected removed value to be gone")
 	}
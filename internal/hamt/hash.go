@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"hash"
 	"hash/fnv"
+	"math"
 	"reflect"
 	"time"
 )
@@ -15,8 +16,13 @@ type Hashable interface {
 }
 
 // Hash returns the hash value of an arbitrary value using FNV-1a algorithm.
-// This function uses reflection to handle any Go type.
+// Strings, booleans and fixed-size numbers are hashed without reflection or allocation;
+// every other type is handled through reflection. Both paths produce identical hashes.
 func Hash(value any) uint64 {
+	if result, ok := hashPrimitive(value); ok {
+		return result
+	}
+
 	hasher := fnv.New64a()
 	hashResult, _ := hashValue(hasher, reflect.ValueOf(value))
 	return hashResult
@@ -24,6 +30,69 @@ func Hash(value any) uint64 {
 
 var timeType = reflect.TypeOf(time.Time{})
 
+const (
+	fnvOffset64 uint64 = 14695981039346656037
+	fnvPrime64  uint64 = 1099511628211
+)
+
+// hashPrimitive mirrors hashValue for unnamed primitive types using an inlined FNV-1a.
+func hashPrimitive(value any) (uint64, bool) {
+	switch typed := value.(type) {
+	case string:
+		return fnvString(typed), true
+	case int:
+		return fnvFixed(uint64(typed), 8), true
+	case int64:
+		return fnvFixed(uint64(typed), 8), true
+	case int32:
+		return fnvFixed(uint64(typed), 4), true
+	case int16:
+		return fnvFixed(uint64(typed), 2), true
+	case int8:
+		return fnvFixed(uint64(typed), 1), true
+	case uint:
+		return fnvFixed(uint64(typed), 8), true
+	case uint64:
+		return fnvFixed(typed, 8), true
+	case uint32:
+		return fnvFixed(uint64(typed), 4), true
+	case uint16:
+		return fnvFixed(uint64(typed), 2), true
+	case uint8:
+		return fnvFixed(uint64(typed), 1), true
+	case float64:
+		return fnvFixed(math.Float64bits(typed), 8), true
+	case float32:
+		return fnvFixed(uint64(math.Float32bits(typed)), 4), true
+	case bool:
+		if typed {
+			return fnvFixed(1, 1), true
+		}
+		return fnvFixed(0, 1), true
+	default:
+		return 0, false
+	}
+}
+
+func fnvString(value string) uint64 {
+	result := fnvOffset64
+	for index := 0; index < len(value); index++ {
+		result ^= uint64(value[index])
+		result *= fnvPrime64
+	}
+	return result
+}
+
+// fnvFixed hashes the lowest size bytes of value in little-endian order, as binary.Write does.
+func fnvFixed(value uint64, size int) uint64 {
+	result := fnvOffset64
+	for index := range size {
+		result ^= (value >> (8 * index)) & 0xFF
+		result *= fnvPrime64
+	}
+	return result
+}
+
 // unwrapValue removes interface and pointer wrapping from a reflect.Value.
 func unwrapValue(value reflect.Value) reflect.Value {
 	for {
@@ -2,6 +2,7 @@ package hamt
 
 import (
 	"hash/fnv"
+	"math"
 	"reflect"
 	"testing"
 	"time"
@@ -760,3 +761,55 @@ func TestHashValue_EmptyCollections(t *testing.T) {
 		})
 	}
 }
+
+func TestHash_PrimitiveFastPathMatchesReflection(t *testing.T) {
+	type named string
+
+	values := []any{
+		"", "hello", "日本語",
+		0, 1, -1, int(math.MaxInt64),
+		int8(-3), int16(300), int32(-70000), int64(1 << 40),
+		uint(7), uint8(255), uint16(65535), uint32(1 << 31), uint64(math.MaxUint64),
+		float32(1.5), 3.25, math.Inf(-1),
+		true, false,
+	}
+
+	for _, value := range values {
+		fast, ok := hashPrimitive(value)
+		if !ok {
+			t.Fatalf("expected fast path for %T", value)
+		}
+		reflective, err := hashValue(fnv.New64a(), reflect.ValueOf(value))
+		if err != nil {
+			t.Fatalf("unexpected error for %T: %v", value, err)
+		}
+		if fast != reflective {
+			t.Errorf("hash mismatch for %T(%v): fast %d, reflective %d", value, value, fast, reflective)
+		}
+	}
+
+	if Hash(named("value")) != Hash("value") {
+		t.Error("named string should hash like its underlying string")
+	}
+}
+
+func TestHash_PrimitiveFastPathDoesNotAllocate(t *testing.T) {
+	seed := int64(0)
+	allocations := testing.AllocsPerRun(100, func() {
+		seed++
+		_ = Hash(seed)
+	})
+	if allocations != 0 {
+		t.Errorf("expected no allocations, got %v", allocations)
+	}
+}
+
+// BenchmarkHash_Int64 benchmarks int64 hashing as used by the builder seed set.
+func BenchmarkHash_Int64(b *testing.B) {
+	var value int64 = 1<<53 - 1
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Hash(value)
+	}
+}
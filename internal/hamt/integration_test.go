@@ -18,8 +18,8 @@ func TestBitmapIndexedNodeDeepNesting(t *testing.T) {
 	root = root.Set("test2", 200, hash2, 0)
 
 	// Both values should be accessible
-	value1, found1 := root.Get(hash1, 0)
-	value2, found2 := root.Get(hash2, 0)
+	value1, found1 := root.Get("test1", hash1, 0)
+	value2, found2 := root.Get("test2", hash2, 0)
 
 	if !found1 || !found2 {
 		t.Error("Expected to find both values")
@@ -41,13 +41,13 @@ func TestBitmapIndexedNodeRemoveDeep(t *testing.T) {
 	root = root.Set("remove2", 200, hash2, 0)
 
 	// Remove one value
-	newNode, removed := root.Remove(hash2, 0)
+	newNode, removed := root.Remove("remove2", hash2, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
 
 	// First value should still be accessible
-	value1, found1 := newNode.Get(hash1, 0)
+	value1, found1 := newNode.Get("remove1", hash1, 0)
 	if !found1 {
 		t.Error("Expected to find first value")
 	}
@@ -56,7 +56,7 @@ func TestBitmapIndexedNodeRemoveDeep(t *testing.T) {
 	}
 
 	// Second value should not be accessible
-	_, found2 := newNode.Get(hash2, 0)
+	_, found2 := newNode.Get("remove2", hash2, 0)
 	if found2 {
 		t.Error("Expected not to find removed value")
 	}
@@ -86,7 +86,7 @@ func TestComplexTreeOperations(t *testing.T) {
 
 	// Verify all values are accessible
 	for hash, expectedValue := range values {
-		value, found := root.Get(hash, 0)
+		value, found := root.Get(int(hash), hash, 0)
 		if !found {
 			t.Errorf("Expected to find value for hash %b", hash)
 		}
@@ -96,19 +96,19 @@ func TestComplexTreeOperations(t *testing.T) {
 	}
 
 	// Remove some values
-	root, removed := root.Remove(0b000001, 0)
+	root, removed := root.Remove(0b000001, 0b000001, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
 
 	// Verify removed value is gone
-	_, found := root.Get(0b000001, 0)
+	_, found := root.Get(0b000001, 0b000001, 0)
 	if found {
 		t.Error("Expected removed value to be gone")
 	}
 
 	// Verify other values still accessible
-	value, found := root.Get(0b000010, 0)
+	value, found := root.Get(0b000010, 0b000010, 0)
 	if !found {
 		t.Error("Expected to find remaining value")
 	}
@@ -118,38 +118,42 @@ func TestComplexTreeOperations(t *testing.T) {
 }
 
 func TestHashCollisionInTree(t *testing.T) {
-	// Force hash collisions by using custom hash values
-	// This tests CollisionNode integration
+	// Distinct keys sharing a hash end up in one CollisionNode below the root
 	hash := uint64(12345)
+	other := uint64(67890)
 
-	// Add another value with the same hash
-	// Since we can't control Hash() function, we'll manually create collision scenario
-	// by building the structure ourselves
+	var root Node[string, int] = NewLeafNode(other, "other", 1)
+	root = root.Set("key1", 100, hash, 0)
+	root = root.Set("key2", 200, hash, 0)
+	root = root.Set("key3", 300, hash, 0)
 
-	entry1 := Entry[string, int]{Key: "key1", Value: 100}
-	entry2 := Entry[string, int]{Key: "key2", Value: 200}
+	for key, expected := range map[string]int{"key1": 100, "key2": 200, "key3": 300, "other": 1} {
+		hashOf := hash
+		if key == "other" {
+			hashOf = other
+		}
+		if value, found := root.Get(key, hashOf, 0); !found || value != expected {
+			t.Errorf("Expected %s to hold %d, got %d, %v", key, expected, value, found)
+		}
+	}
 
-	collision := NewCollisionNode(hash, []Entry[string, int]{entry1, entry2})
+	if _, found := root.Get("key4", hash, 0); found {
+		t.Error("Expected an absent key sharing the hash not to be found")
+	}
 
-	// Test collision node operations
-	value, found := collision.Get(hash, 0)
-	if !found {
-		t.Error("Expected to find value in collision node")
+	root, removed := root.Remove("key2", hash, 0)
+	if !removed {
+		t.Fatal("Expected removal of key2 to succeed")
 	}
-	if value != 100 {
-		t.Errorf("Expected value 100, got %d", value)
+	if _, found := root.Get("key2", hash, 0); found {
+		t.Error("Expected key2 to be removed")
 	}
-
-	// Add more to collision
-	newCollision := collision.Set("key3", 300, hash, 0)
-
-	collisionPtr, ok := newCollision.(*CollisionNode[string, int])
-	if !ok {
-		t.Fatal("Expected CollisionNode")
+	if value, found := root.Get("key3", hash, 0); !found || value != 300 {
+		t.Errorf("Expected key3 to survive the removal, got %d, %v", value, found)
 	}
 
-	if len(collisionPtr.entries) != 3 {
-		t.Errorf("Expected 3 entries, got %d", len(collisionPtr.entries))
+	if size := len(root.ToSlice()); size != 3 {
+		t.Errorf("Expected 3 entries, got %d", size)
 	}
 }
 
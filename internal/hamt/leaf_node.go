@@ -23,8 +23,8 @@ func (leaf *LeafNode[K, V]) Value() V {
 	return leaf.value
 }
 
-func (leaf *LeafNode[K, V]) Get(hash uint64, offset int) (V, bool) {
-	if leaf.hash == hash {
+func (leaf *LeafNode[K, V]) Get(key K, hash uint64, offset int) (V, bool) {
+	if leaf.hash == hash && sameKey(leaf.key, key) {
 		return leaf.value, true
 	}
 
@@ -35,8 +35,16 @@ func (leaf *LeafNode[K, V]) Get(hash uint64, offset int) (V, bool) {
 
 func (leaf *LeafNode[K, V]) Set(key K, value V, hash uint64, offset int) Node[K, V] {
 	if leaf.hash == hash {
-		// Same hash - update the value
-		return NewLeafNode(hash, key, value)
+		if sameKey(leaf.key, key) {
+			// Same key - update the value
+			return NewLeafNode(hash, key, value)
+		}
+
+		// Same hash, different key - keep both in a collision bucket
+		return NewCollisionNode(hash, []Entry[K, V]{
+			{Key: leaf.key, Value: leaf.value},
+			{Key: key, Value: value},
+		})
 	}
 
 	// Different hashes - create a BitmapIndexedNode
@@ -62,8 +70,8 @@ func (leaf *LeafNode[K, V]) Set(key K, value V, hash uint64, offset int) Node[K,
 	return bitmapNode.Set(key, value, hash, offset)
 }
 
-func (leaf *LeafNode[K, V]) Remove(hash uint64, offset int) (Node[K, V], bool) {
-	if leaf.hash == hash {
+func (leaf *LeafNode[K, V]) Remove(key K, hash uint64, offset int) (Node[K, V], bool) {
+	if leaf.hash == hash && sameKey(leaf.key, key) {
 		return nil, true
 	}
 
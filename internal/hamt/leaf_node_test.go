@@ -24,7 +24,7 @@ func TestLeafNodeGet(t *testing.T) {
 	node := NewLeafNode(hash, "apple", 100)
 
 	// Get with matching hash
-	value, found := node.Get(hash, 0)
+	value, found := node.Get("apple", hash, 0)
 	if !found {
 		t.Error("Expected to find value with matching hash")
 	}
@@ -33,10 +33,16 @@ func TestLeafNodeGet(t *testing.T) {
 	}
 
 	// Get with non-matching hash
-	_, found = node.Get(99999, 0)
+	_, found = node.Get("apple", 99999, 0)
 	if found {
 		t.Error("Expected not to find value with non-matching hash")
 	}
+
+	// Get another key sharing the hash
+	_, found = node.Get("banana", hash, 0)
+	if found {
+		t.Error("Expected not to find value with non-matching key")
+	}
 }
 
 func TestLeafNodeSetSameHash(t *testing.T) {
@@ -49,7 +55,7 @@ func TestLeafNodeSetSameHash(t *testing.T) {
 		t.Fatal("Expected non-nil node")
 	}
 
-	value, found := newNode.Get(hash, 0)
+	value, found := newNode.Get("key1", hash, 0)
 	if !found {
 		t.Error("Expected to find updated value")
 	}
@@ -58,6 +64,24 @@ func TestLeafNodeSetSameHash(t *testing.T) {
 	}
 }
 
+func TestLeafNodeSetSameHashDifferentKey(t *testing.T) {
+	hash := uint64(12345)
+	node := NewLeafNode(hash, "key1", 100)
+
+	// Set with same hash but another key keeps both entries
+	newNode := node.Set("key2", 200, hash, 0)
+
+	if _, ok := newNode.(*CollisionNode[string, int]); !ok {
+		t.Fatalf("Expected result to be CollisionNode, got %T", newNode)
+	}
+
+	value1, found1 := newNode.Get("key1", hash, 0)
+	value2, found2 := newNode.Get("key2", hash, 0)
+	if !found1 || !found2 || value1 != 100 || value2 != 200 {
+		t.Errorf("Expected both keys to keep their values, got %d, %v and %d, %v", value1, found1, value2, found2)
+	}
+}
+
 func TestLeafNodeSetDifferentHash(t *testing.T) {
 	hash1 := uint64(12345)
 	hash2 := uint64(67890)
@@ -71,8 +95,8 @@ func TestLeafNodeSetDifferentHash(t *testing.T) {
 	}
 
 	// Both values should be accessible
-	value1, found1 := newNode.Get(hash1, 0)
-	value2, found2 := newNode.Get(hash2, 0)
+	value1, found1 := newNode.Get("key1", hash1, 0)
+	value2, found2 := newNode.Get("key2", hash2, 0)
 
 	if !found1 {
 		t.Error("Expected to find first value")
@@ -94,7 +118,7 @@ func TestLeafNodeRemove(t *testing.T) {
 	node := NewLeafNode(hash, "key", 42)
 
 	// Remove with matching hash
-	newNode, removed := node.Remove(hash, 0)
+	newNode, removed := node.Remove("key", hash, 0)
 	if !removed {
 		t.Error("Expected removal to succeed")
 	}
@@ -103,13 +127,22 @@ func TestLeafNodeRemove(t *testing.T) {
 	}
 
 	// Remove with non-matching hash
-	newNode, removed = node.Remove(99999, 0)
+	newNode, removed = node.Remove("key", 99999, 0)
 	if removed {
 		t.Error("Expected removal to fail with non-matching hash")
 	}
 	if newNode != node {
 		t.Error("Expected node to be unchanged")
 	}
+
+	// Remove another key sharing the hash
+	newNode, removed = node.Remove("other", hash, 0)
+	if removed {
+		t.Error("Expected removal to fail with non-matching key")
+	}
+	if newNode != node {
+		t.Error("Expected node to be unchanged")
+	}
 }
 
 func TestLeafNodeToSlice(t *testing.T) {
@@ -0,0 +1,151 @@
+package hamt
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+)
+
+// Property-based harness: random operation sequences are applied both to a HAMT and to a
+// reference map, and the two are compared after every step. The hash functions below differ in
+// how much of the trie they exercise; colliding maps many keys to each hash, so entries must be
+// told apart by key.
+
+type operationKind uint8
+
+const (
+	operationSet operationKind = iota
+	operationRemove
+)
+
+type operation struct {
+	kind  operationKind
+	key   uint16
+	value int
+}
+
+var propertyHashes = map[string]func(key uint16) uint64{
+	// full spreads keys over the whole trie.
+	"full": func(key uint16) uint64 { return Hash(key) },
+	// sharedPrefix keeps the lowest 24 bits equal, forcing splits four levels deep.
+	"sharedPrefix": func(key uint16) uint64 { return uint64(key)<<24 | 0xABCDEF },
+	// dense packs keys into consecutive values, filling whole bitmaps at the first levels.
+	"dense": func(key uint16) uint64 { return uint64(key) },
+	// colliding gives eight keys each hash, filling CollisionNodes.
+	"colliding": func(key uint16) uint64 { return uint64(key % 8) },
+}
+
+func randomOperations(random *rand.Rand, count int, keySpace int) []operation {
+	operations := make([]operation, count)
+	for index := range operations {
+		kind := operationSet
+		if random.Intn(3) == 0 {
+			kind = operationRemove
+		}
+		operations[index] = operation{
+			kind: kind,
+			//nolint:gosec // G115: keySpace never exceeds the uint16 range
+			key:   uint16(random.Intn(keySpace)),
+			value: random.Int(),
+		}
+	}
+	return operations
+}
+
+// checkOperations applies operations and reports the first divergence from the reference map.
+func checkOperations(t *testing.T, operations []operation, hashOf func(uint16) uint64) {
+	t.Helper()
+
+	var root Node[uint16, int]
+	reference := make(map[uint16]int)
+
+	for step, op := range operations {
+		hash := hashOf(op.key)
+
+		switch op.kind {
+		case operationSet:
+			if root == nil {
+				root = NewLeafNode(hash, op.key, op.value)
+			} else {
+				root = root.Set(op.key, op.value, hash, 0)
+			}
+			reference[op.key] = op.value
+		case operationRemove:
+			_, expected := reference[op.key]
+			removed := false
+			if root != nil {
+				root, removed = root.Remove(op.key, hash, 0)
+			}
+			if removed != expected {
+				t.Fatalf("step %d: Remove(%d) reported %v, reference %v", step, op.key, removed, expected)
+			}
+			delete(reference, op.key)
+		}
+
+		compareWithReference(t, step, root, reference, hashOf)
+	}
+}
+
+func compareWithReference(t *testing.T, step int, root Node[uint16, int], reference map[uint16]int, hashOf func(uint16) uint64) {
+	t.Helper()
+
+	if root == nil {
+		if len(reference) != 0 {
+			t.Fatalf("step %d: empty trie, reference holds %d entries", step, len(reference))
+		}
+		return
+	}
+
+	for key, expected := range reference {
+		if value, found := root.Get(key, hashOf(key), 0); !found || value != expected {
+			t.Fatalf("step %d: Get(%d) = %d, %v; reference %d", step, key, value, found, expected)
+		}
+	}
+
+	entries := root.ToSlice()
+	if len(entries) != len(reference) {
+		t.Fatalf("step %d: ToSlice holds %d entries, reference %d", step, len(entries), len(reference))
+	}
+	for _, entry := range entries {
+		if expected, ok := reference[entry.Key]; !ok || expected != entry.Value {
+			t.Fatalf("step %d: ToSlice entry %d = %d not in reference", step, entry.Key, entry.Value)
+		}
+	}
+}
+
+func TestNodeMatchesReferenceMap(t *testing.T) {
+	for name, hashOf := range propertyHashes {
+		t.Run(name, func(t *testing.T) {
+			for run := range 20 {
+				random := rand.New(rand.NewSource(int64(run)))
+				checkOperations(t, randomOperations(random, 400, 64+run*16), hashOf)
+			}
+		})
+	}
+}
+
+// maxFuzzOperations bounds each fuzz input, as every step compares the whole trie.
+const maxFuzzOperations = 256
+
+// FuzzNodeOperations decodes the input as a sequence of (kind, key) triples of one kind byte and
+// a big-endian key.
+func FuzzNodeOperations(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 1, 1, 0, 65, 1, 2})
+	f.Add([]byte{0, 0, 0, 64, 0, 128, 1, 64, 0, 192, 1, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		operations := make([]operation, 0, len(data)/3)
+		for len(data) >= 3 && len(operations) < maxFuzzOperations {
+			operations = append(operations, operation{
+				kind:  operationKind(data[0] % 2),
+				key:   binary.BigEndian.Uint16(data[1:3]),
+				value: len(operations),
+			})
+			data = data[3:]
+		}
+
+		for _, hashOf := range propertyHashes {
+			checkOperations(t, operations, hashOf)
+		}
+	})
+}
@@ -63,3 +63,18 @@ func Scramble(original uint32) uint32 {
 
 	return asUint32(uint64(inverted) * uint64(invertedSalt))
 }
+
+const (
+	mixIncrement uint64 = 0x9E3779B97F4A7C15
+	mixMultiply1 uint64 = 0xBF58476D1CE4E5B9
+	mixMultiply2 uint64 = 0x94D049BB133111EB
+)
+
+// Mix64 scrambles original with the SplitMix64 finalizer, spreading entropy over all 64 bits.
+func Mix64(original uint64) uint64 {
+	mixed := original + mixIncrement
+	mixed = (mixed ^ (mixed >> 30)) * mixMultiply1
+	mixed = (mixed ^ (mixed >> 27)) * mixMultiply2
+
+	return mixed ^ (mixed >> 31)
+}
@@ -213,3 +213,17 @@ func TestExtendedGCDSymmetry(t *testing.T) {
 			a, b, gcd1, b, a, gcd2)
 	}
 }
+
+func TestMix64(t *testing.T) {
+	if Mix64(0) != 0xE220A8397B1DCDAF {
+		t.Errorf("Mix64(0) = 0x%X, expected 0xE220A8397B1DCDAF", Mix64(0))
+	}
+
+	if Mix64(1) == Mix64(2) {
+		t.Error("Mix64 should produce different outputs for different inputs")
+	}
+
+	if Mix64(12345) != Mix64(12345) {
+		t.Error("Mix64 should be deterministic")
+	}
+}
@@ -0,0 +1,82 @@
+// Package intervalset provides a set of int64 values stored as disjoint intervals, so runs of
+// consecutive values take constant memory. Builders record the consecutive seed runs of
+// BulkAllocation in it.
+package intervalset
+
+import (
+	"math"
+	"sort"
+)
+
+// interval is the closed range [low, high].
+type interval struct {
+	low  int64
+	high int64
+}
+
+// Set holds int64 values as sorted, non-overlapping and non-adjacent intervals. Adding n
+// consecutive values costs one interval; arbitrary values cost one interval each, and inserting a
+// new interval is O(k) for k intervals, so scattered values are better kept in a map. Lookups are
+// O(log k). The zero value is an empty set ready to use; a Set is not safe for
+// concurrent use.
+type Set struct {
+	intervals []interval
+	size      uint64
+}
+
+// New returns an empty Set.
+func New() *Set {
+	return &Set{}
+}
+
+// Add inserts value and reports whether it was absent.
+func (s *Set) Add(value int64) bool {
+	index := s.search(value)
+	if index < len(s.intervals) && s.intervals[index].low <= value {
+		return false
+	}
+
+	s.size++
+
+	joinsPrevious := index > 0 && s.intervals[index-1].high == value-1 && value != math.MinInt64
+	joinsNext := index < len(s.intervals) && s.intervals[index].low == value+1 && value != math.MaxInt64
+
+	switch {
+	case joinsPrevious && joinsNext:
+		s.intervals[index-1].high = s.intervals[index].high
+		s.intervals = append(s.intervals[:index], s.intervals[index+1:]...)
+	case joinsPrevious:
+		s.intervals[index-1].high = value
+	case joinsNext:
+		s.intervals[index].low = value
+	default:
+		s.intervals = append(s.intervals, interval{})
+		copy(s.intervals[index+1:], s.intervals[index:])
+		s.intervals[index] = interval{low: value, high: value}
+	}
+
+	return true
+}
+
+// Has reports whether value is in the set.
+func (s *Set) Has(value int64) bool {
+	index := s.search(value)
+	return index < len(s.intervals) && s.intervals[index].low <= value
+}
+
+// Len returns the number of values in the set.
+func (s *Set) Len() uint64 {
+	return s.size
+}
+
+// Intervals returns the number of disjoint intervals backing the set, a measure of its memory use.
+func (s *Set) Intervals() int {
+	return len(s.intervals)
+}
+
+// search returns the index of the first interval whose high end is at least value.
+func (s *Set) search(value int64) int {
+	return sort.Search(len(s.intervals), func(index int) bool {
+		return s.intervals[index].high >= value
+	})
+}
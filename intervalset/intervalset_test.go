@@ -0,0 +1,91 @@
+package intervalset
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestAddRejectsRepeats(t *testing.T) {
+	set := New()
+
+	if !set.Add(5) || set.Add(5) {
+		t.Fatal("expected the first Add to succeed and the repeat to fail")
+	}
+	if !set.Has(5) || set.Has(4) || set.Has(6) {
+		t.Fatal("unexpected membership")
+	}
+	if set.Len() != 1 {
+		t.Fatalf("expected one value, got %d", set.Len())
+	}
+}
+
+func TestSequentialValuesUseOneInterval(t *testing.T) {
+	var set Set
+
+	for value := range int64(10_000) {
+		set.Add(value)
+	}
+	for value := int64(-1); value > -100; value-- {
+		set.Add(value)
+	}
+
+	if set.Intervals() != 1 || set.Len() != 10_099 {
+		t.Fatalf("expected a single interval of 10099 values, got %d intervals and %d values", set.Intervals(), set.Len())
+	}
+}
+
+func TestAddMergesNeighbours(t *testing.T) {
+	set := New()
+	set.Add(1)
+	set.Add(3)
+	set.Add(5)
+
+	if set.Intervals() != 3 {
+		t.Fatalf("expected three intervals, got %d", set.Intervals())
+	}
+
+	set.Add(2)
+	set.Add(4)
+
+	if set.Intervals() != 1 || !set.Has(1) || !set.Has(5) || set.Has(6) {
+		t.Fatalf("expected one merged interval, got %d", set.Intervals())
+	}
+}
+
+func TestAddAtIntegerLimits(t *testing.T) {
+	set := New()
+
+	for _, value := range []int64{math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, math.MinInt64 + 1} {
+		if !set.Add(value) {
+			t.Fatalf("expected %d to be added", value)
+		}
+	}
+
+	if set.Intervals() != 2 || set.Has(0) || !set.Has(math.MinInt64) || !set.Has(math.MaxInt64) {
+		t.Fatalf("unexpected set at limits: %d intervals", set.Intervals())
+	}
+}
+
+func TestSetMatchesReferenceMap(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	set := New()
+	reference := make(map[int64]bool)
+
+	for range 5_000 {
+		value := random.Int63n(500) - 250
+		if added := set.Add(value); added == reference[value] {
+			t.Fatalf("Add(%d) = %v with reference membership %v", value, added, reference[value])
+		}
+		reference[value] = true
+	}
+
+	for value := int64(-260); value < 260; value++ {
+		if set.Has(value) != reference[value] {
+			t.Fatalf("Has(%d) = %v, reference %v", value, set.Has(value), reference[value])
+		}
+	}
+	if set.Len() != uint64(len(reference)) {
+		t.Fatalf("expected %d values, got %d", len(reference), set.Len())
+	}
+}
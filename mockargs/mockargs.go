@@ -0,0 +1,89 @@
+// Package mockargs fills method parameters and results of mocked interfaces
+// (gomock, mockery) with values produced by registered forge factories.
+package mockargs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+var contextType = reflect.TypeFor[context.Context]()
+
+// Registry maps types to the factories that produce them.
+type Registry struct {
+	providers map[reflect.Type]func(seed int64) any
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[reflect.Type]func(seed int64) any),
+	}
+}
+
+// Register makes values of type T available to the registry through f.
+func Register[T any, P any](registry *Registry, f factory.Factory[T, P]) {
+	builder := factory.Builder(f)
+	registry.providers[reflect.TypeFor[T]()] = func(seed int64) any {
+		return builder.BuildWith(seed, nil)
+	}
+}
+
+// Args returns one value per parameter of method on interface I.
+// Parameter i is built with seed+i; context.Context parameters receive context.Background().
+func Args[I any](registry *Registry, method string, seed int64) []any {
+	methodType := lookupMethod[I](method)
+	return registry.values(methodType.In, methodType.NumIn(), seed)
+}
+
+// Returns returns one value per result of method on interface I.
+// Result i is built with seed+i; error results are nil unless an error factory is registered.
+func Returns[I any](registry *Registry, method string, seed int64) []any {
+	methodType := lookupMethod[I](method)
+	return registry.values(methodType.Out, methodType.NumOut(), seed)
+}
+
+// Values builds one value per type, using seed+i for the i-th type.
+// Types without a registered factory receive their zero value.
+func (r *Registry) Values(types []reflect.Type, seed int64) []any {
+	return r.values(func(i int) reflect.Type { return types[i] }, len(types), seed)
+}
+
+func (r *Registry) values(typeAt func(int) reflect.Type, count int, seed int64) []any {
+	values := make([]any, count)
+
+	for i := range count {
+		values[i] = r.value(typeAt(i), seed+int64(i))
+	}
+
+	return values
+}
+
+func (r *Registry) value(typ reflect.Type, seed int64) any {
+	if provider, ok := r.providers[typ]; ok {
+		return provider(seed)
+	}
+
+	if typ == contextType {
+		return context.Background()
+	}
+
+	return reflect.Zero(typ).Interface()
+}
+
+func lookupMethod[I any](name string) reflect.Type {
+	typ := reflect.TypeFor[I]()
+	if typ.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("mockargs: %s is not an interface", typ))
+	}
+
+	method, ok := typ.MethodByName(name)
+	if !ok {
+		panic(fmt.Sprintf("mockargs: %s has no method %q", typ, name))
+	}
+
+	return method.Type
+}
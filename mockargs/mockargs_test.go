@@ -0,0 +1,74 @@
+package mockargs
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+type Status string
+
+type repository interface {
+	Save(ctx context.Context, name string, status Status, retries int) (string, error)
+}
+
+func newRegistry() *Registry {
+	registry := NewRegistry()
+	Register(registry, &factory.StringFactory{})
+	Register(registry, factory.NewEnumFactory([]Status{"active", "inactive"}))
+	return registry
+}
+
+func TestArgsFillsParameters(t *testing.T) {
+	args := Args[repository](newRegistry(), "Save", 10)
+
+	if len(args) != 4 {
+		t.Fatalf("expected 4 args, got %d", len(args))
+	}
+	if args[0] != context.Background() {
+		t.Fatalf("expected background context, got %v", args[0])
+	}
+	if name, ok := args[1].(string); !ok || name == "" {
+		t.Fatalf("expected generated name, got %#v", args[1])
+	}
+	if status, ok := args[2].(Status); !ok || (status != "active" && status != "inactive") {
+		t.Fatalf("expected enum status, got %#v", args[2])
+	}
+	if args[3] != 0 {
+		t.Fatalf("expected zero value for unregistered type, got %#v", args[3])
+	}
+}
+
+func TestArgsAreDeterministic(t *testing.T) {
+	registry := newRegistry()
+
+	if !reflect.DeepEqual(Args[repository](registry, "Save", 3), Args[repository](registry, "Save", 3)) {
+		t.Fatal("expected identical args for the same seed")
+	}
+}
+
+func TestReturnsFillsResults(t *testing.T) {
+	results := Returns[repository](newRegistry(), "Save", 1)
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if id, ok := results[0].(string); !ok || id == "" {
+		t.Fatalf("expected generated id, got %#v", results[0])
+	}
+	if results[1] != nil {
+		t.Fatalf("expected nil error, got %#v", results[1])
+	}
+}
+
+func TestArgsPanicsForUnknownMethod(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic for unknown method")
+		}
+	}()
+
+	Args[repository](newRegistry(), "Delete", 0)
+}
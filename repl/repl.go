@@ -0,0 +1,174 @@
+// Package repl implements an interactive playground for factories registered through
+// factory.RegisterProvider. Build a binary that imports the packages registering your providers
+// and calls Run, or use cmd/forge-repl for the built-in factories.
+package repl
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+const help = `commands:
+  list                 show registered providers
+  use <provider>       select a provider
+  set <key> <value>    set an override; value is JSON, or a plain string
+  unset <key>          remove an override
+  reset                remove every override
+  seed <n>             set the seed of the next build
+  build [count]        build instances and print them as JSON
+  help                 show this help
+  quit                 leave the playground
+`
+
+// Session holds the state of a playground: the selected provider, its overrides and the next seed.
+// Every build advances the seed past the instances it produced, so a session replays identically.
+type Session struct {
+	provider  factory.Provider
+	selected  bool
+	overrides map[string]any
+	seed      int64
+}
+
+// NewSession creates a session with no provider selected, starting at seed 1.
+func NewSession() *Session {
+	return &Session{
+		overrides: make(map[string]any),
+		seed:      1,
+	}
+}
+
+// Run reads commands from in until EOF or quit, writing results and errors to out.
+func Run(in io.Reader, out io.Writer) error {
+	session := NewSession()
+	scanner := bufio.NewScanner(in)
+
+	fmt.Fprint(out, "forge> ")
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "quit" || line == "exit" {
+			return nil
+		}
+
+		if line != "" {
+			output, err := session.Execute(line)
+			if err != nil {
+				fmt.Fprintf(out, "error: %v\n", err)
+			} else if output != "" {
+				fmt.Fprintln(out, output)
+			}
+		}
+		fmt.Fprint(out, "forge> ")
+	}
+
+	return scanner.Err()
+}
+
+// Execute runs one command line and returns its output.
+func (s *Session) Execute(line string) (string, error) {
+	command, argument, _ := strings.Cut(strings.TrimSpace(line), " ")
+	argument = strings.TrimSpace(argument)
+
+	switch command {
+	case "help":
+		return help, nil
+	case "list":
+		return strings.Join(factory.Providers(), "\n"), nil
+	case "use":
+		provider, ok := factory.LookupProvider(argument)
+		if !ok {
+			return "", fmt.Errorf("unknown provider %q", argument)
+		}
+		s.provider, s.selected = provider, true
+		clear(s.overrides)
+		return "using " + argument, nil
+	case "set":
+		key, raw, ok := strings.Cut(argument, " ")
+		if !ok || key == "" {
+			return "", fmt.Errorf("usage: set <key> <value>")
+		}
+		s.overrides[key] = parseValue(strings.TrimSpace(raw))
+		return s.describeOverrides(), nil
+	case "unset":
+		delete(s.overrides, argument)
+		return s.describeOverrides(), nil
+	case "reset":
+		clear(s.overrides)
+		return s.describeOverrides(), nil
+	case "seed":
+		seed, err := strconv.ParseInt(argument, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid seed %q", argument)
+		}
+		s.seed = seed
+		return "", nil
+	case "build":
+		return s.build(argument)
+	default:
+		return "", fmt.Errorf("unknown command %q, try help", command)
+	}
+}
+
+func (s *Session) build(argument string) (output string, err error) {
+	if !s.selected {
+		return "", fmt.Errorf("no provider selected, try use <provider>")
+	}
+
+	count := 1
+	if argument != "" {
+		count, err = strconv.Atoi(argument)
+		if err != nil || count <= 0 {
+			return "", fmt.Errorf("invalid count %q", argument)
+		}
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%v", recovered)
+		}
+	}()
+
+	instances := s.provider.BuildListWith(count, s.seed, s.overrides)
+	s.seed += int64(count)
+
+	var encoded []byte
+	if count == 1 {
+		encoded, err = json.MarshalIndent(instances[0], "", "  ")
+	} else {
+		encoded, err = json.MarshalIndent(instances, "", "  ")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func (s *Session) describeOverrides() string {
+	if len(s.overrides) == 0 {
+		return "no overrides"
+	}
+
+	lines := make([]string, 0, len(s.overrides))
+	for _, key := range slices.Sorted(maps.Keys(s.overrides)) {
+		encoded, _ := json.Marshal(s.overrides[key])
+		lines = append(lines, key+" = "+string(encoded))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseValue decodes raw as JSON, falling back to the raw string.
+func parseValue(raw string) any {
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return raw
+	}
+	return value
+}
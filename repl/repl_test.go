@@ -0,0 +1,83 @@
+package repl
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+func init() {
+	factory.RegisterProvider("repl.test.int", func() factory.Factory[int, factory.IntProperties] {
+		return &factory.IntFactory{Min: 1, Max: 100}
+	})
+}
+
+func TestSessionBuildsSelectedProvider(t *testing.T) {
+	session := NewSession()
+
+	if _, err := session.Execute("build"); err == nil {
+		t.Fatal("expected an error before a provider is selected")
+	}
+	if _, err := session.Execute("use repl.test.int"); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := session.Execute("build 3")
+	if err != nil || !strings.HasPrefix(first, "[") {
+		t.Fatalf("expected a JSON array, got %q: %v", first, err)
+	}
+
+	second, _ := session.Execute("build 3")
+	if first == second {
+		t.Fatal("expected the seed to advance between builds")
+	}
+
+	if _, err := session.Execute("seed 1"); err != nil {
+		t.Fatal(err)
+	}
+	if replayed, _ := session.Execute("build 3"); replayed != first {
+		t.Fatalf("expected seed 1 to replay %q, got %q", first, replayed)
+	}
+}
+
+func TestSessionOverrides(t *testing.T) {
+	session := NewSession()
+	_, _ = session.Execute("use repl.test.int")
+
+	if output, err := session.Execute("set value 7"); err != nil || output != "value = 7" {
+		t.Fatalf("unexpected set output %q: %v", output, err)
+	}
+	if output, _ := session.Execute("build"); output != "7" {
+		t.Fatalf("expected the override to apply, got %q", output)
+	}
+
+	if output, _ := session.Execute("set missing 1"); !strings.Contains(output, "missing") {
+		t.Fatalf("expected the override list, got %q", output)
+	}
+	if _, err := session.Execute("build"); err == nil {
+		t.Fatal("expected an unknown override key to be reported as an error")
+	}
+
+	if output, _ := session.Execute("reset"); output != "no overrides" {
+		t.Fatalf("unexpected reset output %q", output)
+	}
+}
+
+func TestRunReadsCommandsUntilQuit(t *testing.T) {
+	var out bytes.Buffer
+	input := strings.NewReader("list\nuse nothing\nbogus\nquit\nlist\n")
+
+	if err := Run(input, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	output := out.String()
+	if !strings.Contains(output, "repl.test.int") || !strings.Contains(output, `unknown provider "nothing"`) || !strings.Contains(output, `unknown command "bogus"`) {
+		t.Fatalf("unexpected transcript:\n%s", output)
+	}
+	if strings.Count(output, "repl.test.int") != 1 {
+		t.Fatal("expected commands after quit to be ignored")
+	}
+}
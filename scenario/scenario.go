@@ -0,0 +1,200 @@
+// Package scenario builds datasets described in JSON files, so datasets can be defined
+// without writing Go. Factories and their traits are registered in Go once; scenario files
+// then choose which factories to run, how many instances to build and with which overrides.
+package scenario
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+const stepSeedStride = 1 << 32
+
+// Definition is the decoded form of a scenario file.
+type Definition struct {
+	Seed  int64  `json:"seed"`
+	Steps []Step `json:"steps"`
+}
+
+// Step builds Count instances of a registered factory.
+// Traits are applied in order before Overrides; later keys win.
+type Step struct {
+	Name      string         `json:"name"`
+	Factory   string         `json:"factory"`
+	Count     int            `json:"count"`
+	Traits    []string       `json:"traits"`
+	Overrides map[string]any `json:"overrides"`
+	Persist   *bool          `json:"persist"`
+}
+
+// Persister stores the instances built by a step, e.g. into a database.
+type Persister interface {
+	Persist(step string, instances []any) error
+}
+
+// Result maps step names to the instances they built.
+type Result map[string][]any
+
+type registration struct {
+	build  func(count int, seed int64, overrides map[string]any) []any
+	traits map[string]map[string]any
+}
+
+// Registry exposes factories and traits to scenario files by name. Names that are not registered
+// resolve to providers registered through factory.RegisterProvider.
+type Registry struct {
+	factories map[string]*registration
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]*registration),
+	}
+}
+
+// Register exposes f under name.
+func Register[T any, P any](registry *Registry, name string, f factory.Factory[T, P]) {
+	builder := factory.Builder(f)
+	registry.factories[name] = &registration{
+		build: func(count int, seed int64, overrides map[string]any) []any {
+			var override any
+			if len(overrides) > 0 {
+				override = factory.Override[P](overrides)
+			}
+
+			instances := builder.BuildListWith(count, seed, override)
+			result := make([]any, len(instances))
+			for index, instance := range instances {
+				result[index] = instance
+			}
+			return result
+		},
+		traits: make(map[string]map[string]any),
+	}
+}
+
+// Trait registers a named set of overrides for the factory registered under factoryName.
+func (r *Registry) Trait(factoryName, trait string, overrides map[string]any) error {
+	registered, ok := r.lookup(factoryName)
+	if !ok {
+		return fmt.Errorf("scenario: unknown factory %q", factoryName)
+	}
+	registered.traits[trait] = overrides
+	return nil
+}
+
+// Load decodes a scenario definition from JSON.
+func Load(reader io.Reader) (Definition, error) {
+	var definition Definition
+
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&definition); err != nil {
+		return definition, fmt.Errorf("scenario: %w", err)
+	}
+
+	return definition, nil
+}
+
+// RunFile loads the scenario at path and runs it.
+func (r *Registry) RunFile(path string, persister Persister) (Result, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("scenario: %w", err)
+	}
+	defer file.Close()
+
+	definition, err := Load(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Run(definition, persister)
+}
+
+// Run builds every step in order. Each step draws seeds from its own range derived from the
+// definition seed, so runs are reproducible. When persister is non-nil, steps are persisted
+// unless they set "persist": false.
+func (r *Registry) Run(definition Definition, persister Persister) (Result, error) {
+	result := make(Result, len(definition.Steps))
+
+	for index, step := range definition.Steps {
+		name := step.Name
+		if name == "" {
+			name = step.Factory
+		}
+		if _, exists := result[name]; exists {
+			return nil, fmt.Errorf("scenario: duplicate step name %q", name)
+		}
+
+		instances, err := r.runStep(step, definition.Seed+int64(index)*stepSeedStride)
+		if err != nil {
+			return nil, fmt.Errorf("scenario: step %q: %w", name, err)
+		}
+		result[name] = instances
+
+		if persister != nil && (step.Persist == nil || *step.Persist) {
+			if err := persister.Persist(name, instances); err != nil {
+				return nil, fmt.Errorf("scenario: persist %q: %w", name, err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// lookup returns the factory registered under name, falling back to providers registered
+// through factory.RegisterProvider.
+func (r *Registry) lookup(name string) (*registration, bool) {
+	if registered, ok := r.factories[name]; ok {
+		return registered, true
+	}
+
+	provider, ok := factory.LookupProvider(name)
+	if !ok {
+		return nil, false
+	}
+
+	registered := &registration{
+		build:  provider.BuildListWith,
+		traits: make(map[string]map[string]any),
+	}
+	r.factories[name] = registered
+
+	return registered, true
+}
+
+func (r *Registry) runStep(step Step, seed int64) (instances []any, err error) {
+	registered, ok := r.lookup(step.Factory)
+	if !ok {
+		return nil, fmt.Errorf("unknown factory %q", step.Factory)
+	}
+	if step.Count < 0 {
+		return nil, errors.New("count must not be negative")
+	}
+
+	overrides := make(map[string]any)
+	for _, trait := range step.Traits {
+		traitOverrides, ok := registered.traits[trait]
+		if !ok {
+			return nil, fmt.Errorf("unknown trait %q", trait)
+		}
+		maps.Copy(overrides, traitOverrides)
+	}
+	maps.Copy(overrides, step.Overrides)
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%v", recovered)
+		}
+	}()
+
+	return registered.build(step.Count, seed, overrides), nil
+}
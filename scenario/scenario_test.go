@@ -0,0 +1,160 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lihs-ie/forge/factory"
+)
+
+type user struct {
+	Name string
+	Role string
+	Age  int
+}
+
+type userFactory struct{}
+
+func (f *userFactory) Instantiate(props user) user {
+	return props
+}
+
+func (f *userFactory) Prepare(overrides factory.Partial[user], seed int64) user {
+	props := user{Name: fmt.Sprintf("user-%d", seed), Role: "member", Age: int(seed % 80)}
+	if overrides != nil {
+		overrides(&props)
+	}
+	return props
+}
+
+func (f *userFactory) Retrieve(instance user) user {
+	return instance
+}
+
+type recordingPersister struct {
+	steps map[string]int
+	err   error
+}
+
+func (p *recordingPersister) Persist(step string, instances []any) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.steps[step] = len(instances)
+	return nil
+}
+
+func newRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	registry := NewRegistry()
+	Register(registry, "user", &userFactory{})
+	if err := registry.Trait("user", "admin", map[string]any{"Role": "admin"}); err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+func TestRunFileBuildsAndPersists(t *testing.T) {
+	persister := &recordingPersister{steps: map[string]int{}}
+
+	result, err := newRegistry(t).RunFile(filepath.Join("testdata", "users.json"), persister)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result["user"]) != 3 || len(result["guests"]) != 2 {
+		t.Fatalf("unexpected result sizes: %d users, %d guests", len(result["user"]), len(result["guests"]))
+	}
+	for _, instance := range result["user"] {
+		if instance.(user).Role != "admin" {
+			t.Fatalf("expected admin trait, got %+v", instance)
+		}
+	}
+	for _, instance := range result["guests"] {
+		if guest := instance.(user); guest.Role != "guest" || guest.Age != 20 {
+			t.Fatalf("expected guest overrides, got %+v", guest)
+		}
+	}
+	if !reflect.DeepEqual(persister.steps, map[string]int{"user": 3}) {
+		t.Fatalf("expected only the user step to be persisted, got %v", persister.steps)
+	}
+}
+
+func TestRunIsReproducible(t *testing.T) {
+	registry := newRegistry(t)
+	definition := Definition{Seed: 1, Steps: []Step{{Factory: "user", Count: 4}}}
+
+	first, _ := registry.Run(definition, nil)
+	second, _ := registry.Run(definition, nil)
+
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("expected identical results for identical definitions")
+	}
+}
+
+func TestRunReportsErrors(t *testing.T) {
+	registry := newRegistry(t)
+
+	cases := map[string]Definition{
+		"unknown factory": {Steps: []Step{{Factory: "order", Count: 1}}},
+		"unknown trait":   {Steps: []Step{{Factory: "user", Count: 1, Traits: []string{"owner"}}}},
+		"unknown field":   {Steps: []Step{{Factory: "user", Count: 1, Overrides: map[string]any{"Email": "x"}}}},
+		"duplicate step":  {Steps: []Step{{Factory: "user", Count: 1}, {Factory: "user", Count: 1}}},
+	}
+
+	for name, definition := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := registry.Run(definition, nil); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestRunReturnsPersistError(t *testing.T) {
+	failure := errors.New("db down")
+	persister := &recordingPersister{err: failure}
+
+	_, err := newRegistry(t).Run(Definition{Steps: []Step{{Factory: "user", Count: 1}}}, persister)
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected persist error, got %v", err)
+	}
+}
+
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	if _, err := Load(strings.NewReader(`{"steps": [{"factory": "user", "amount": 3}]}`)); err == nil {
+		t.Fatal("expected error for unknown key")
+	}
+}
+
+func TestTraitRequiresRegisteredFactory(t *testing.T) {
+	if err := NewRegistry().Trait("user", "admin", nil); err == nil {
+		t.Fatal("expected error for unknown factory")
+	}
+}
+
+func TestRunResolvesProviders(t *testing.T) {
+	factory.RegisterProvider("scenario.user", func() factory.Factory[user, user] {
+		return &userFactory{}
+	})
+
+	registry := NewRegistry()
+	if err := registry.Trait("scenario.user", "guest", map[string]any{"Role": "guest"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := registry.Run(Definition{Steps: []Step{{Factory: "scenario.user", Count: 2, Traits: []string{"guest"}}}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, instance := range result["scenario.user"] {
+		if instance.(user).Role != "guest" {
+			t.Fatalf("expected provider instances with trait, got %+v", instance)
+		}
+	}
+}